@@ -700,6 +700,52 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Params_8_list)(nil)
+
+type _Params_8_list struct {
+	list *[]OracleType
+}
+
+func (x *_Params_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_Params_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (OracleType)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (OracleType)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field TrustedProviderTypes as it is not of Message kind"))
+}
+
+func (x *_Params_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_8_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_Params_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                         protoreflect.MessageDescriptor
 	fd_Params_enable_oracle           protoreflect.FieldDescriptor
@@ -707,6 +753,11 @@ var (
 	fd_Params_min_submit_per_window   protoreflect.FieldDescriptor
 	fd_Params_slash_fraction_downtime protoreflect.FieldDescriptor
 	fd_Params_max_account_list_size   protoreflect.FieldDescriptor
+	fd_Params_max_magnitude_ratio     protoreflect.FieldDescriptor
+	fd_Params_max_raw_data_bytes      protoreflect.FieldDescriptor
+	fd_Params_trusted_provider_types  protoreflect.FieldDescriptor
+	fd_Params_min_raw_value           protoreflect.FieldDescriptor
+	fd_Params_max_raw_value           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -717,6 +768,11 @@ func init() {
 	fd_Params_min_submit_per_window = md_Params.Fields().ByName("min_submit_per_window")
 	fd_Params_slash_fraction_downtime = md_Params.Fields().ByName("slash_fraction_downtime")
 	fd_Params_max_account_list_size = md_Params.Fields().ByName("max_account_list_size")
+	fd_Params_max_magnitude_ratio = md_Params.Fields().ByName("max_magnitude_ratio")
+	fd_Params_max_raw_data_bytes = md_Params.Fields().ByName("max_raw_data_bytes")
+	fd_Params_trusted_provider_types = md_Params.Fields().ByName("trusted_provider_types")
+	fd_Params_min_raw_value = md_Params.Fields().ByName("min_raw_value")
+	fd_Params_max_raw_value = md_Params.Fields().ByName("max_raw_value")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -814,6 +870,36 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.MaxMagnitudeRatio) != 0 {
+		value := protoreflect.ValueOfBytes(x.MaxMagnitudeRatio)
+		if !f(fd_Params_max_magnitude_ratio, value) {
+			return
+		}
+	}
+	if x.MaxRawDataBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRawDataBytes)
+		if !f(fd_Params_max_raw_data_bytes, value) {
+			return
+		}
+	}
+	if len(x.TrustedProviderTypes) != 0 {
+		value := protoreflect.ValueOfList(&_Params_8_list{list: &x.TrustedProviderTypes})
+		if !f(fd_Params_trusted_provider_types, value) {
+			return
+		}
+	}
+	if len(x.MinRawValue) != 0 {
+		value := protoreflect.ValueOfBytes(x.MinRawValue)
+		if !f(fd_Params_min_raw_value, value) {
+			return
+		}
+	}
+	if len(x.MaxRawValue) != 0 {
+		value := protoreflect.ValueOfBytes(x.MaxRawValue)
+		if !f(fd_Params_max_raw_value, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -839,6 +925,16 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.SlashFractionDowntime) != 0
 	case "guru.oracle.v1.Params.max_account_list_size":
 		return x.MaxAccountListSize != uint64(0)
+	case "guru.oracle.v1.Params.max_magnitude_ratio":
+		return len(x.MaxMagnitudeRatio) != 0
+	case "guru.oracle.v1.Params.max_raw_data_bytes":
+		return x.MaxRawDataBytes != uint64(0)
+	case "guru.oracle.v1.Params.trusted_provider_types":
+		return len(x.TrustedProviderTypes) != 0
+	case "guru.oracle.v1.Params.min_raw_value":
+		return len(x.MinRawValue) != 0
+	case "guru.oracle.v1.Params.max_raw_value":
+		return len(x.MaxRawValue) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.Params"))
@@ -865,6 +961,16 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SlashFractionDowntime = nil
 	case "guru.oracle.v1.Params.max_account_list_size":
 		x.MaxAccountListSize = uint64(0)
+	case "guru.oracle.v1.Params.max_magnitude_ratio":
+		x.MaxMagnitudeRatio = nil
+	case "guru.oracle.v1.Params.max_raw_data_bytes":
+		x.MaxRawDataBytes = uint64(0)
+	case "guru.oracle.v1.Params.trusted_provider_types":
+		x.TrustedProviderTypes = nil
+	case "guru.oracle.v1.Params.min_raw_value":
+		x.MinRawValue = nil
+	case "guru.oracle.v1.Params.max_raw_value":
+		x.MaxRawValue = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.Params"))
@@ -896,6 +1002,24 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "guru.oracle.v1.Params.max_account_list_size":
 		value := x.MaxAccountListSize
 		return protoreflect.ValueOfUint64(value)
+	case "guru.oracle.v1.Params.max_magnitude_ratio":
+		value := x.MaxMagnitudeRatio
+		return protoreflect.ValueOfBytes(value)
+	case "guru.oracle.v1.Params.max_raw_data_bytes":
+		value := x.MaxRawDataBytes
+		return protoreflect.ValueOfUint64(value)
+	case "guru.oracle.v1.Params.trusted_provider_types":
+		if len(x.TrustedProviderTypes) == 0 {
+			return protoreflect.ValueOfList(&_Params_8_list{})
+		}
+		listValue := &_Params_8_list{list: &x.TrustedProviderTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "guru.oracle.v1.Params.min_raw_value":
+		value := x.MinRawValue
+		return protoreflect.ValueOfBytes(value)
+	case "guru.oracle.v1.Params.max_raw_value":
+		value := x.MaxRawValue
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.Params"))
@@ -926,6 +1050,18 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SlashFractionDowntime = value.Bytes()
 	case "guru.oracle.v1.Params.max_account_list_size":
 		x.MaxAccountListSize = value.Uint()
+	case "guru.oracle.v1.Params.max_magnitude_ratio":
+		x.MaxMagnitudeRatio = value.Bytes()
+	case "guru.oracle.v1.Params.max_raw_data_bytes":
+		x.MaxRawDataBytes = value.Uint()
+	case "guru.oracle.v1.Params.trusted_provider_types":
+		lv := value.List()
+		clv := lv.(*_Params_8_list)
+		x.TrustedProviderTypes = *clv.list
+	case "guru.oracle.v1.Params.min_raw_value":
+		x.MinRawValue = value.Bytes()
+	case "guru.oracle.v1.Params.max_raw_value":
+		x.MaxRawValue = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.Params"))
@@ -946,6 +1082,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "guru.oracle.v1.Params.trusted_provider_types":
+		if x.TrustedProviderTypes == nil {
+			x.TrustedProviderTypes = []OracleType{}
+		}
+		value := &_Params_8_list{list: &x.TrustedProviderTypes}
+		return protoreflect.ValueOfList(value)
 	case "guru.oracle.v1.Params.enable_oracle":
 		panic(fmt.Errorf("field enable_oracle of message guru.oracle.v1.Params is not mutable"))
 	case "guru.oracle.v1.Params.submit_window":
@@ -956,6 +1098,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field slash_fraction_downtime of message guru.oracle.v1.Params is not mutable"))
 	case "guru.oracle.v1.Params.max_account_list_size":
 		panic(fmt.Errorf("field max_account_list_size of message guru.oracle.v1.Params is not mutable"))
+	case "guru.oracle.v1.Params.max_magnitude_ratio":
+		panic(fmt.Errorf("field max_magnitude_ratio of message guru.oracle.v1.Params is not mutable"))
+	case "guru.oracle.v1.Params.max_raw_data_bytes":
+		panic(fmt.Errorf("field max_raw_data_bytes of message guru.oracle.v1.Params is not mutable"))
+	case "guru.oracle.v1.Params.min_raw_value":
+		panic(fmt.Errorf("field min_raw_value of message guru.oracle.v1.Params is not mutable"))
+	case "guru.oracle.v1.Params.max_raw_value":
+		panic(fmt.Errorf("field max_raw_value of message guru.oracle.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.Params"))
@@ -979,6 +1129,17 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBytes(nil)
 	case "guru.oracle.v1.Params.max_account_list_size":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "guru.oracle.v1.Params.max_magnitude_ratio":
+		return protoreflect.ValueOfBytes(nil)
+	case "guru.oracle.v1.Params.max_raw_data_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "guru.oracle.v1.Params.trusted_provider_types":
+		list := []OracleType{}
+		return protoreflect.ValueOfList(&_Params_8_list{list: &list})
+	case "guru.oracle.v1.Params.min_raw_value":
+		return protoreflect.ValueOfBytes(nil)
+	case "guru.oracle.v1.Params.max_raw_value":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.Params"))
@@ -1065,6 +1226,28 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.MaxAccountListSize != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxAccountListSize))
 		}
+		l = len(x.MaxMagnitudeRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxRawDataBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRawDataBytes))
+		}
+		if len(x.TrustedProviderTypes) > 0 {
+			l = 0
+			for _, e := range x.TrustedProviderTypes {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		l = len(x.MinRawValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxRawValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1094,6 +1277,53 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxRawValue) > 0 {
+			i -= len(x.MaxRawValue)
+			copy(dAtA[i:], x.MaxRawValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxRawValue)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.MinRawValue) > 0 {
+			i -= len(x.MinRawValue)
+			copy(dAtA[i:], x.MinRawValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinRawValue)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.TrustedProviderTypes) > 0 {
+			var pksize2 int
+			for _, num := range x.TrustedProviderTypes {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.TrustedProviderTypes {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.MaxRawDataBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRawDataBytes))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.MaxMagnitudeRatio) > 0 {
+			i -= len(x.MaxMagnitudeRatio)
+			copy(dAtA[i:], x.MaxMagnitudeRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxMagnitudeRatio)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if x.MaxAccountListSize != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAccountListSize))
 			i--
@@ -1303,6 +1533,196 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMagnitudeRatio", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxMagnitudeRatio = append(x.MaxMagnitudeRatio[:0], dAtA[iNdEx:postIndex]...)
+				if x.MaxMagnitudeRatio == nil {
+					x.MaxMagnitudeRatio = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRawDataBytes", wireType)
+				}
+				x.MaxRawDataBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRawDataBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType == 0 {
+					var v OracleType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OracleType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.TrustedProviderTypes = append(x.TrustedProviderTypes, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					if elementCount != 0 && len(x.TrustedProviderTypes) == 0 {
+						x.TrustedProviderTypes = make([]OracleType, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v OracleType
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= OracleType(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.TrustedProviderTypes = append(x.TrustedProviderTypes, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustedProviderTypes", wireType)
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinRawValue", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinRawValue = append(x.MinRawValue[:0], dAtA[iNdEx:postIndex]...)
+				if x.MinRawValue == nil {
+					x.MinRawValue = []byte{}
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRawValue", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxRawValue = append(x.MaxRawValue[:0], dAtA[iNdEx:postIndex]...)
+				if x.MaxRawValue == nil {
+					x.MaxRawValue = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1432,6 +1852,23 @@ type Params struct {
 	// max_account_list_size defines the maximum size of the account list for oracle requests
 	// This also effectively limits the maximum submissions per aggregation since each account can only submit once(Updated when resubmitted)
 	MaxAccountListSize uint64 `protobuf:"varint,5,opt,name=max_account_list_size,json=maxAccountListSize,proto3" json:"max_account_list_size,omitempty"`
+	// max_magnitude_ratio defines the largest allowed ratio between a submission and the
+	// round median before the submission is excluded as a suspected unit mismatch.
+	// Zero disables the check.
+	MaxMagnitudeRatio []byte `protobuf:"bytes,6,opt,name=max_magnitude_ratio,json=maxMagnitudeRatio,proto3" json:"max_magnitude_ratio,omitempty"`
+	// max_raw_data_bytes defines the largest raw data, in bytes, accepted in a single
+	// submission. It must be positive.
+	MaxRawDataBytes uint64 `protobuf:"varint,7,opt,name=max_raw_data_bytes,json=maxRawDataBytes,proto3" json:"max_raw_data_bytes,omitempty"`
+	// trusted_provider_types lists the oracle types whose requests may use
+	// QUORUM_MODE_TRUSTED_PROVIDER. Price types (currency, stock and crypto)
+	// cannot be listed. Empty disables the mode.
+	TrustedProviderTypes []OracleType `protobuf:"varint,8,rep,packed,name=trusted_provider_types,json=trustedProviderTypes,proto3,enum=guru.oracle.v1.OracleType" json:"trusted_provider_types,omitempty"`
+	// min_raw_value defines the smallest value accepted in a submission to a request of
+	// a numeric oracle type. Zero disables the bound.
+	MinRawValue []byte `protobuf:"bytes,9,opt,name=min_raw_value,json=minRawValue,proto3" json:"min_raw_value,omitempty"`
+	// max_raw_value defines the largest value accepted in a submission to a request of
+	// a numeric oracle type. Zero disables the bound.
+	MaxRawValue []byte `protobuf:"bytes,10,opt,name=max_raw_value,json=maxRawValue,proto3" json:"max_raw_value,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1489,6 +1926,41 @@ func (x *Params) GetMaxAccountListSize() uint64 {
 	return 0
 }
 
+func (x *Params) GetMaxMagnitudeRatio() []byte {
+	if x != nil {
+		return x.MaxMagnitudeRatio
+	}
+	return nil
+}
+
+func (x *Params) GetMaxRawDataBytes() uint64 {
+	if x != nil {
+		return x.MaxRawDataBytes
+	}
+	return 0
+}
+
+func (x *Params) GetTrustedProviderTypes() []OracleType {
+	if x != nil {
+		return x.TrustedProviderTypes
+	}
+	return nil
+}
+
+func (x *Params) GetMinRawValue() []byte {
+	if x != nil {
+		return x.MinRawValue
+	}
+	return nil
+}
+
+func (x *Params) GetMaxRawValue() []byte {
+	if x != nil {
+		return x.MaxRawValue
+	}
+	return nil
+}
+
 var File_guru_oracle_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_guru_oracle_v1_genesis_proto_rawDesc = []byte{
@@ -1517,7 +1989,7 @@ var file_guru_oracle_v1_genesis_proto_rawDesc = []byte{
 	0x65, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x72,
 	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0xe0, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0xff, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
 	0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72,
 	0x61, 0x63, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x77,
@@ -1539,18 +2011,44 @@ var file_guru_oracle_v1_genesis_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x63, 0x6f,
 	0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
 	0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x42, 0xa6, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e,
-	0x67, 0x75, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c,
-	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x67, 0x75, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x47, 0x4f, 0x58, 0xaa, 0x02,
-	0x0e, 0x47, 0x75, 0x72, 0x75, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x0e, 0x47, 0x75, 0x72, 0x75, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x1a, 0x47, 0x75, 0x72, 0x75, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10,
-	0x47, 0x75, 0x72, 0x75, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x73, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6d,
+	0x61, 0x67, 0x6e, 0x69, 0x74, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x4d, 0x61, 0x67, 0x6e, 0x69, 0x74, 0x75, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
+	0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78,
+	0x52, 0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x16,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67,
+	0x75, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x14, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x5a,
+	0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d,
+	0x69, 0x6e, 0x52, 0x61, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x61,
+	0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0xa6, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
+	0x75, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x67, 0x75, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x47, 0x4f, 0x58, 0xaa, 0x02, 0x0e,
+	0x47, 0x75, 0x72, 0x75, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0e, 0x47, 0x75, 0x72, 0x75, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1a, 0x47, 0x75, 0x72, 0x75, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x47,
+	0x75, 0x72, 0x75, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1570,15 +2068,17 @@ var file_guru_oracle_v1_genesis_proto_goTypes = []interface{}{
 	(*GenesisState)(nil),     // 0: guru.oracle.v1.GenesisState
 	(*Params)(nil),           // 1: guru.oracle.v1.Params
 	(*OracleRequestDoc)(nil), // 2: guru.oracle.v1.OracleRequestDoc
+	(OracleType)(0),          // 3: guru.oracle.v1.OracleType
 }
 var file_guru_oracle_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: guru.oracle.v1.GenesisState.params:type_name -> guru.oracle.v1.Params
 	2, // 1: guru.oracle.v1.GenesisState.oracle_request_docs:type_name -> guru.oracle.v1.OracleRequestDoc
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: guru.oracle.v1.Params.trusted_provider_types:type_name -> guru.oracle.v1.OracleType
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_guru_oracle_v1_genesis_proto_init() }
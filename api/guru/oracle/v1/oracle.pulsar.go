@@ -112,19 +112,71 @@ func (x *_OracleRequestDoc_8_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_OracleRequestDoc_16_list)(nil)
+
+type _OracleRequestDoc_16_list struct {
+	list *[]AggregationRule
+}
+
+func (x *_OracleRequestDoc_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OracleRequestDoc_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_OracleRequestDoc_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (AggregationRule)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OracleRequestDoc_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (AggregationRule)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OracleRequestDoc_16_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OracleRequestDoc at list field FallbackRules as it is not of Message kind"))
+}
+
+func (x *_OracleRequestDoc_16_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OracleRequestDoc_16_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_OracleRequestDoc_16_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_OracleRequestDoc                  protoreflect.MessageDescriptor
-	fd_OracleRequestDoc_request_id       protoreflect.FieldDescriptor
-	fd_OracleRequestDoc_oracle_type      protoreflect.FieldDescriptor
-	fd_OracleRequestDoc_name             protoreflect.FieldDescriptor
-	fd_OracleRequestDoc_description      protoreflect.FieldDescriptor
-	fd_OracleRequestDoc_period           protoreflect.FieldDescriptor
-	fd_OracleRequestDoc_account_list     protoreflect.FieldDescriptor
-	fd_OracleRequestDoc_quorum           protoreflect.FieldDescriptor
-	fd_OracleRequestDoc_endpoints        protoreflect.FieldDescriptor
-	fd_OracleRequestDoc_aggregation_rule protoreflect.FieldDescriptor
-	fd_OracleRequestDoc_status           protoreflect.FieldDescriptor
-	fd_OracleRequestDoc_nonce            protoreflect.FieldDescriptor
+	md_OracleRequestDoc                       protoreflect.MessageDescriptor
+	fd_OracleRequestDoc_request_id            protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_oracle_type           protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_name                  protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_description           protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_period                protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_account_list          protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_quorum                protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_endpoints             protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_aggregation_rule      protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_status                protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_nonce                 protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_endpoint_assignment   protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_quorum_mode           protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_quorum_stake_fraction protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_fallback_rules        protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_result_format         protoreflect.FieldDescriptor
+	fd_OracleRequestDoc_coverage_mode         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -141,6 +193,12 @@ func init() {
 	fd_OracleRequestDoc_aggregation_rule = md_OracleRequestDoc.Fields().ByName("aggregation_rule")
 	fd_OracleRequestDoc_status = md_OracleRequestDoc.Fields().ByName("status")
 	fd_OracleRequestDoc_nonce = md_OracleRequestDoc.Fields().ByName("nonce")
+	fd_OracleRequestDoc_endpoint_assignment = md_OracleRequestDoc.Fields().ByName("endpoint_assignment")
+	fd_OracleRequestDoc_quorum_mode = md_OracleRequestDoc.Fields().ByName("quorum_mode")
+	fd_OracleRequestDoc_quorum_stake_fraction = md_OracleRequestDoc.Fields().ByName("quorum_stake_fraction")
+	fd_OracleRequestDoc_fallback_rules = md_OracleRequestDoc.Fields().ByName("fallback_rules")
+	fd_OracleRequestDoc_result_format = md_OracleRequestDoc.Fields().ByName("result_format")
+	fd_OracleRequestDoc_coverage_mode = md_OracleRequestDoc.Fields().ByName("coverage_mode")
 }
 
 var _ protoreflect.Message = (*fastReflection_OracleRequestDoc)(nil)
@@ -274,6 +332,42 @@ func (x *fastReflection_OracleRequestDoc) Range(f func(protoreflect.FieldDescrip
 			return
 		}
 	}
+	if x.EndpointAssignment != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.EndpointAssignment))
+		if !f(fd_OracleRequestDoc_endpoint_assignment, value) {
+			return
+		}
+	}
+	if x.QuorumMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.QuorumMode))
+		if !f(fd_OracleRequestDoc_quorum_mode, value) {
+			return
+		}
+	}
+	if x.QuorumStakeFraction != "" {
+		value := protoreflect.ValueOfString(x.QuorumStakeFraction)
+		if !f(fd_OracleRequestDoc_quorum_stake_fraction, value) {
+			return
+		}
+	}
+	if len(x.FallbackRules) != 0 {
+		value := protoreflect.ValueOfList(&_OracleRequestDoc_16_list{list: &x.FallbackRules})
+		if !f(fd_OracleRequestDoc_fallback_rules, value) {
+			return
+		}
+	}
+	if x.ResultFormat != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ResultFormat))
+		if !f(fd_OracleRequestDoc_result_format, value) {
+			return
+		}
+	}
+	if x.CoverageMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.CoverageMode))
+		if !f(fd_OracleRequestDoc_coverage_mode, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -311,6 +405,18 @@ func (x *fastReflection_OracleRequestDoc) Has(fd protoreflect.FieldDescriptor) b
 		return x.Status != 0
 	case "guru.oracle.v1.OracleRequestDoc.nonce":
 		return x.Nonce != uint64(0)
+	case "guru.oracle.v1.OracleRequestDoc.endpoint_assignment":
+		return x.EndpointAssignment != 0
+	case "guru.oracle.v1.OracleRequestDoc.quorum_mode":
+		return x.QuorumMode != 0
+	case "guru.oracle.v1.OracleRequestDoc.quorum_stake_fraction":
+		return x.QuorumStakeFraction != ""
+	case "guru.oracle.v1.OracleRequestDoc.fallback_rules":
+		return len(x.FallbackRules) != 0
+	case "guru.oracle.v1.OracleRequestDoc.result_format":
+		return x.ResultFormat != 0
+	case "guru.oracle.v1.OracleRequestDoc.coverage_mode":
+		return x.CoverageMode != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.OracleRequestDoc"))
@@ -349,6 +455,18 @@ func (x *fastReflection_OracleRequestDoc) Clear(fd protoreflect.FieldDescriptor)
 		x.Status = 0
 	case "guru.oracle.v1.OracleRequestDoc.nonce":
 		x.Nonce = uint64(0)
+	case "guru.oracle.v1.OracleRequestDoc.endpoint_assignment":
+		x.EndpointAssignment = 0
+	case "guru.oracle.v1.OracleRequestDoc.quorum_mode":
+		x.QuorumMode = 0
+	case "guru.oracle.v1.OracleRequestDoc.quorum_stake_fraction":
+		x.QuorumStakeFraction = ""
+	case "guru.oracle.v1.OracleRequestDoc.fallback_rules":
+		x.FallbackRules = nil
+	case "guru.oracle.v1.OracleRequestDoc.result_format":
+		x.ResultFormat = 0
+	case "guru.oracle.v1.OracleRequestDoc.coverage_mode":
+		x.CoverageMode = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.OracleRequestDoc"))
@@ -404,6 +522,27 @@ func (x *fastReflection_OracleRequestDoc) Get(descriptor protoreflect.FieldDescr
 	case "guru.oracle.v1.OracleRequestDoc.nonce":
 		value := x.Nonce
 		return protoreflect.ValueOfUint64(value)
+	case "guru.oracle.v1.OracleRequestDoc.endpoint_assignment":
+		value := x.EndpointAssignment
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "guru.oracle.v1.OracleRequestDoc.quorum_mode":
+		value := x.QuorumMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "guru.oracle.v1.OracleRequestDoc.quorum_stake_fraction":
+		value := x.QuorumStakeFraction
+		return protoreflect.ValueOfString(value)
+	case "guru.oracle.v1.OracleRequestDoc.fallback_rules":
+		if len(x.FallbackRules) == 0 {
+			return protoreflect.ValueOfList(&_OracleRequestDoc_16_list{})
+		}
+		listValue := &_OracleRequestDoc_16_list{list: &x.FallbackRules}
+		return protoreflect.ValueOfList(listValue)
+	case "guru.oracle.v1.OracleRequestDoc.result_format":
+		value := x.ResultFormat
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "guru.oracle.v1.OracleRequestDoc.coverage_mode":
+		value := x.CoverageMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.OracleRequestDoc"))
@@ -450,6 +589,20 @@ func (x *fastReflection_OracleRequestDoc) Set(fd protoreflect.FieldDescriptor, v
 		x.Status = (RequestStatus)(value.Enum())
 	case "guru.oracle.v1.OracleRequestDoc.nonce":
 		x.Nonce = value.Uint()
+	case "guru.oracle.v1.OracleRequestDoc.endpoint_assignment":
+		x.EndpointAssignment = (EndpointAssignment)(value.Enum())
+	case "guru.oracle.v1.OracleRequestDoc.quorum_mode":
+		x.QuorumMode = (QuorumMode)(value.Enum())
+	case "guru.oracle.v1.OracleRequestDoc.quorum_stake_fraction":
+		x.QuorumStakeFraction = value.Interface().(string)
+	case "guru.oracle.v1.OracleRequestDoc.fallback_rules":
+		lv := value.List()
+		clv := lv.(*_OracleRequestDoc_16_list)
+		x.FallbackRules = *clv.list
+	case "guru.oracle.v1.OracleRequestDoc.result_format":
+		x.ResultFormat = (ResultFormat)(value.Enum())
+	case "guru.oracle.v1.OracleRequestDoc.coverage_mode":
+		x.CoverageMode = (CoverageMode)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.OracleRequestDoc"))
@@ -482,6 +635,12 @@ func (x *fastReflection_OracleRequestDoc) Mutable(fd protoreflect.FieldDescripto
 		}
 		value := &_OracleRequestDoc_8_list{list: &x.Endpoints}
 		return protoreflect.ValueOfList(value)
+	case "guru.oracle.v1.OracleRequestDoc.fallback_rules":
+		if x.FallbackRules == nil {
+			x.FallbackRules = []AggregationRule{}
+		}
+		value := &_OracleRequestDoc_16_list{list: &x.FallbackRules}
+		return protoreflect.ValueOfList(value)
 	case "guru.oracle.v1.OracleRequestDoc.request_id":
 		panic(fmt.Errorf("field request_id of message guru.oracle.v1.OracleRequestDoc is not mutable"))
 	case "guru.oracle.v1.OracleRequestDoc.oracle_type":
@@ -500,6 +659,16 @@ func (x *fastReflection_OracleRequestDoc) Mutable(fd protoreflect.FieldDescripto
 		panic(fmt.Errorf("field status of message guru.oracle.v1.OracleRequestDoc is not mutable"))
 	case "guru.oracle.v1.OracleRequestDoc.nonce":
 		panic(fmt.Errorf("field nonce of message guru.oracle.v1.OracleRequestDoc is not mutable"))
+	case "guru.oracle.v1.OracleRequestDoc.endpoint_assignment":
+		panic(fmt.Errorf("field endpoint_assignment of message guru.oracle.v1.OracleRequestDoc is not mutable"))
+	case "guru.oracle.v1.OracleRequestDoc.quorum_mode":
+		panic(fmt.Errorf("field quorum_mode of message guru.oracle.v1.OracleRequestDoc is not mutable"))
+	case "guru.oracle.v1.OracleRequestDoc.quorum_stake_fraction":
+		panic(fmt.Errorf("field quorum_stake_fraction of message guru.oracle.v1.OracleRequestDoc is not mutable"))
+	case "guru.oracle.v1.OracleRequestDoc.result_format":
+		panic(fmt.Errorf("field result_format of message guru.oracle.v1.OracleRequestDoc is not mutable"))
+	case "guru.oracle.v1.OracleRequestDoc.coverage_mode":
+		panic(fmt.Errorf("field coverage_mode of message guru.oracle.v1.OracleRequestDoc is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.OracleRequestDoc"))
@@ -537,6 +706,19 @@ func (x *fastReflection_OracleRequestDoc) NewField(fd protoreflect.FieldDescript
 		return protoreflect.ValueOfEnum(0)
 	case "guru.oracle.v1.OracleRequestDoc.nonce":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "guru.oracle.v1.OracleRequestDoc.endpoint_assignment":
+		return protoreflect.ValueOfEnum(0)
+	case "guru.oracle.v1.OracleRequestDoc.quorum_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "guru.oracle.v1.OracleRequestDoc.quorum_stake_fraction":
+		return protoreflect.ValueOfString("")
+	case "guru.oracle.v1.OracleRequestDoc.fallback_rules":
+		list := []AggregationRule{}
+		return protoreflect.ValueOfList(&_OracleRequestDoc_16_list{list: &list})
+	case "guru.oracle.v1.OracleRequestDoc.result_format":
+		return protoreflect.ValueOfEnum(0)
+	case "guru.oracle.v1.OracleRequestDoc.coverage_mode":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.OracleRequestDoc"))
@@ -647,6 +829,29 @@ func (x *fastReflection_OracleRequestDoc) ProtoMethods() *protoiface.Methods {
 		if x.Nonce != 0 {
 			n += 1 + runtime.Sov(uint64(x.Nonce))
 		}
+		if x.EndpointAssignment != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndpointAssignment))
+		}
+		if x.QuorumMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.QuorumMode))
+		}
+		l = len(x.QuorumStakeFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FallbackRules) > 0 {
+			l = 0
+			for _, e := range x.FallbackRules {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 2 + runtime.Sov(uint64(l)) + l
+		}
+		if x.ResultFormat != 0 {
+			n += 2 + runtime.Sov(uint64(x.ResultFormat))
+		}
+		if x.CoverageMode != 0 {
+			n += 2 + runtime.Sov(uint64(x.CoverageMode))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -676,6 +881,60 @@ func (x *fastReflection_OracleRequestDoc) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.CoverageMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CoverageMode))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.ResultFormat != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResultFormat))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.FallbackRules) > 0 {
+			var pksize2 int
+			for _, num := range x.FallbackRules {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.FallbackRules {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.QuorumStakeFraction) > 0 {
+			i -= len(x.QuorumStakeFraction)
+			copy(dAtA[i:], x.QuorumStakeFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QuorumStakeFraction)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.QuorumMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.QuorumMode))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.EndpointAssignment != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndpointAssignment))
+			i--
+			dAtA[i] = 0x68
+		}
 		if x.Nonce != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
 			i--
@@ -1062,6 +1321,183 @@ func (x *fastReflection_OracleRequestDoc) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndpointAssignment", wireType)
+				}
+				x.EndpointAssignment = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndpointAssignment |= EndpointAssignment(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuorumMode", wireType)
+				}
+				x.QuorumMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.QuorumMode |= QuorumMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuorumStakeFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QuorumStakeFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType == 0 {
+					var v AggregationRule
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= AggregationRule(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.FallbackRules = append(x.FallbackRules, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					if elementCount != 0 && len(x.FallbackRules) == 0 {
+						x.FallbackRules = make([]AggregationRule, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v AggregationRule
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= AggregationRule(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.FallbackRules = append(x.FallbackRules, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FallbackRules", wireType)
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResultFormat", wireType)
+				}
+				x.ResultFormat = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResultFormat |= ResultFormat(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoverageMode", wireType)
+				}
+				x.CoverageMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CoverageMode |= CoverageMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1098,9 +1534,11 @@ func (x *fastReflection_OracleRequestDoc) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_OracleEndpoint            protoreflect.MessageDescriptor
-	fd_OracleEndpoint_url        protoreflect.FieldDescriptor
-	fd_OracleEndpoint_parse_rule protoreflect.FieldDescriptor
+	md_OracleEndpoint              protoreflect.MessageDescriptor
+	fd_OracleEndpoint_url          protoreflect.FieldDescriptor
+	fd_OracleEndpoint_parse_rule   protoreflect.FieldDescriptor
+	fd_OracleEndpoint_transform    protoreflect.FieldDescriptor
+	fd_OracleEndpoint_message_type protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1108,6 +1546,8 @@ func init() {
 	md_OracleEndpoint = File_guru_oracle_v1_oracle_proto.Messages().ByName("OracleEndpoint")
 	fd_OracleEndpoint_url = md_OracleEndpoint.Fields().ByName("url")
 	fd_OracleEndpoint_parse_rule = md_OracleEndpoint.Fields().ByName("parse_rule")
+	fd_OracleEndpoint_transform = md_OracleEndpoint.Fields().ByName("transform")
+	fd_OracleEndpoint_message_type = md_OracleEndpoint.Fields().ByName("message_type")
 }
 
 var _ protoreflect.Message = (*fastReflection_OracleEndpoint)(nil)
@@ -1187,6 +1627,18 @@ func (x *fastReflection_OracleEndpoint) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if x.Transform != nil {
+		value := protoreflect.ValueOfMessage(x.Transform.ProtoReflect())
+		if !f(fd_OracleEndpoint_transform, value) {
+			return
+		}
+	}
+	if x.MessageType != "" {
+		value := protoreflect.ValueOfString(x.MessageType)
+		if !f(fd_OracleEndpoint_message_type, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1206,6 +1658,10 @@ func (x *fastReflection_OracleEndpoint) Has(fd protoreflect.FieldDescriptor) boo
 		return x.Url != ""
 	case "guru.oracle.v1.OracleEndpoint.parse_rule":
 		return x.ParseRule != ""
+	case "guru.oracle.v1.OracleEndpoint.transform":
+		return x.Transform != nil
+	case "guru.oracle.v1.OracleEndpoint.message_type":
+		return x.MessageType != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.OracleEndpoint"))
@@ -1226,6 +1682,10 @@ func (x *fastReflection_OracleEndpoint) Clear(fd protoreflect.FieldDescriptor) {
 		x.Url = ""
 	case "guru.oracle.v1.OracleEndpoint.parse_rule":
 		x.ParseRule = ""
+	case "guru.oracle.v1.OracleEndpoint.transform":
+		x.Transform = nil
+	case "guru.oracle.v1.OracleEndpoint.message_type":
+		x.MessageType = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.OracleEndpoint"))
@@ -1248,6 +1708,12 @@ func (x *fastReflection_OracleEndpoint) Get(descriptor protoreflect.FieldDescrip
 	case "guru.oracle.v1.OracleEndpoint.parse_rule":
 		value := x.ParseRule
 		return protoreflect.ValueOfString(value)
+	case "guru.oracle.v1.OracleEndpoint.transform":
+		value := x.Transform
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "guru.oracle.v1.OracleEndpoint.message_type":
+		value := x.MessageType
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.OracleEndpoint"))
@@ -1272,6 +1738,10 @@ func (x *fastReflection_OracleEndpoint) Set(fd protoreflect.FieldDescriptor, val
 		x.Url = value.Interface().(string)
 	case "guru.oracle.v1.OracleEndpoint.parse_rule":
 		x.ParseRule = value.Interface().(string)
+	case "guru.oracle.v1.OracleEndpoint.transform":
+		x.Transform = value.Message().Interface().(*EndpointTransform)
+	case "guru.oracle.v1.OracleEndpoint.message_type":
+		x.MessageType = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.OracleEndpoint"))
@@ -1292,10 +1762,17 @@ func (x *fastReflection_OracleEndpoint) Set(fd protoreflect.FieldDescriptor, val
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_OracleEndpoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "guru.oracle.v1.OracleEndpoint.transform":
+		if x.Transform == nil {
+			x.Transform = new(EndpointTransform)
+		}
+		return protoreflect.ValueOfMessage(x.Transform.ProtoReflect())
 	case "guru.oracle.v1.OracleEndpoint.url":
 		panic(fmt.Errorf("field url of message guru.oracle.v1.OracleEndpoint is not mutable"))
 	case "guru.oracle.v1.OracleEndpoint.parse_rule":
 		panic(fmt.Errorf("field parse_rule of message guru.oracle.v1.OracleEndpoint is not mutable"))
+	case "guru.oracle.v1.OracleEndpoint.message_type":
+		panic(fmt.Errorf("field message_type of message guru.oracle.v1.OracleEndpoint is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.OracleEndpoint"))
@@ -1313,6 +1790,11 @@ func (x *fastReflection_OracleEndpoint) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfString("")
 	case "guru.oracle.v1.OracleEndpoint.parse_rule":
 		return protoreflect.ValueOfString("")
+	case "guru.oracle.v1.OracleEndpoint.transform":
+		m := new(EndpointTransform)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "guru.oracle.v1.OracleEndpoint.message_type":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.OracleEndpoint"))
@@ -1390,6 +1872,14 @@ func (x *fastReflection_OracleEndpoint) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Transform != nil {
+			l = options.Size(x.Transform)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MessageType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1419,6 +1909,27 @@ func (x *fastReflection_OracleEndpoint) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MessageType) > 0 {
+			i -= len(x.MessageType)
+			copy(dAtA[i:], x.MessageType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MessageType)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Transform != nil {
+			encoded, err := options.Marshal(x.Transform)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.ParseRule) > 0 {
 			i -= len(x.ParseRule)
 			copy(dAtA[i:], x.ParseRule)
@@ -1546,69 +2057,131 @@ func (x *fastReflection_OracleEndpoint) ProtoMethods() *protoiface.Methods {
 				}
 				x.ParseRule = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.Transform == nil {
+					x.Transform = &EndpointTransform{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Transform); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MessageType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MessageType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
 var (
-	md_SubmitDataSet            protoreflect.MessageDescriptor
-	fd_SubmitDataSet_request_id protoreflect.FieldDescriptor
-	fd_SubmitDataSet_nonce      protoreflect.FieldDescriptor
-	fd_SubmitDataSet_raw_data   protoreflect.FieldDescriptor
-	fd_SubmitDataSet_provider   protoreflect.FieldDescriptor
-	fd_SubmitDataSet_signature  protoreflect.FieldDescriptor
+	md_EndpointTransform        protoreflect.MessageDescriptor
+	fd_EndpointTransform_scale  protoreflect.FieldDescriptor
+	fd_EndpointTransform_invert protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_guru_oracle_v1_oracle_proto_init()
-	md_SubmitDataSet = File_guru_oracle_v1_oracle_proto.Messages().ByName("SubmitDataSet")
-	fd_SubmitDataSet_request_id = md_SubmitDataSet.Fields().ByName("request_id")
-	fd_SubmitDataSet_nonce = md_SubmitDataSet.Fields().ByName("nonce")
-	fd_SubmitDataSet_raw_data = md_SubmitDataSet.Fields().ByName("raw_data")
-	fd_SubmitDataSet_provider = md_SubmitDataSet.Fields().ByName("provider")
-	fd_SubmitDataSet_signature = md_SubmitDataSet.Fields().ByName("signature")
+	md_EndpointTransform = File_guru_oracle_v1_oracle_proto.Messages().ByName("EndpointTransform")
+	fd_EndpointTransform_scale = md_EndpointTransform.Fields().ByName("scale")
+	fd_EndpointTransform_invert = md_EndpointTransform.Fields().ByName("invert")
 }
 
-var _ protoreflect.Message = (*fastReflection_SubmitDataSet)(nil)
+var _ protoreflect.Message = (*fastReflection_EndpointTransform)(nil)
 
-type fastReflection_SubmitDataSet SubmitDataSet
+type fastReflection_EndpointTransform EndpointTransform
 
-func (x *SubmitDataSet) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SubmitDataSet)(x)
+func (x *EndpointTransform) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EndpointTransform)(x)
 }
 
-func (x *SubmitDataSet) slowProtoReflect() protoreflect.Message {
+func (x *EndpointTransform) slowProtoReflect() protoreflect.Message {
 	mi := &file_guru_oracle_v1_oracle_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1620,43 +2193,43 @@ func (x *SubmitDataSet) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SubmitDataSet_messageType fastReflection_SubmitDataSet_messageType
-var _ protoreflect.MessageType = fastReflection_SubmitDataSet_messageType{}
+var _fastReflection_EndpointTransform_messageType fastReflection_EndpointTransform_messageType
+var _ protoreflect.MessageType = fastReflection_EndpointTransform_messageType{}
 
-type fastReflection_SubmitDataSet_messageType struct{}
+type fastReflection_EndpointTransform_messageType struct{}
 
-func (x fastReflection_SubmitDataSet_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SubmitDataSet)(nil)
+func (x fastReflection_EndpointTransform_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EndpointTransform)(nil)
 }
-func (x fastReflection_SubmitDataSet_messageType) New() protoreflect.Message {
-	return new(fastReflection_SubmitDataSet)
+func (x fastReflection_EndpointTransform_messageType) New() protoreflect.Message {
+	return new(fastReflection_EndpointTransform)
 }
-func (x fastReflection_SubmitDataSet_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SubmitDataSet
+func (x fastReflection_EndpointTransform_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EndpointTransform
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SubmitDataSet) Descriptor() protoreflect.MessageDescriptor {
-	return md_SubmitDataSet
+func (x *fastReflection_EndpointTransform) Descriptor() protoreflect.MessageDescriptor {
+	return md_EndpointTransform
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SubmitDataSet) Type() protoreflect.MessageType {
-	return _fastReflection_SubmitDataSet_messageType
+func (x *fastReflection_EndpointTransform) Type() protoreflect.MessageType {
+	return _fastReflection_EndpointTransform_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SubmitDataSet) New() protoreflect.Message {
-	return new(fastReflection_SubmitDataSet)
+func (x *fastReflection_EndpointTransform) New() protoreflect.Message {
+	return new(fastReflection_EndpointTransform)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SubmitDataSet) Interface() protoreflect.ProtoMessage {
-	return (*SubmitDataSet)(x)
+func (x *fastReflection_EndpointTransform) Interface() protoreflect.ProtoMessage {
+	return (*EndpointTransform)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1664,34 +2237,16 @@ func (x *fastReflection_SubmitDataSet) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SubmitDataSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_SubmitDataSet_request_id, value) {
-			return
-		}
-	}
-	if x.Nonce != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Nonce)
-		if !f(fd_SubmitDataSet_nonce, value) {
-			return
-		}
-	}
-	if x.RawData != "" {
-		value := protoreflect.ValueOfString(x.RawData)
-		if !f(fd_SubmitDataSet_raw_data, value) {
-			return
-		}
-	}
-	if x.Provider != "" {
-		value := protoreflect.ValueOfString(x.Provider)
-		if !f(fd_SubmitDataSet_provider, value) {
+func (x *fastReflection_EndpointTransform) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Scale != "" {
+		value := protoreflect.ValueOfString(x.Scale)
+		if !f(fd_EndpointTransform_scale, value) {
 			return
 		}
 	}
-	if len(x.Signature) != 0 {
-		value := protoreflect.ValueOfBytes(x.Signature)
-		if !f(fd_SubmitDataSet_signature, value) {
+	if x.Invert != false {
+		value := protoreflect.ValueOfBool(x.Invert)
+		if !f(fd_EndpointTransform_invert, value) {
 			return
 		}
 	}
@@ -1708,23 +2263,17 @@ func (x *fastReflection_SubmitDataSet) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SubmitDataSet) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EndpointTransform) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "guru.oracle.v1.SubmitDataSet.request_id":
-		return x.RequestId != uint64(0)
-	case "guru.oracle.v1.SubmitDataSet.nonce":
-		return x.Nonce != uint64(0)
-	case "guru.oracle.v1.SubmitDataSet.raw_data":
-		return x.RawData != ""
-	case "guru.oracle.v1.SubmitDataSet.provider":
-		return x.Provider != ""
-	case "guru.oracle.v1.SubmitDataSet.signature":
-		return len(x.Signature) != 0
+	case "guru.oracle.v1.EndpointTransform.scale":
+		return x.Scale != ""
+	case "guru.oracle.v1.EndpointTransform.invert":
+		return x.Invert != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.SubmitDataSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.EndpointTransform"))
 		}
-		panic(fmt.Errorf("message guru.oracle.v1.SubmitDataSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message guru.oracle.v1.EndpointTransform does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1734,23 +2283,17 @@ func (x *fastReflection_SubmitDataSet) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SubmitDataSet) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EndpointTransform) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "guru.oracle.v1.SubmitDataSet.request_id":
-		x.RequestId = uint64(0)
-	case "guru.oracle.v1.SubmitDataSet.nonce":
-		x.Nonce = uint64(0)
-	case "guru.oracle.v1.SubmitDataSet.raw_data":
-		x.RawData = ""
-	case "guru.oracle.v1.SubmitDataSet.provider":
-		x.Provider = ""
-	case "guru.oracle.v1.SubmitDataSet.signature":
-		x.Signature = nil
+	case "guru.oracle.v1.EndpointTransform.scale":
+		x.Scale = ""
+	case "guru.oracle.v1.EndpointTransform.invert":
+		x.Invert = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.SubmitDataSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.EndpointTransform"))
 		}
-		panic(fmt.Errorf("message guru.oracle.v1.SubmitDataSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message guru.oracle.v1.EndpointTransform does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1760,28 +2303,19 @@ func (x *fastReflection_SubmitDataSet) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SubmitDataSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EndpointTransform) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "guru.oracle.v1.SubmitDataSet.request_id":
-		value := x.RequestId
-		return protoreflect.ValueOfUint64(value)
-	case "guru.oracle.v1.SubmitDataSet.nonce":
-		value := x.Nonce
-		return protoreflect.ValueOfUint64(value)
-	case "guru.oracle.v1.SubmitDataSet.raw_data":
-		value := x.RawData
+	case "guru.oracle.v1.EndpointTransform.scale":
+		value := x.Scale
 		return protoreflect.ValueOfString(value)
-	case "guru.oracle.v1.SubmitDataSet.provider":
-		value := x.Provider
-		return protoreflect.ValueOfString(value)
-	case "guru.oracle.v1.SubmitDataSet.signature":
-		value := x.Signature
-		return protoreflect.ValueOfBytes(value)
+	case "guru.oracle.v1.EndpointTransform.invert":
+		value := x.Invert
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.SubmitDataSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.EndpointTransform"))
 		}
-		panic(fmt.Errorf("message guru.oracle.v1.SubmitDataSet does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message guru.oracle.v1.EndpointTransform does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1795,23 +2329,17 @@ func (x *fastReflection_SubmitDataSet) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SubmitDataSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EndpointTransform) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "guru.oracle.v1.SubmitDataSet.request_id":
-		x.RequestId = value.Uint()
-	case "guru.oracle.v1.SubmitDataSet.nonce":
-		x.Nonce = value.Uint()
-	case "guru.oracle.v1.SubmitDataSet.raw_data":
-		x.RawData = value.Interface().(string)
-	case "guru.oracle.v1.SubmitDataSet.provider":
-		x.Provider = value.Interface().(string)
-	case "guru.oracle.v1.SubmitDataSet.signature":
-		x.Signature = value.Bytes()
+	case "guru.oracle.v1.EndpointTransform.scale":
+		x.Scale = value.Interface().(string)
+	case "guru.oracle.v1.EndpointTransform.invert":
+		x.Invert = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.SubmitDataSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.EndpointTransform"))
 		}
-		panic(fmt.Errorf("message guru.oracle.v1.SubmitDataSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message guru.oracle.v1.EndpointTransform does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1825,56 +2353,44 @@ func (x *fastReflection_SubmitDataSet) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SubmitDataSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EndpointTransform) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "guru.oracle.v1.SubmitDataSet.request_id":
-		panic(fmt.Errorf("field request_id of message guru.oracle.v1.SubmitDataSet is not mutable"))
-	case "guru.oracle.v1.SubmitDataSet.nonce":
-		panic(fmt.Errorf("field nonce of message guru.oracle.v1.SubmitDataSet is not mutable"))
-	case "guru.oracle.v1.SubmitDataSet.raw_data":
-		panic(fmt.Errorf("field raw_data of message guru.oracle.v1.SubmitDataSet is not mutable"))
-	case "guru.oracle.v1.SubmitDataSet.provider":
-		panic(fmt.Errorf("field provider of message guru.oracle.v1.SubmitDataSet is not mutable"))
-	case "guru.oracle.v1.SubmitDataSet.signature":
-		panic(fmt.Errorf("field signature of message guru.oracle.v1.SubmitDataSet is not mutable"))
+	case "guru.oracle.v1.EndpointTransform.scale":
+		panic(fmt.Errorf("field scale of message guru.oracle.v1.EndpointTransform is not mutable"))
+	case "guru.oracle.v1.EndpointTransform.invert":
+		panic(fmt.Errorf("field invert of message guru.oracle.v1.EndpointTransform is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.SubmitDataSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.EndpointTransform"))
 		}
-		panic(fmt.Errorf("message guru.oracle.v1.SubmitDataSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message guru.oracle.v1.EndpointTransform does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SubmitDataSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EndpointTransform) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "guru.oracle.v1.SubmitDataSet.request_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "guru.oracle.v1.SubmitDataSet.nonce":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "guru.oracle.v1.SubmitDataSet.raw_data":
+	case "guru.oracle.v1.EndpointTransform.scale":
 		return protoreflect.ValueOfString("")
-	case "guru.oracle.v1.SubmitDataSet.provider":
-		return protoreflect.ValueOfString("")
-	case "guru.oracle.v1.SubmitDataSet.signature":
-		return protoreflect.ValueOfBytes(nil)
+	case "guru.oracle.v1.EndpointTransform.invert":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.SubmitDataSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.EndpointTransform"))
 		}
-		panic(fmt.Errorf("message guru.oracle.v1.SubmitDataSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message guru.oracle.v1.EndpointTransform does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SubmitDataSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EndpointTransform) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in guru.oracle.v1.SubmitDataSet", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in guru.oracle.v1.EndpointTransform", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1882,7 +2398,7 @@ func (x *fastReflection_SubmitDataSet) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SubmitDataSet) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EndpointTransform) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1893,7 +2409,7 @@ func (x *fastReflection_SubmitDataSet) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SubmitDataSet) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EndpointTransform) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1905,7 +2421,7 @@ func (x *fastReflection_SubmitDataSet) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SubmitDataSet) IsValid() bool {
+func (x *fastReflection_EndpointTransform) IsValid() bool {
 	return x != nil
 }
 
@@ -1915,9 +2431,9 @@ func (x *fastReflection_SubmitDataSet) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SubmitDataSet) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EndpointTransform) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SubmitDataSet)
+		x := input.Message.Interface().(*EndpointTransform)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1929,23 +2445,12 @@ func (x *fastReflection_SubmitDataSet) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.Nonce != 0 {
-			n += 1 + runtime.Sov(uint64(x.Nonce))
-		}
-		l = len(x.RawData)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Provider)
+		l = len(x.Scale)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Signature)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Invert {
+			n += 2
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1957,7 +2462,7 @@ func (x *fastReflection_SubmitDataSet) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SubmitDataSet)
+		x := input.Message.Interface().(*EndpointTransform)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1976,36 +2481,22 @@ func (x *fastReflection_SubmitDataSet) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signature) > 0 {
-			i -= len(x.Signature)
-			copy(dAtA[i:], x.Signature)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Provider) > 0 {
-			i -= len(x.Provider)
-			copy(dAtA[i:], x.Provider)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.RawData) > 0 {
-			i -= len(x.RawData)
-			copy(dAtA[i:], x.RawData)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RawData)))
+		if x.Invert {
 			i--
-			dAtA[i] = 0x1a
-		}
-		if x.Nonce != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			if x.Invert {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+		if len(x.Scale) > 0 {
+			i -= len(x.Scale)
+			copy(dAtA[i:], x.Scale)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Scale)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2018,7 +2509,7 @@ func (x *fastReflection_SubmitDataSet) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SubmitDataSet)
+		x := input.Message.Interface().(*EndpointTransform)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2050,85 +2541,15 @@ func (x *fastReflection_SubmitDataSet) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubmitDataSet: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EndpointTransform: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubmitDataSet: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EndpointTransform: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-				}
-				x.RequestId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-				}
-				x.Nonce = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Nonce |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawData", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RawData = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2156,13 +2577,13 @@ func (x *fastReflection_SubmitDataSet) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Provider = string(dAtA[iNdEx:postIndex])
+				x.Scale = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
 				}
-				var byteLen int
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2172,26 +2593,12 @@ func (x *fastReflection_SubmitDataSet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
-				if x.Signature == nil {
-					x.Signature = []byte{}
-				}
-				iNdEx = postIndex
+				x.Invert = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2228,33 +2635,33 @@ func (x *fastReflection_SubmitDataSet) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_DataSet              protoreflect.MessageDescriptor
-	fd_DataSet_request_id   protoreflect.FieldDescriptor
-	fd_DataSet_nonce        protoreflect.FieldDescriptor
-	fd_DataSet_block_height protoreflect.FieldDescriptor
-	fd_DataSet_block_time   protoreflect.FieldDescriptor
-	fd_DataSet_raw_data     protoreflect.FieldDescriptor
+	md_SubmitDataSet            protoreflect.MessageDescriptor
+	fd_SubmitDataSet_request_id protoreflect.FieldDescriptor
+	fd_SubmitDataSet_nonce      protoreflect.FieldDescriptor
+	fd_SubmitDataSet_raw_data   protoreflect.FieldDescriptor
+	fd_SubmitDataSet_provider   protoreflect.FieldDescriptor
+	fd_SubmitDataSet_signature  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_guru_oracle_v1_oracle_proto_init()
-	md_DataSet = File_guru_oracle_v1_oracle_proto.Messages().ByName("DataSet")
-	fd_DataSet_request_id = md_DataSet.Fields().ByName("request_id")
-	fd_DataSet_nonce = md_DataSet.Fields().ByName("nonce")
-	fd_DataSet_block_height = md_DataSet.Fields().ByName("block_height")
-	fd_DataSet_block_time = md_DataSet.Fields().ByName("block_time")
-	fd_DataSet_raw_data = md_DataSet.Fields().ByName("raw_data")
+	md_SubmitDataSet = File_guru_oracle_v1_oracle_proto.Messages().ByName("SubmitDataSet")
+	fd_SubmitDataSet_request_id = md_SubmitDataSet.Fields().ByName("request_id")
+	fd_SubmitDataSet_nonce = md_SubmitDataSet.Fields().ByName("nonce")
+	fd_SubmitDataSet_raw_data = md_SubmitDataSet.Fields().ByName("raw_data")
+	fd_SubmitDataSet_provider = md_SubmitDataSet.Fields().ByName("provider")
+	fd_SubmitDataSet_signature = md_SubmitDataSet.Fields().ByName("signature")
 }
 
-var _ protoreflect.Message = (*fastReflection_DataSet)(nil)
+var _ protoreflect.Message = (*fastReflection_SubmitDataSet)(nil)
 
-type fastReflection_DataSet DataSet
+type fastReflection_SubmitDataSet SubmitDataSet
 
-func (x *DataSet) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DataSet)(x)
+func (x *SubmitDataSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SubmitDataSet)(x)
 }
 
-func (x *DataSet) slowProtoReflect() protoreflect.Message {
+func (x *SubmitDataSet) slowProtoReflect() protoreflect.Message {
 	mi := &file_guru_oracle_v1_oracle_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2266,43 +2673,43 @@ func (x *DataSet) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DataSet_messageType fastReflection_DataSet_messageType
-var _ protoreflect.MessageType = fastReflection_DataSet_messageType{}
+var _fastReflection_SubmitDataSet_messageType fastReflection_SubmitDataSet_messageType
+var _ protoreflect.MessageType = fastReflection_SubmitDataSet_messageType{}
 
-type fastReflection_DataSet_messageType struct{}
+type fastReflection_SubmitDataSet_messageType struct{}
 
-func (x fastReflection_DataSet_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DataSet)(nil)
+func (x fastReflection_SubmitDataSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SubmitDataSet)(nil)
 }
-func (x fastReflection_DataSet_messageType) New() protoreflect.Message {
-	return new(fastReflection_DataSet)
+func (x fastReflection_SubmitDataSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_SubmitDataSet)
 }
-func (x fastReflection_DataSet_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DataSet
+func (x fastReflection_SubmitDataSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubmitDataSet
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DataSet) Descriptor() protoreflect.MessageDescriptor {
-	return md_DataSet
+func (x *fastReflection_SubmitDataSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubmitDataSet
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DataSet) Type() protoreflect.MessageType {
-	return _fastReflection_DataSet_messageType
+func (x *fastReflection_SubmitDataSet) Type() protoreflect.MessageType {
+	return _fastReflection_SubmitDataSet_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DataSet) New() protoreflect.Message {
-	return new(fastReflection_DataSet)
+func (x *fastReflection_SubmitDataSet) New() protoreflect.Message {
+	return new(fastReflection_SubmitDataSet)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DataSet) Interface() protoreflect.ProtoMessage {
-	return (*DataSet)(x)
+func (x *fastReflection_SubmitDataSet) Interface() protoreflect.ProtoMessage {
+	return (*SubmitDataSet)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2310,34 +2717,34 @@ func (x *fastReflection_DataSet) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DataSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_SubmitDataSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.RequestId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_DataSet_request_id, value) {
+		if !f(fd_SubmitDataSet_request_id, value) {
 			return
 		}
 	}
 	if x.Nonce != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Nonce)
-		if !f(fd_DataSet_nonce, value) {
+		if !f(fd_SubmitDataSet_nonce, value) {
 			return
 		}
 	}
-	if x.BlockHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_DataSet_block_height, value) {
+	if x.RawData != "" {
+		value := protoreflect.ValueOfString(x.RawData)
+		if !f(fd_SubmitDataSet_raw_data, value) {
 			return
 		}
 	}
-	if x.BlockTime != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockTime)
-		if !f(fd_DataSet_block_time, value) {
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_SubmitDataSet_provider, value) {
 			return
 		}
 	}
-	if x.RawData != "" {
-		value := protoreflect.ValueOfString(x.RawData)
-		if !f(fd_DataSet_raw_data, value) {
+	if len(x.Signature) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signature)
+		if !f(fd_SubmitDataSet_signature, value) {
 			return
 		}
 	}
@@ -2354,23 +2761,23 @@ func (x *fastReflection_DataSet) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DataSet) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SubmitDataSet) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "guru.oracle.v1.DataSet.request_id":
+	case "guru.oracle.v1.SubmitDataSet.request_id":
 		return x.RequestId != uint64(0)
-	case "guru.oracle.v1.DataSet.nonce":
+	case "guru.oracle.v1.SubmitDataSet.nonce":
 		return x.Nonce != uint64(0)
-	case "guru.oracle.v1.DataSet.block_height":
-		return x.BlockHeight != uint64(0)
-	case "guru.oracle.v1.DataSet.block_time":
-		return x.BlockTime != uint64(0)
-	case "guru.oracle.v1.DataSet.raw_data":
+	case "guru.oracle.v1.SubmitDataSet.raw_data":
 		return x.RawData != ""
+	case "guru.oracle.v1.SubmitDataSet.provider":
+		return x.Provider != ""
+	case "guru.oracle.v1.SubmitDataSet.signature":
+		return len(x.Signature) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.DataSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.SubmitDataSet"))
 		}
-		panic(fmt.Errorf("message guru.oracle.v1.DataSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message guru.oracle.v1.SubmitDataSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2380,23 +2787,23 @@ func (x *fastReflection_DataSet) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DataSet) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SubmitDataSet) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "guru.oracle.v1.DataSet.request_id":
+	case "guru.oracle.v1.SubmitDataSet.request_id":
 		x.RequestId = uint64(0)
-	case "guru.oracle.v1.DataSet.nonce":
+	case "guru.oracle.v1.SubmitDataSet.nonce":
 		x.Nonce = uint64(0)
-	case "guru.oracle.v1.DataSet.block_height":
-		x.BlockHeight = uint64(0)
-	case "guru.oracle.v1.DataSet.block_time":
-		x.BlockTime = uint64(0)
-	case "guru.oracle.v1.DataSet.raw_data":
+	case "guru.oracle.v1.SubmitDataSet.raw_data":
 		x.RawData = ""
+	case "guru.oracle.v1.SubmitDataSet.provider":
+		x.Provider = ""
+	case "guru.oracle.v1.SubmitDataSet.signature":
+		x.Signature = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.DataSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.SubmitDataSet"))
 		}
-		panic(fmt.Errorf("message guru.oracle.v1.DataSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message guru.oracle.v1.SubmitDataSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2406,28 +2813,28 @@ func (x *fastReflection_DataSet) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DataSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SubmitDataSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "guru.oracle.v1.DataSet.request_id":
+	case "guru.oracle.v1.SubmitDataSet.request_id":
 		value := x.RequestId
 		return protoreflect.ValueOfUint64(value)
-	case "guru.oracle.v1.DataSet.nonce":
+	case "guru.oracle.v1.SubmitDataSet.nonce":
 		value := x.Nonce
 		return protoreflect.ValueOfUint64(value)
-	case "guru.oracle.v1.DataSet.block_height":
-		value := x.BlockHeight
-		return protoreflect.ValueOfUint64(value)
-	case "guru.oracle.v1.DataSet.block_time":
-		value := x.BlockTime
-		return protoreflect.ValueOfUint64(value)
-	case "guru.oracle.v1.DataSet.raw_data":
+	case "guru.oracle.v1.SubmitDataSet.raw_data":
 		value := x.RawData
 		return protoreflect.ValueOfString(value)
+	case "guru.oracle.v1.SubmitDataSet.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "guru.oracle.v1.SubmitDataSet.signature":
+		value := x.Signature
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.DataSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.SubmitDataSet"))
 		}
-		panic(fmt.Errorf("message guru.oracle.v1.DataSet does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message guru.oracle.v1.SubmitDataSet does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2441,23 +2848,23 @@ func (x *fastReflection_DataSet) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DataSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SubmitDataSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "guru.oracle.v1.DataSet.request_id":
+	case "guru.oracle.v1.SubmitDataSet.request_id":
 		x.RequestId = value.Uint()
-	case "guru.oracle.v1.DataSet.nonce":
+	case "guru.oracle.v1.SubmitDataSet.nonce":
 		x.Nonce = value.Uint()
-	case "guru.oracle.v1.DataSet.block_height":
-		x.BlockHeight = value.Uint()
-	case "guru.oracle.v1.DataSet.block_time":
-		x.BlockTime = value.Uint()
-	case "guru.oracle.v1.DataSet.raw_data":
+	case "guru.oracle.v1.SubmitDataSet.raw_data":
 		x.RawData = value.Interface().(string)
+	case "guru.oracle.v1.SubmitDataSet.provider":
+		x.Provider = value.Interface().(string)
+	case "guru.oracle.v1.SubmitDataSet.signature":
+		x.Signature = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.DataSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.SubmitDataSet"))
 		}
-		panic(fmt.Errorf("message guru.oracle.v1.DataSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message guru.oracle.v1.SubmitDataSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2471,56 +2878,56 @@ func (x *fastReflection_DataSet) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DataSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SubmitDataSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "guru.oracle.v1.DataSet.request_id":
-		panic(fmt.Errorf("field request_id of message guru.oracle.v1.DataSet is not mutable"))
-	case "guru.oracle.v1.DataSet.nonce":
-		panic(fmt.Errorf("field nonce of message guru.oracle.v1.DataSet is not mutable"))
-	case "guru.oracle.v1.DataSet.block_height":
-		panic(fmt.Errorf("field block_height of message guru.oracle.v1.DataSet is not mutable"))
-	case "guru.oracle.v1.DataSet.block_time":
-		panic(fmt.Errorf("field block_time of message guru.oracle.v1.DataSet is not mutable"))
-	case "guru.oracle.v1.DataSet.raw_data":
-		panic(fmt.Errorf("field raw_data of message guru.oracle.v1.DataSet is not mutable"))
+	case "guru.oracle.v1.SubmitDataSet.request_id":
+		panic(fmt.Errorf("field request_id of message guru.oracle.v1.SubmitDataSet is not mutable"))
+	case "guru.oracle.v1.SubmitDataSet.nonce":
+		panic(fmt.Errorf("field nonce of message guru.oracle.v1.SubmitDataSet is not mutable"))
+	case "guru.oracle.v1.SubmitDataSet.raw_data":
+		panic(fmt.Errorf("field raw_data of message guru.oracle.v1.SubmitDataSet is not mutable"))
+	case "guru.oracle.v1.SubmitDataSet.provider":
+		panic(fmt.Errorf("field provider of message guru.oracle.v1.SubmitDataSet is not mutable"))
+	case "guru.oracle.v1.SubmitDataSet.signature":
+		panic(fmt.Errorf("field signature of message guru.oracle.v1.SubmitDataSet is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.DataSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.SubmitDataSet"))
 		}
-		panic(fmt.Errorf("message guru.oracle.v1.DataSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message guru.oracle.v1.SubmitDataSet does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DataSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SubmitDataSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "guru.oracle.v1.DataSet.request_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "guru.oracle.v1.DataSet.nonce":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "guru.oracle.v1.DataSet.block_height":
+	case "guru.oracle.v1.SubmitDataSet.request_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "guru.oracle.v1.DataSet.block_time":
+	case "guru.oracle.v1.SubmitDataSet.nonce":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "guru.oracle.v1.DataSet.raw_data":
+	case "guru.oracle.v1.SubmitDataSet.raw_data":
 		return protoreflect.ValueOfString("")
+	case "guru.oracle.v1.SubmitDataSet.provider":
+		return protoreflect.ValueOfString("")
+	case "guru.oracle.v1.SubmitDataSet.signature":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.DataSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.SubmitDataSet"))
 		}
-		panic(fmt.Errorf("message guru.oracle.v1.DataSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message guru.oracle.v1.SubmitDataSet does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DataSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SubmitDataSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in guru.oracle.v1.DataSet", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in guru.oracle.v1.SubmitDataSet", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2528,7 +2935,7 @@ func (x *fastReflection_DataSet) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DataSet) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SubmitDataSet) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2539,7 +2946,7 @@ func (x *fastReflection_DataSet) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DataSet) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SubmitDataSet) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2551,7 +2958,7 @@ func (x *fastReflection_DataSet) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DataSet) IsValid() bool {
+func (x *fastReflection_SubmitDataSet) IsValid() bool {
 	return x != nil
 }
 
@@ -2561,9 +2968,9 @@ func (x *fastReflection_DataSet) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DataSet) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SubmitDataSet) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DataSet)
+		x := input.Message.Interface().(*SubmitDataSet)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2581,13 +2988,15 @@ func (x *fastReflection_DataSet) ProtoMethods() *protoiface.Methods {
 		if x.Nonce != 0 {
 			n += 1 + runtime.Sov(uint64(x.Nonce))
 		}
-		if x.BlockHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		l = len(x.RawData)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockTime))
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.RawData)
+		l = len(x.Signature)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2601,7 +3010,7 @@ func (x *fastReflection_DataSet) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DataSet)
+		x := input.Message.Interface().(*SubmitDataSet)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2620,22 +3029,26 @@ func (x *fastReflection_DataSet) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RawData) > 0 {
-			i -= len(x.RawData)
-			copy(dAtA[i:], x.RawData)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RawData)))
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
 			i--
 			dAtA[i] = 0x2a
 		}
-		if x.BlockTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockTime))
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x22
 		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+		if len(x.RawData) > 0 {
+			i -= len(x.RawData)
+			copy(dAtA[i:], x.RawData)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RawData)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
 		if x.Nonce != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
@@ -2658,7 +3071,7 @@ func (x *fastReflection_DataSet) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DataSet)
+		x := input.Message.Interface().(*SubmitDataSet)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2690,10 +3103,10 @@ func (x *fastReflection_DataSet) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DataSet: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubmitDataSet: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DataSet: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubmitDataSet: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2735,10 +3148,10 @@ func (x *fastReflection_DataSet) ProtoMethods() *protoiface.Methods {
 					}
 				}
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawData", wireType)
 				}
-				x.BlockHeight = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2748,16 +3161,29 @@ func (x *fastReflection_DataSet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockHeight |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RawData = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 				}
-				x.BlockTime = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2767,16 +3193,29 @@ func (x *fastReflection_DataSet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockTime |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawData", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2786,23 +3225,25 @@ func (x *fastReflection_DataSet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RawData = string(dAtA[iNdEx:postIndex])
+				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signature == nil {
+					x.Signature = []byte{}
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2839,137 +3280,1850 @@ func (x *fastReflection_DataSet) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: guru/oracle/v1/oracle.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_DataSet                  protoreflect.MessageDescriptor
+	fd_DataSet_request_id       protoreflect.FieldDescriptor
+	fd_DataSet_nonce            protoreflect.FieldDescriptor
+	fd_DataSet_block_height     protoreflect.FieldDescriptor
+	fd_DataSet_block_time       protoreflect.FieldDescriptor
+	fd_DataSet_raw_data         protoreflect.FieldDescriptor
+	fd_DataSet_aggregation_rule protoreflect.FieldDescriptor
+	fd_DataSet_raw_aggregate    protoreflect.FieldDescriptor
 )
 
-// OracleType defines the type of oracle data that can be requested and provided
-// This enum helps categorize different kinds of oracle data sources and their purposes
-type OracleType int32
+func init() {
+	file_guru_oracle_v1_oracle_proto_init()
+	md_DataSet = File_guru_oracle_v1_oracle_proto.Messages().ByName("DataSet")
+	fd_DataSet_request_id = md_DataSet.Fields().ByName("request_id")
+	fd_DataSet_nonce = md_DataSet.Fields().ByName("nonce")
+	fd_DataSet_block_height = md_DataSet.Fields().ByName("block_height")
+	fd_DataSet_block_time = md_DataSet.Fields().ByName("block_time")
+	fd_DataSet_raw_data = md_DataSet.Fields().ByName("raw_data")
+	fd_DataSet_aggregation_rule = md_DataSet.Fields().ByName("aggregation_rule")
+	fd_DataSet_raw_aggregate = md_DataSet.Fields().ByName("raw_aggregate")
+}
 
-const (
-	// Default value, should not be used
-	OracleType_ORACLE_TYPE_UNSPECIFIED OracleType = 0
-	// Minimum gas price oracle for network fee estimation
-	OracleType_ORACLE_TYPE_MIN_GAS_PRICE OracleType = 1
-	// Currency exchange rates and forex data
-	OracleType_ORACLE_TYPE_CURRENCY OracleType = 2
-	// Stock market data and indices
-	OracleType_ORACLE_TYPE_STOCK OracleType = 3
-	// Cryptocurrency prices and market data
-	OracleType_ORACLE_TYPE_CRYPTO OracleType = 4
+var _ protoreflect.Message = (*fastReflection_DataSet)(nil)
+
+type fastReflection_DataSet DataSet
+
+func (x *DataSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DataSet)(x)
+}
+
+func (x *DataSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_guru_oracle_v1_oracle_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DataSet_messageType fastReflection_DataSet_messageType
+var _ protoreflect.MessageType = fastReflection_DataSet_messageType{}
+
+type fastReflection_DataSet_messageType struct{}
+
+func (x fastReflection_DataSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DataSet)(nil)
+}
+func (x fastReflection_DataSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_DataSet)
+}
+func (x fastReflection_DataSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DataSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DataSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_DataSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DataSet) Type() protoreflect.MessageType {
+	return _fastReflection_DataSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DataSet) New() protoreflect.Message {
+	return new(fastReflection_DataSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DataSet) Interface() protoreflect.ProtoMessage {
+	return (*DataSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DataSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_DataSet_request_id, value) {
+			return
+		}
+	}
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_DataSet_nonce, value) {
+			return
+		}
+	}
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_DataSet_block_height, value) {
+			return
+		}
+	}
+	if x.BlockTime != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockTime)
+		if !f(fd_DataSet_block_time, value) {
+			return
+		}
+	}
+	if x.RawData != "" {
+		value := protoreflect.ValueOfString(x.RawData)
+		if !f(fd_DataSet_raw_data, value) {
+			return
+		}
+	}
+	if x.AggregationRule != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AggregationRule))
+		if !f(fd_DataSet_aggregation_rule, value) {
+			return
+		}
+	}
+	if x.RawAggregate != "" {
+		value := protoreflect.ValueOfString(x.RawAggregate)
+		if !f(fd_DataSet_raw_aggregate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DataSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "guru.oracle.v1.DataSet.request_id":
+		return x.RequestId != uint64(0)
+	case "guru.oracle.v1.DataSet.nonce":
+		return x.Nonce != uint64(0)
+	case "guru.oracle.v1.DataSet.block_height":
+		return x.BlockHeight != uint64(0)
+	case "guru.oracle.v1.DataSet.block_time":
+		return x.BlockTime != uint64(0)
+	case "guru.oracle.v1.DataSet.raw_data":
+		return x.RawData != ""
+	case "guru.oracle.v1.DataSet.aggregation_rule":
+		return x.AggregationRule != 0
+	case "guru.oracle.v1.DataSet.raw_aggregate":
+		return x.RawAggregate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.DataSet"))
+		}
+		panic(fmt.Errorf("message guru.oracle.v1.DataSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DataSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "guru.oracle.v1.DataSet.request_id":
+		x.RequestId = uint64(0)
+	case "guru.oracle.v1.DataSet.nonce":
+		x.Nonce = uint64(0)
+	case "guru.oracle.v1.DataSet.block_height":
+		x.BlockHeight = uint64(0)
+	case "guru.oracle.v1.DataSet.block_time":
+		x.BlockTime = uint64(0)
+	case "guru.oracle.v1.DataSet.raw_data":
+		x.RawData = ""
+	case "guru.oracle.v1.DataSet.aggregation_rule":
+		x.AggregationRule = 0
+	case "guru.oracle.v1.DataSet.raw_aggregate":
+		x.RawAggregate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.DataSet"))
+		}
+		panic(fmt.Errorf("message guru.oracle.v1.DataSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DataSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "guru.oracle.v1.DataSet.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "guru.oracle.v1.DataSet.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	case "guru.oracle.v1.DataSet.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	case "guru.oracle.v1.DataSet.block_time":
+		value := x.BlockTime
+		return protoreflect.ValueOfUint64(value)
+	case "guru.oracle.v1.DataSet.raw_data":
+		value := x.RawData
+		return protoreflect.ValueOfString(value)
+	case "guru.oracle.v1.DataSet.aggregation_rule":
+		value := x.AggregationRule
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "guru.oracle.v1.DataSet.raw_aggregate":
+		value := x.RawAggregate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.DataSet"))
+		}
+		panic(fmt.Errorf("message guru.oracle.v1.DataSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DataSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "guru.oracle.v1.DataSet.request_id":
+		x.RequestId = value.Uint()
+	case "guru.oracle.v1.DataSet.nonce":
+		x.Nonce = value.Uint()
+	case "guru.oracle.v1.DataSet.block_height":
+		x.BlockHeight = value.Uint()
+	case "guru.oracle.v1.DataSet.block_time":
+		x.BlockTime = value.Uint()
+	case "guru.oracle.v1.DataSet.raw_data":
+		x.RawData = value.Interface().(string)
+	case "guru.oracle.v1.DataSet.aggregation_rule":
+		x.AggregationRule = (AggregationRule)(value.Enum())
+	case "guru.oracle.v1.DataSet.raw_aggregate":
+		x.RawAggregate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.DataSet"))
+		}
+		panic(fmt.Errorf("message guru.oracle.v1.DataSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DataSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "guru.oracle.v1.DataSet.request_id":
+		panic(fmt.Errorf("field request_id of message guru.oracle.v1.DataSet is not mutable"))
+	case "guru.oracle.v1.DataSet.nonce":
+		panic(fmt.Errorf("field nonce of message guru.oracle.v1.DataSet is not mutable"))
+	case "guru.oracle.v1.DataSet.block_height":
+		panic(fmt.Errorf("field block_height of message guru.oracle.v1.DataSet is not mutable"))
+	case "guru.oracle.v1.DataSet.block_time":
+		panic(fmt.Errorf("field block_time of message guru.oracle.v1.DataSet is not mutable"))
+	case "guru.oracle.v1.DataSet.raw_data":
+		panic(fmt.Errorf("field raw_data of message guru.oracle.v1.DataSet is not mutable"))
+	case "guru.oracle.v1.DataSet.aggregation_rule":
+		panic(fmt.Errorf("field aggregation_rule of message guru.oracle.v1.DataSet is not mutable"))
+	case "guru.oracle.v1.DataSet.raw_aggregate":
+		panic(fmt.Errorf("field raw_aggregate of message guru.oracle.v1.DataSet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.DataSet"))
+		}
+		panic(fmt.Errorf("message guru.oracle.v1.DataSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DataSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "guru.oracle.v1.DataSet.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "guru.oracle.v1.DataSet.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "guru.oracle.v1.DataSet.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "guru.oracle.v1.DataSet.block_time":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "guru.oracle.v1.DataSet.raw_data":
+		return protoreflect.ValueOfString("")
+	case "guru.oracle.v1.DataSet.aggregation_rule":
+		return protoreflect.ValueOfEnum(0)
+	case "guru.oracle.v1.DataSet.raw_aggregate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.DataSet"))
+		}
+		panic(fmt.Errorf("message guru.oracle.v1.DataSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DataSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in guru.oracle.v1.DataSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DataSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DataSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DataSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DataSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DataSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.BlockTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockTime))
+		}
+		l = len(x.RawData)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AggregationRule != 0 {
+			n += 1 + runtime.Sov(uint64(x.AggregationRule))
+		}
+		l = len(x.RawAggregate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DataSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RawAggregate) > 0 {
+			i -= len(x.RawAggregate)
+			copy(dAtA[i:], x.RawAggregate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RawAggregate)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.AggregationRule != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AggregationRule))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.RawData) > 0 {
+			i -= len(x.RawData)
+			copy(dAtA[i:], x.RawData)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RawData)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.BlockTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockTime))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DataSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DataSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DataSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				}
+				x.BlockTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockTime |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawData", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RawData = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregationRule", wireType)
+				}
+				x.AggregationRule = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AggregationRule |= AggregationRule(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawAggregate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RawAggregate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RoundTiming                       protoreflect.MessageDescriptor
+	fd_RoundTiming_request_id            protoreflect.FieldDescriptor
+	fd_RoundTiming_samples               protoreflect.FieldDescriptor
+	fd_RoundTiming_ema_duration_seconds  protoreflect.FieldDescriptor
+	fd_RoundTiming_ema_duration_blocks   protoreflect.FieldDescriptor
+	fd_RoundTiming_last_duration_seconds protoreflect.FieldDescriptor
+	fd_RoundTiming_last_duration_blocks  protoreflect.FieldDescriptor
+	fd_RoundTiming_last_height           protoreflect.FieldDescriptor
+	fd_RoundTiming_round_start_height    protoreflect.FieldDescriptor
+	fd_RoundTiming_round_start_time      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_guru_oracle_v1_oracle_proto_init()
+	md_RoundTiming = File_guru_oracle_v1_oracle_proto.Messages().ByName("RoundTiming")
+	fd_RoundTiming_request_id = md_RoundTiming.Fields().ByName("request_id")
+	fd_RoundTiming_samples = md_RoundTiming.Fields().ByName("samples")
+	fd_RoundTiming_ema_duration_seconds = md_RoundTiming.Fields().ByName("ema_duration_seconds")
+	fd_RoundTiming_ema_duration_blocks = md_RoundTiming.Fields().ByName("ema_duration_blocks")
+	fd_RoundTiming_last_duration_seconds = md_RoundTiming.Fields().ByName("last_duration_seconds")
+	fd_RoundTiming_last_duration_blocks = md_RoundTiming.Fields().ByName("last_duration_blocks")
+	fd_RoundTiming_last_height = md_RoundTiming.Fields().ByName("last_height")
+	fd_RoundTiming_round_start_height = md_RoundTiming.Fields().ByName("round_start_height")
+	fd_RoundTiming_round_start_time = md_RoundTiming.Fields().ByName("round_start_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_RoundTiming)(nil)
+
+type fastReflection_RoundTiming RoundTiming
+
+func (x *RoundTiming) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RoundTiming)(x)
+}
+
+func (x *RoundTiming) slowProtoReflect() protoreflect.Message {
+	mi := &file_guru_oracle_v1_oracle_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RoundTiming_messageType fastReflection_RoundTiming_messageType
+var _ protoreflect.MessageType = fastReflection_RoundTiming_messageType{}
+
+type fastReflection_RoundTiming_messageType struct{}
+
+func (x fastReflection_RoundTiming_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RoundTiming)(nil)
+}
+func (x fastReflection_RoundTiming_messageType) New() protoreflect.Message {
+	return new(fastReflection_RoundTiming)
+}
+func (x fastReflection_RoundTiming_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RoundTiming
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RoundTiming) Descriptor() protoreflect.MessageDescriptor {
+	return md_RoundTiming
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RoundTiming) Type() protoreflect.MessageType {
+	return _fastReflection_RoundTiming_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RoundTiming) New() protoreflect.Message {
+	return new(fastReflection_RoundTiming)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RoundTiming) Interface() protoreflect.ProtoMessage {
+	return (*RoundTiming)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RoundTiming) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_RoundTiming_request_id, value) {
+			return
+		}
+	}
+	if x.Samples != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Samples)
+		if !f(fd_RoundTiming_samples, value) {
+			return
+		}
+	}
+	if x.EmaDurationSeconds != "" {
+		value := protoreflect.ValueOfString(x.EmaDurationSeconds)
+		if !f(fd_RoundTiming_ema_duration_seconds, value) {
+			return
+		}
+	}
+	if x.EmaDurationBlocks != "" {
+		value := protoreflect.ValueOfString(x.EmaDurationBlocks)
+		if !f(fd_RoundTiming_ema_duration_blocks, value) {
+			return
+		}
+	}
+	if x.LastDurationSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastDurationSeconds)
+		if !f(fd_RoundTiming_last_duration_seconds, value) {
+			return
+		}
+	}
+	if x.LastDurationBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastDurationBlocks)
+		if !f(fd_RoundTiming_last_duration_blocks, value) {
+			return
+		}
+	}
+	if x.LastHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastHeight)
+		if !f(fd_RoundTiming_last_height, value) {
+			return
+		}
+	}
+	if x.RoundStartHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RoundStartHeight)
+		if !f(fd_RoundTiming_round_start_height, value) {
+			return
+		}
+	}
+	if x.RoundStartTime != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RoundStartTime)
+		if !f(fd_RoundTiming_round_start_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RoundTiming) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "guru.oracle.v1.RoundTiming.request_id":
+		return x.RequestId != uint64(0)
+	case "guru.oracle.v1.RoundTiming.samples":
+		return x.Samples != uint64(0)
+	case "guru.oracle.v1.RoundTiming.ema_duration_seconds":
+		return x.EmaDurationSeconds != ""
+	case "guru.oracle.v1.RoundTiming.ema_duration_blocks":
+		return x.EmaDurationBlocks != ""
+	case "guru.oracle.v1.RoundTiming.last_duration_seconds":
+		return x.LastDurationSeconds != uint64(0)
+	case "guru.oracle.v1.RoundTiming.last_duration_blocks":
+		return x.LastDurationBlocks != uint64(0)
+	case "guru.oracle.v1.RoundTiming.last_height":
+		return x.LastHeight != uint64(0)
+	case "guru.oracle.v1.RoundTiming.round_start_height":
+		return x.RoundStartHeight != uint64(0)
+	case "guru.oracle.v1.RoundTiming.round_start_time":
+		return x.RoundStartTime != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.RoundTiming"))
+		}
+		panic(fmt.Errorf("message guru.oracle.v1.RoundTiming does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoundTiming) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "guru.oracle.v1.RoundTiming.request_id":
+		x.RequestId = uint64(0)
+	case "guru.oracle.v1.RoundTiming.samples":
+		x.Samples = uint64(0)
+	case "guru.oracle.v1.RoundTiming.ema_duration_seconds":
+		x.EmaDurationSeconds = ""
+	case "guru.oracle.v1.RoundTiming.ema_duration_blocks":
+		x.EmaDurationBlocks = ""
+	case "guru.oracle.v1.RoundTiming.last_duration_seconds":
+		x.LastDurationSeconds = uint64(0)
+	case "guru.oracle.v1.RoundTiming.last_duration_blocks":
+		x.LastDurationBlocks = uint64(0)
+	case "guru.oracle.v1.RoundTiming.last_height":
+		x.LastHeight = uint64(0)
+	case "guru.oracle.v1.RoundTiming.round_start_height":
+		x.RoundStartHeight = uint64(0)
+	case "guru.oracle.v1.RoundTiming.round_start_time":
+		x.RoundStartTime = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.RoundTiming"))
+		}
+		panic(fmt.Errorf("message guru.oracle.v1.RoundTiming does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RoundTiming) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "guru.oracle.v1.RoundTiming.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "guru.oracle.v1.RoundTiming.samples":
+		value := x.Samples
+		return protoreflect.ValueOfUint64(value)
+	case "guru.oracle.v1.RoundTiming.ema_duration_seconds":
+		value := x.EmaDurationSeconds
+		return protoreflect.ValueOfString(value)
+	case "guru.oracle.v1.RoundTiming.ema_duration_blocks":
+		value := x.EmaDurationBlocks
+		return protoreflect.ValueOfString(value)
+	case "guru.oracle.v1.RoundTiming.last_duration_seconds":
+		value := x.LastDurationSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "guru.oracle.v1.RoundTiming.last_duration_blocks":
+		value := x.LastDurationBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "guru.oracle.v1.RoundTiming.last_height":
+		value := x.LastHeight
+		return protoreflect.ValueOfUint64(value)
+	case "guru.oracle.v1.RoundTiming.round_start_height":
+		value := x.RoundStartHeight
+		return protoreflect.ValueOfUint64(value)
+	case "guru.oracle.v1.RoundTiming.round_start_time":
+		value := x.RoundStartTime
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.RoundTiming"))
+		}
+		panic(fmt.Errorf("message guru.oracle.v1.RoundTiming does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoundTiming) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "guru.oracle.v1.RoundTiming.request_id":
+		x.RequestId = value.Uint()
+	case "guru.oracle.v1.RoundTiming.samples":
+		x.Samples = value.Uint()
+	case "guru.oracle.v1.RoundTiming.ema_duration_seconds":
+		x.EmaDurationSeconds = value.Interface().(string)
+	case "guru.oracle.v1.RoundTiming.ema_duration_blocks":
+		x.EmaDurationBlocks = value.Interface().(string)
+	case "guru.oracle.v1.RoundTiming.last_duration_seconds":
+		x.LastDurationSeconds = value.Uint()
+	case "guru.oracle.v1.RoundTiming.last_duration_blocks":
+		x.LastDurationBlocks = value.Uint()
+	case "guru.oracle.v1.RoundTiming.last_height":
+		x.LastHeight = value.Uint()
+	case "guru.oracle.v1.RoundTiming.round_start_height":
+		x.RoundStartHeight = value.Uint()
+	case "guru.oracle.v1.RoundTiming.round_start_time":
+		x.RoundStartTime = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.RoundTiming"))
+		}
+		panic(fmt.Errorf("message guru.oracle.v1.RoundTiming does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoundTiming) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "guru.oracle.v1.RoundTiming.request_id":
+		panic(fmt.Errorf("field request_id of message guru.oracle.v1.RoundTiming is not mutable"))
+	case "guru.oracle.v1.RoundTiming.samples":
+		panic(fmt.Errorf("field samples of message guru.oracle.v1.RoundTiming is not mutable"))
+	case "guru.oracle.v1.RoundTiming.ema_duration_seconds":
+		panic(fmt.Errorf("field ema_duration_seconds of message guru.oracle.v1.RoundTiming is not mutable"))
+	case "guru.oracle.v1.RoundTiming.ema_duration_blocks":
+		panic(fmt.Errorf("field ema_duration_blocks of message guru.oracle.v1.RoundTiming is not mutable"))
+	case "guru.oracle.v1.RoundTiming.last_duration_seconds":
+		panic(fmt.Errorf("field last_duration_seconds of message guru.oracle.v1.RoundTiming is not mutable"))
+	case "guru.oracle.v1.RoundTiming.last_duration_blocks":
+		panic(fmt.Errorf("field last_duration_blocks of message guru.oracle.v1.RoundTiming is not mutable"))
+	case "guru.oracle.v1.RoundTiming.last_height":
+		panic(fmt.Errorf("field last_height of message guru.oracle.v1.RoundTiming is not mutable"))
+	case "guru.oracle.v1.RoundTiming.round_start_height":
+		panic(fmt.Errorf("field round_start_height of message guru.oracle.v1.RoundTiming is not mutable"))
+	case "guru.oracle.v1.RoundTiming.round_start_time":
+		panic(fmt.Errorf("field round_start_time of message guru.oracle.v1.RoundTiming is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.RoundTiming"))
+		}
+		panic(fmt.Errorf("message guru.oracle.v1.RoundTiming does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RoundTiming) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "guru.oracle.v1.RoundTiming.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "guru.oracle.v1.RoundTiming.samples":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "guru.oracle.v1.RoundTiming.ema_duration_seconds":
+		return protoreflect.ValueOfString("")
+	case "guru.oracle.v1.RoundTiming.ema_duration_blocks":
+		return protoreflect.ValueOfString("")
+	case "guru.oracle.v1.RoundTiming.last_duration_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "guru.oracle.v1.RoundTiming.last_duration_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "guru.oracle.v1.RoundTiming.last_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "guru.oracle.v1.RoundTiming.round_start_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "guru.oracle.v1.RoundTiming.round_start_time":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: guru.oracle.v1.RoundTiming"))
+		}
+		panic(fmt.Errorf("message guru.oracle.v1.RoundTiming does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RoundTiming) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in guru.oracle.v1.RoundTiming", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RoundTiming) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoundTiming) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RoundTiming) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RoundTiming) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RoundTiming)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.Samples != 0 {
+			n += 1 + runtime.Sov(uint64(x.Samples))
+		}
+		l = len(x.EmaDurationSeconds)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EmaDurationBlocks)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastDurationSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastDurationSeconds))
+		}
+		if x.LastDurationBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastDurationBlocks))
+		}
+		if x.LastHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastHeight))
+		}
+		if x.RoundStartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.RoundStartHeight))
+		}
+		if x.RoundStartTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.RoundStartTime))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RoundTiming)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RoundStartTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RoundStartTime))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.RoundStartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RoundStartHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.LastHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.LastDurationBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastDurationBlocks))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.LastDurationSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastDurationSeconds))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.EmaDurationBlocks) > 0 {
+			i -= len(x.EmaDurationBlocks)
+			copy(dAtA[i:], x.EmaDurationBlocks)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EmaDurationBlocks)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.EmaDurationSeconds) > 0 {
+			i -= len(x.EmaDurationSeconds)
+			copy(dAtA[i:], x.EmaDurationSeconds)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EmaDurationSeconds)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Samples != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Samples))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RoundTiming)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RoundTiming: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RoundTiming: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+				}
+				x.Samples = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Samples |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmaDurationSeconds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EmaDurationSeconds = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmaDurationBlocks", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EmaDurationBlocks = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastDurationSeconds", wireType)
+				}
+				x.LastDurationSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastDurationSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastDurationBlocks", wireType)
+				}
+				x.LastDurationBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastDurationBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+				}
+				x.LastHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoundStartHeight", wireType)
+				}
+				x.RoundStartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RoundStartHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoundStartTime", wireType)
+				}
+				x.RoundStartTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RoundStartTime |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: guru/oracle/v1/oracle.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OracleType defines the type of oracle data that can be requested and provided
+// This enum helps categorize different kinds of oracle data sources and their purposes
+type OracleType int32
+
+const (
+	// Default value, should not be used
+	OracleType_ORACLE_TYPE_UNSPECIFIED OracleType = 0
+	// Minimum gas price oracle for network fee estimation
+	OracleType_ORACLE_TYPE_MIN_GAS_PRICE OracleType = 1
+	// Currency exchange rates and forex data
+	OracleType_ORACLE_TYPE_CURRENCY OracleType = 2
+	// Stock market data and indices
+	OracleType_ORACLE_TYPE_STOCK OracleType = 3
+	// Cryptocurrency prices and market data
+	OracleType_ORACLE_TYPE_CRYPTO OracleType = 4
+)
+
+// Enum value maps for OracleType.
+var (
+	OracleType_name = map[int32]string{
+		0: "ORACLE_TYPE_UNSPECIFIED",
+		1: "ORACLE_TYPE_MIN_GAS_PRICE",
+		2: "ORACLE_TYPE_CURRENCY",
+		3: "ORACLE_TYPE_STOCK",
+		4: "ORACLE_TYPE_CRYPTO",
+	}
+	OracleType_value = map[string]int32{
+		"ORACLE_TYPE_UNSPECIFIED":   0,
+		"ORACLE_TYPE_MIN_GAS_PRICE": 1,
+		"ORACLE_TYPE_CURRENCY":      2,
+		"ORACLE_TYPE_STOCK":         3,
+		"ORACLE_TYPE_CRYPTO":        4,
+	}
+)
+
+func (x OracleType) Enum() *OracleType {
+	p := new(OracleType)
+	*p = x
+	return p
+}
+
+func (x OracleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OracleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_guru_oracle_v1_oracle_proto_enumTypes[0].Descriptor()
+}
+
+func (OracleType) Type() protoreflect.EnumType {
+	return &file_guru_oracle_v1_oracle_proto_enumTypes[0]
+}
+
+func (x OracleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OracleType.Descriptor instead.
+func (OracleType) EnumDescriptor() ([]byte, []int) {
+	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+}
+
+// RequestStatus defines the current state of an oracle request
+// This helps track the lifecycle of oracle data requests
+type RequestStatus int32
+
+const (
+	// Default value, should not be used
+	RequestStatus_REQUEST_STATUS_UNSPECIFIED RequestStatus = 0
+	// Request is enabled
+	RequestStatus_REQUEST_STATUS_ENABLED RequestStatus = 1
+	// Request is paused
+	RequestStatus_REQUEST_STATUS_PAUSED RequestStatus = 2
+	// Request is disabled
+	RequestStatus_REQUEST_STATUS_DISABLED RequestStatus = 3
+)
+
+// Enum value maps for RequestStatus.
+var (
+	RequestStatus_name = map[int32]string{
+		0: "REQUEST_STATUS_UNSPECIFIED",
+		1: "REQUEST_STATUS_ENABLED",
+		2: "REQUEST_STATUS_PAUSED",
+		3: "REQUEST_STATUS_DISABLED",
+	}
+	RequestStatus_value = map[string]int32{
+		"REQUEST_STATUS_UNSPECIFIED": 0,
+		"REQUEST_STATUS_ENABLED":     1,
+		"REQUEST_STATUS_PAUSED":      2,
+		"REQUEST_STATUS_DISABLED":    3,
+	}
+)
+
+func (x RequestStatus) Enum() *RequestStatus {
+	p := new(RequestStatus)
+	*p = x
+	return p
+}
+
+func (x RequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_guru_oracle_v1_oracle_proto_enumTypes[1].Descriptor()
+}
+
+func (RequestStatus) Type() protoreflect.EnumType {
+	return &file_guru_oracle_v1_oracle_proto_enumTypes[1]
+}
+
+func (x RequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RequestStatus.Descriptor instead.
+func (RequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+}
+
+// EndpointAssignment defines how providers are mapped to endpoints when the
+// account list and endpoint list differ in length
+type EndpointAssignment int32
+
+const (
+	// Default value, behaves like ENDPOINT_ASSIGNMENT_WRAP
+	EndpointAssignment_ENDPOINT_ASSIGNMENT_UNSPECIFIED EndpointAssignment = 0
+	// Providers wrap around the endpoint list, so several may share an endpoint
+	EndpointAssignment_ENDPOINT_ASSIGNMENT_WRAP EndpointAssignment = 1
+	// The account list and endpoint list must have the same length
+	EndpointAssignment_ENDPOINT_ASSIGNMENT_STRICT EndpointAssignment = 2
+)
+
+// Enum value maps for EndpointAssignment.
+var (
+	EndpointAssignment_name = map[int32]string{
+		0: "ENDPOINT_ASSIGNMENT_UNSPECIFIED",
+		1: "ENDPOINT_ASSIGNMENT_WRAP",
+		2: "ENDPOINT_ASSIGNMENT_STRICT",
+	}
+	EndpointAssignment_value = map[string]int32{
+		"ENDPOINT_ASSIGNMENT_UNSPECIFIED": 0,
+		"ENDPOINT_ASSIGNMENT_WRAP":        1,
+		"ENDPOINT_ASSIGNMENT_STRICT":      2,
+	}
+)
+
+func (x EndpointAssignment) Enum() *EndpointAssignment {
+	p := new(EndpointAssignment)
+	*p = x
+	return p
+}
+
+func (x EndpointAssignment) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EndpointAssignment) Descriptor() protoreflect.EnumDescriptor {
+	return file_guru_oracle_v1_oracle_proto_enumTypes[2].Descriptor()
+}
+
+func (EndpointAssignment) Type() protoreflect.EnumType {
+	return &file_guru_oracle_v1_oracle_proto_enumTypes[2]
+}
+
+func (x EndpointAssignment) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EndpointAssignment.Descriptor instead.
+func (EndpointAssignment) EnumDescriptor() ([]byte, []int) {
+	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+}
+
+// QuorumMode defines how a round decides that enough providers reported
+type QuorumMode int32
+
+const (
+	// Default value, behaves like QUORUM_MODE_COUNT
+	QuorumMode_QUORUM_MODE_UNSPECIFIED QuorumMode = 0
+	// At least quorum providers must report
+	QuorumMode_QUORUM_MODE_COUNT QuorumMode = 1
+	// The reporting providers must hold at least quorum_stake_fraction of the
+	// bonded stake of all providers in the account list
+	QuorumMode_QUORUM_MODE_STAKE_WEIGHTED QuorumMode = 2
+	// The single provider in the account list is trusted: its submission
+	// finalizes the round without outlier filtering. Only allowed for the
+	// oracle types listed in the trusted_provider_types param
+	QuorumMode_QUORUM_MODE_TRUSTED_PROVIDER QuorumMode = 3
 )
 
-// Enum value maps for OracleType.
+// Enum value maps for QuorumMode.
 var (
-	OracleType_name = map[int32]string{
-		0: "ORACLE_TYPE_UNSPECIFIED",
-		1: "ORACLE_TYPE_MIN_GAS_PRICE",
-		2: "ORACLE_TYPE_CURRENCY",
-		3: "ORACLE_TYPE_STOCK",
-		4: "ORACLE_TYPE_CRYPTO",
+	QuorumMode_name = map[int32]string{
+		0: "QUORUM_MODE_UNSPECIFIED",
+		1: "QUORUM_MODE_COUNT",
+		2: "QUORUM_MODE_STAKE_WEIGHTED",
+		3: "QUORUM_MODE_TRUSTED_PROVIDER",
 	}
-	OracleType_value = map[string]int32{
-		"ORACLE_TYPE_UNSPECIFIED":   0,
-		"ORACLE_TYPE_MIN_GAS_PRICE": 1,
-		"ORACLE_TYPE_CURRENCY":      2,
-		"ORACLE_TYPE_STOCK":         3,
-		"ORACLE_TYPE_CRYPTO":        4,
+	QuorumMode_value = map[string]int32{
+		"QUORUM_MODE_UNSPECIFIED":      0,
+		"QUORUM_MODE_COUNT":            1,
+		"QUORUM_MODE_STAKE_WEIGHTED":   2,
+		"QUORUM_MODE_TRUSTED_PROVIDER": 3,
 	}
 )
 
-func (x OracleType) Enum() *OracleType {
-	p := new(OracleType)
+func (x QuorumMode) Enum() *QuorumMode {
+	p := new(QuorumMode)
 	*p = x
 	return p
 }
 
-func (x OracleType) String() string {
+func (x QuorumMode) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (OracleType) Descriptor() protoreflect.EnumDescriptor {
-	return file_guru_oracle_v1_oracle_proto_enumTypes[0].Descriptor()
+func (QuorumMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_guru_oracle_v1_oracle_proto_enumTypes[3].Descriptor()
 }
 
-func (OracleType) Type() protoreflect.EnumType {
-	return &file_guru_oracle_v1_oracle_proto_enumTypes[0]
+func (QuorumMode) Type() protoreflect.EnumType {
+	return &file_guru_oracle_v1_oracle_proto_enumTypes[3]
 }
 
-func (x OracleType) Number() protoreflect.EnumNumber {
+func (x QuorumMode) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use OracleType.Descriptor instead.
-func (OracleType) EnumDescriptor() ([]byte, []int) {
-	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QuorumMode.Descriptor instead.
+func (QuorumMode) EnumDescriptor() ([]byte, []int) {
+	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
 }
 
-// RequestStatus defines the current state of an oracle request
-// This helps track the lifecycle of oracle data requests
-type RequestStatus int32
+// CoverageMode defines whether a round may finalize without every provider
+type CoverageMode int32
 
 const (
-	// Default value, should not be used
-	RequestStatus_REQUEST_STATUS_UNSPECIFIED RequestStatus = 0
-	// Request is enabled
-	RequestStatus_REQUEST_STATUS_ENABLED RequestStatus = 1
-	// Request is paused
-	RequestStatus_REQUEST_STATUS_PAUSED RequestStatus = 2
-	// Request is disabled
-	RequestStatus_REQUEST_STATUS_DISABLED RequestStatus = 3
+	// Default value, behaves like COVERAGE_MODE_QUORUM
+	CoverageMode_COVERAGE_MODE_UNSPECIFIED CoverageMode = 0
+	// The round finalizes once the quorum is met
+	CoverageMode_COVERAGE_MODE_QUORUM CoverageMode = 1
+	// The round only finalizes once every provider in the account list
+	// reported and no report was excluded as an outlier; otherwise it stays
+	// open and providers retry
+	CoverageMode_COVERAGE_MODE_FULL CoverageMode = 2
 )
 
-// Enum value maps for RequestStatus.
+// Enum value maps for CoverageMode.
 var (
-	RequestStatus_name = map[int32]string{
-		0: "REQUEST_STATUS_UNSPECIFIED",
-		1: "REQUEST_STATUS_ENABLED",
-		2: "REQUEST_STATUS_PAUSED",
-		3: "REQUEST_STATUS_DISABLED",
+	CoverageMode_name = map[int32]string{
+		0: "COVERAGE_MODE_UNSPECIFIED",
+		1: "COVERAGE_MODE_QUORUM",
+		2: "COVERAGE_MODE_FULL",
 	}
-	RequestStatus_value = map[string]int32{
-		"REQUEST_STATUS_UNSPECIFIED": 0,
-		"REQUEST_STATUS_ENABLED":     1,
-		"REQUEST_STATUS_PAUSED":      2,
-		"REQUEST_STATUS_DISABLED":    3,
+	CoverageMode_value = map[string]int32{
+		"COVERAGE_MODE_UNSPECIFIED": 0,
+		"COVERAGE_MODE_QUORUM":      1,
+		"COVERAGE_MODE_FULL":        2,
 	}
 )
 
-func (x RequestStatus) Enum() *RequestStatus {
-	p := new(RequestStatus)
+func (x CoverageMode) Enum() *CoverageMode {
+	p := new(CoverageMode)
 	*p = x
 	return p
 }
 
-func (x RequestStatus) String() string {
+func (x CoverageMode) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (RequestStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_guru_oracle_v1_oracle_proto_enumTypes[1].Descriptor()
+func (CoverageMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_guru_oracle_v1_oracle_proto_enumTypes[4].Descriptor()
 }
 
-func (RequestStatus) Type() protoreflect.EnumType {
-	return &file_guru_oracle_v1_oracle_proto_enumTypes[1]
+func (CoverageMode) Type() protoreflect.EnumType {
+	return &file_guru_oracle_v1_oracle_proto_enumTypes[4]
 }
 
-func (x RequestStatus) Number() protoreflect.EnumNumber {
+func (x CoverageMode) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use RequestStatus.Descriptor instead.
-func (RequestStatus) EnumDescriptor() ([]byte, []int) {
-	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use CoverageMode.Descriptor instead.
+func (CoverageMode) EnumDescriptor() ([]byte, []int) {
+	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
 }
 
 // AggregationRule defines the enumeration for aggregating oracle data
@@ -2987,6 +5141,9 @@ const (
 	AggregationRule_AGGREGATION_RULE_MAX AggregationRule = 3
 	// Use median value to aggregate the data
 	AggregationRule_AGGREGATION_RULE_MEDIAN AggregationRule = 4
+	// Use the median weighted by the bonded stake of each submitting provider.
+	// Fails when no submitting provider has bonded stake, so it is usually followed by a fallback rule.
+	AggregationRule_AGGREGATION_RULE_STAKE_WEIGHTED_MEDIAN AggregationRule = 5
 )
 
 // Enum value maps for AggregationRule.
@@ -2997,13 +5154,15 @@ var (
 		2: "AGGREGATION_RULE_MIN",
 		3: "AGGREGATION_RULE_MAX",
 		4: "AGGREGATION_RULE_MEDIAN",
+		5: "AGGREGATION_RULE_STAKE_WEIGHTED_MEDIAN",
 	}
 	AggregationRule_value = map[string]int32{
-		"AGGREGATION_RULE_UNSPECIFIED": 0,
-		"AGGREGATION_RULE_AVG":         1,
-		"AGGREGATION_RULE_MIN":         2,
-		"AGGREGATION_RULE_MAX":         3,
-		"AGGREGATION_RULE_MEDIAN":      4,
+		"AGGREGATION_RULE_UNSPECIFIED":           0,
+		"AGGREGATION_RULE_AVG":                   1,
+		"AGGREGATION_RULE_MIN":                   2,
+		"AGGREGATION_RULE_MAX":                   3,
+		"AGGREGATION_RULE_MEDIAN":                4,
+		"AGGREGATION_RULE_STAKE_WEIGHTED_MEDIAN": 5,
 	}
 )
 
@@ -3018,11 +5177,11 @@ func (x AggregationRule) String() string {
 }
 
 func (AggregationRule) Descriptor() protoreflect.EnumDescriptor {
-	return file_guru_oracle_v1_oracle_proto_enumTypes[2].Descriptor()
+	return file_guru_oracle_v1_oracle_proto_enumTypes[5].Descriptor()
 }
 
 func (AggregationRule) Type() protoreflect.EnumType {
-	return &file_guru_oracle_v1_oracle_proto_enumTypes[2]
+	return &file_guru_oracle_v1_oracle_proto_enumTypes[5]
 }
 
 func (x AggregationRule) Number() protoreflect.EnumNumber {
@@ -3031,7 +5190,65 @@ func (x AggregationRule) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AggregationRule.Descriptor instead.
 func (AggregationRule) EnumDescriptor() ([]byte, []int) {
-	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
+}
+
+// ResultFormat defines which form of the aggregated value a request emits in
+// its completion event
+type ResultFormat int32
+
+const (
+	// Default value, behaves like RESULT_FORMAT_NORMALIZED
+	ResultFormat_RESULT_FORMAT_UNSPECIFIED ResultFormat = 0
+	// The canonical decimal rounded to 18 places (e.g. "0.333333333333333333")
+	ResultFormat_RESULT_FORMAT_NORMALIZED ResultFormat = 1
+	// The aggregate before rounding, as computed from the submissions
+	ResultFormat_RESULT_FORMAT_RAW ResultFormat = 2
+	// The canonical decimal and the aggregate before rounding
+	ResultFormat_RESULT_FORMAT_BOTH ResultFormat = 3
+)
+
+// Enum value maps for ResultFormat.
+var (
+	ResultFormat_name = map[int32]string{
+		0: "RESULT_FORMAT_UNSPECIFIED",
+		1: "RESULT_FORMAT_NORMALIZED",
+		2: "RESULT_FORMAT_RAW",
+		3: "RESULT_FORMAT_BOTH",
+	}
+	ResultFormat_value = map[string]int32{
+		"RESULT_FORMAT_UNSPECIFIED": 0,
+		"RESULT_FORMAT_NORMALIZED":  1,
+		"RESULT_FORMAT_RAW":         2,
+		"RESULT_FORMAT_BOTH":        3,
+	}
+)
+
+func (x ResultFormat) Enum() *ResultFormat {
+	p := new(ResultFormat)
+	*p = x
+	return p
+}
+
+func (x ResultFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResultFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_guru_oracle_v1_oracle_proto_enumTypes[6].Descriptor()
+}
+
+func (ResultFormat) Type() protoreflect.EnumType {
+	return &file_guru_oracle_v1_oracle_proto_enumTypes[6]
+}
+
+func (x ResultFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResultFormat.Descriptor instead.
+func (ResultFormat) EnumDescriptor() ([]byte, []int) {
+	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
 }
 
 // OracleRequestDoc defines the structure for oracle request documents
@@ -3063,6 +5280,19 @@ type OracleRequestDoc struct {
 	Status RequestStatus `protobuf:"varint,10,opt,name=status,proto3,enum=guru.oracle.v1.RequestStatus" json:"status,omitempty"`
 	// Sequential number to ensure data freshness
 	Nonce uint64 `protobuf:"varint,12,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// How providers are assigned to endpoints
+	EndpointAssignment EndpointAssignment `protobuf:"varint,13,opt,name=endpoint_assignment,json=endpointAssignment,proto3,enum=guru.oracle.v1.EndpointAssignment" json:"endpoint_assignment,omitempty"`
+	// How the quorum of a round is decided
+	QuorumMode QuorumMode `protobuf:"varint,14,opt,name=quorum_mode,json=quorumMode,proto3,enum=guru.oracle.v1.QuorumMode" json:"quorum_mode,omitempty"`
+	// Decimal fraction in (0, 1] of the providers' bonded stake required with
+	// QUORUM_MODE_STAKE_WEIGHTED (e.g. "0.67")
+	QuorumStakeFraction string `protobuf:"bytes,15,opt,name=quorum_stake_fraction,json=quorumStakeFraction,proto3" json:"quorum_stake_fraction,omitempty"`
+	// Rules tried in order when aggregation_rule fails to produce a result
+	FallbackRules []AggregationRule `protobuf:"varint,16,rep,packed,name=fallback_rules,json=fallbackRules,proto3,enum=guru.oracle.v1.AggregationRule" json:"fallback_rules,omitempty"`
+	// Form of the aggregated value emitted when a round completes
+	ResultFormat ResultFormat `protobuf:"varint,17,opt,name=result_format,json=resultFormat,proto3,enum=guru.oracle.v1.ResultFormat" json:"result_format,omitempty"`
+	// Whether a round may finalize with partial provider coverage
+	CoverageMode CoverageMode `protobuf:"varint,18,opt,name=coverage_mode,json=coverageMode,proto3,enum=guru.oracle.v1.CoverageMode" json:"coverage_mode,omitempty"`
 }
 
 func (x *OracleRequestDoc) Reset() {
@@ -3162,6 +5392,48 @@ func (x *OracleRequestDoc) GetNonce() uint64 {
 	return 0
 }
 
+func (x *OracleRequestDoc) GetEndpointAssignment() EndpointAssignment {
+	if x != nil {
+		return x.EndpointAssignment
+	}
+	return EndpointAssignment_ENDPOINT_ASSIGNMENT_UNSPECIFIED
+}
+
+func (x *OracleRequestDoc) GetQuorumMode() QuorumMode {
+	if x != nil {
+		return x.QuorumMode
+	}
+	return QuorumMode_QUORUM_MODE_UNSPECIFIED
+}
+
+func (x *OracleRequestDoc) GetQuorumStakeFraction() string {
+	if x != nil {
+		return x.QuorumStakeFraction
+	}
+	return ""
+}
+
+func (x *OracleRequestDoc) GetFallbackRules() []AggregationRule {
+	if x != nil {
+		return x.FallbackRules
+	}
+	return nil
+}
+
+func (x *OracleRequestDoc) GetResultFormat() ResultFormat {
+	if x != nil {
+		return x.ResultFormat
+	}
+	return ResultFormat_RESULT_FORMAT_UNSPECIFIED
+}
+
+func (x *OracleRequestDoc) GetCoverageMode() CoverageMode {
+	if x != nil {
+		return x.CoverageMode
+	}
+	return CoverageMode_COVERAGE_MODE_UNSPECIFIED
+}
+
 type OracleEndpoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3171,6 +5443,12 @@ type OracleEndpoint struct {
 	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	// Type of the oracle endpoint
 	ParseRule string `protobuf:"bytes,2,opt,name=parse_rule,json=parseRule,proto3" json:"parse_rule,omitempty"`
+	// Optional transform applied to the extracted value before submission
+	Transform *EndpointTransform `protobuf:"bytes,3,opt,name=transform,proto3" json:"transform,omitempty"`
+	// Fully qualified name of a protobuf message type (e.g. "example.feed.v1.Quote").
+	// When set, the response body is decoded as that message and parse_rule addresses its fields
+	// by their proto names. The type must be registered in the oracle daemon.
+	MessageType string `protobuf:"bytes,4,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
 }
 
 func (x *OracleEndpoint) Reset() {
@@ -3207,6 +5485,67 @@ func (x *OracleEndpoint) GetParseRule() string {
 	return ""
 }
 
+func (x *OracleEndpoint) GetTransform() *EndpointTransform {
+	if x != nil {
+		return x.Transform
+	}
+	return nil
+}
+
+func (x *OracleEndpoint) GetMessageType() string {
+	if x != nil {
+		return x.MessageType
+	}
+	return ""
+}
+
+// EndpointTransform defines a numeric transform applied to an extracted value.
+// When both are set, the value is inverted first and then scaled.
+type EndpointTransform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Decimal multiplier applied to the value (e.g. "1000" for a feed reported in thousands)
+	Scale string `protobuf:"bytes,1,opt,name=scale,proto3" json:"scale,omitempty"`
+	// Replace the value with its reciprocal (e.g. USD/KRW -> KRW/USD)
+	Invert bool `protobuf:"varint,2,opt,name=invert,proto3" json:"invert,omitempty"`
+}
+
+func (x *EndpointTransform) Reset() {
+	*x = EndpointTransform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_guru_oracle_v1_oracle_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndpointTransform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndpointTransform) ProtoMessage() {}
+
+// Deprecated: Use EndpointTransform.ProtoReflect.Descriptor instead.
+func (*EndpointTransform) Descriptor() ([]byte, []int) {
+	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EndpointTransform) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+func (x *EndpointTransform) GetInvert() bool {
+	if x != nil {
+		return x.Invert
+	}
+	return false
+}
+
 // SubmitDataSet defines the structure for oracle data sets for submit
 // This represents a single data point provided by an oracle node
 type SubmitDataSet struct {
@@ -3229,7 +5568,7 @@ type SubmitDataSet struct {
 func (x *SubmitDataSet) Reset() {
 	*x = SubmitDataSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_guru_oracle_v1_oracle_proto_msgTypes[2]
+		mi := &file_guru_oracle_v1_oracle_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3243,7 +5582,7 @@ func (*SubmitDataSet) ProtoMessage() {}
 
 // Deprecated: Use SubmitDataSet.ProtoReflect.Descriptor instead.
 func (*SubmitDataSet) Descriptor() ([]byte, []int) {
-	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SubmitDataSet) GetRequestId() uint64 {
@@ -3297,12 +5636,18 @@ type DataSet struct {
 	BlockTime uint64 `protobuf:"varint,4,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
 	// raw_data represents the raw data in string format (can be JSON, CSV, etc.)
 	RawData string `protobuf:"bytes,5,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	// aggregation_rule is the rule that produced raw_data, which differs from
+	// the request's aggregation_rule when a fallback rule was used
+	AggregationRule AggregationRule `protobuf:"varint,6,opt,name=aggregation_rule,json=aggregationRule,proto3,enum=guru.oracle.v1.AggregationRule" json:"aggregation_rule,omitempty"`
+	// raw_aggregate is the aggregate before rounding to raw_data. It is stored
+	// for every result_format, which only selects the event attributes
+	RawAggregate string `protobuf:"bytes,7,opt,name=raw_aggregate,json=rawAggregate,proto3" json:"raw_aggregate,omitempty"`
 }
 
 func (x *DataSet) Reset() {
 	*x = DataSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_guru_oracle_v1_oracle_proto_msgTypes[3]
+		mi := &file_guru_oracle_v1_oracle_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3316,7 +5661,7 @@ func (*DataSet) ProtoMessage() {}
 
 // Deprecated: Use DataSet.ProtoReflect.Descriptor instead.
 func (*DataSet) Descriptor() ([]byte, []int) {
-	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
+	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DataSet) GetRequestId() uint64 {
@@ -3354,6 +5699,131 @@ func (x *DataSet) GetRawData() string {
 	return ""
 }
 
+func (x *DataSet) GetAggregationRule() AggregationRule {
+	if x != nil {
+		return x.AggregationRule
+	}
+	return AggregationRule_AGGREGATION_RULE_UNSPECIFIED
+}
+
+func (x *DataSet) GetRawAggregate() string {
+	if x != nil {
+		return x.RawAggregate
+	}
+	return ""
+}
+
+// RoundTiming tracks how long the rounds of a request take from starting, when the request was
+// registered or updated or the previous round finalized or was cancelled, to finalizing. It is
+// updated on-chain at every finalization and reset when the request document is updated.
+type RoundTiming struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id represents the ID of the request the statistics belong to
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// samples is the number of finalized rounds folded into the averages
+	Samples uint64 `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`
+	// ema_duration_seconds is the exponential moving average of the round duration in seconds, as a decimal
+	EmaDurationSeconds string `protobuf:"bytes,3,opt,name=ema_duration_seconds,json=emaDurationSeconds,proto3" json:"ema_duration_seconds,omitempty"`
+	// ema_duration_blocks is the exponential moving average of the round duration in blocks, as a decimal
+	EmaDurationBlocks string `protobuf:"bytes,4,opt,name=ema_duration_blocks,json=emaDurationBlocks,proto3" json:"ema_duration_blocks,omitempty"`
+	// last_duration_seconds is the duration of the most recent round in seconds
+	LastDurationSeconds uint64 `protobuf:"varint,5,opt,name=last_duration_seconds,json=lastDurationSeconds,proto3" json:"last_duration_seconds,omitempty"`
+	// last_duration_blocks is the duration of the most recent round in blocks
+	LastDurationBlocks uint64 `protobuf:"varint,6,opt,name=last_duration_blocks,json=lastDurationBlocks,proto3" json:"last_duration_blocks,omitempty"`
+	// last_height is the block height at which the most recent round finalized
+	LastHeight uint64 `protobuf:"varint,7,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// round_start_height is the block height at which the current round started
+	RoundStartHeight uint64 `protobuf:"varint,8,opt,name=round_start_height,json=roundStartHeight,proto3" json:"round_start_height,omitempty"`
+	// round_start_time is the block time, in unix seconds, at which the current round started
+	RoundStartTime uint64 `protobuf:"varint,9,opt,name=round_start_time,json=roundStartTime,proto3" json:"round_start_time,omitempty"`
+}
+
+func (x *RoundTiming) Reset() {
+	*x = RoundTiming{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_guru_oracle_v1_oracle_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoundTiming) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundTiming) ProtoMessage() {}
+
+// Deprecated: Use RoundTiming.ProtoReflect.Descriptor instead.
+func (*RoundTiming) Descriptor() ([]byte, []int) {
+	return file_guru_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RoundTiming) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *RoundTiming) GetSamples() uint64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *RoundTiming) GetEmaDurationSeconds() string {
+	if x != nil {
+		return x.EmaDurationSeconds
+	}
+	return ""
+}
+
+func (x *RoundTiming) GetEmaDurationBlocks() string {
+	if x != nil {
+		return x.EmaDurationBlocks
+	}
+	return ""
+}
+
+func (x *RoundTiming) GetLastDurationSeconds() uint64 {
+	if x != nil {
+		return x.LastDurationSeconds
+	}
+	return 0
+}
+
+func (x *RoundTiming) GetLastDurationBlocks() uint64 {
+	if x != nil {
+		return x.LastDurationBlocks
+	}
+	return 0
+}
+
+func (x *RoundTiming) GetLastHeight() uint64 {
+	if x != nil {
+		return x.LastHeight
+	}
+	return 0
+}
+
+func (x *RoundTiming) GetRoundStartHeight() uint64 {
+	if x != nil {
+		return x.RoundStartHeight
+	}
+	return 0
+}
+
+func (x *RoundTiming) GetRoundStartTime() uint64 {
+	if x != nil {
+		return x.RoundStartTime
+	}
+	return 0
+}
+
 var File_guru_oracle_v1_oracle_proto protoreflect.FileDescriptor
 
 var file_guru_oracle_v1_oracle_proto_rawDesc = []byte{
@@ -3365,7 +5835,7 @@ var file_guru_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
 	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x03, 0x0a, 0x10, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x06, 0x0a, 0x10, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x72, 0x61, 0x63,
@@ -3394,69 +5864,169 @@ var file_guru_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
 	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x41, 0x0a, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x45,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72,
-	0x73, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
-	0x61, 0x72, 0x73, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x0d, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
-	0x19, 0x0a, 0x08, 0x72, 0x61, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x72, 0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x07, 0x44, 0x61, 0x74, 0x61, 0x53, 0x65, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x61, 0x77, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x77, 0x44, 0x61,
-	0x74, 0x61, 0x2a, 0x91, 0x01, 0x0a, 0x0a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x52, 0x41, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d,
-	0x0a, 0x19, 0x4f, 0x52, 0x41, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49,
-	0x4e, 0x5f, 0x47, 0x41, 0x53, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a,
-	0x14, 0x4f, 0x52, 0x41, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x55, 0x52,
-	0x52, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x52, 0x41, 0x43, 0x4c,
-	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x16,
-	0x0a, 0x12, 0x4f, 0x52, 0x41, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52,
-	0x59, 0x50, 0x54, 0x4f, 0x10, 0x04, 0x2a, 0x83, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x51, 0x55,
-	0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x51, 0x55,
-	0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c,
-	0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12,
-	0x1b, 0x0a, 0x17, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x9e, 0x01, 0x0a,
-	0x0f, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65,
-	0x12, 0x20, 0x0a, 0x1c, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x52, 0x55, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x41, 0x56, 0x47, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x13, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x75, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x71, 0x75,
+	0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x67, 0x75, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x71, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x71, 0x75, 0x6f, 0x72, 0x75,
+	0x6d, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0e, 0x66,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x10, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x67, 0x75, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x0d, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x67, 0x75, 0x72,
+	0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x67, 0x75, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x0e, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x72, 0x73, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x73, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x3f, 0x0a,
+	0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x67, 0x75, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x41, 0x0a, 0x11, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x61, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0x8c, 0x02, 0x0a, 0x07, 0x44, 0x61, 0x74, 0x61, 0x53, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x61, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x12, 0x4a,
+	0x0a, 0x10, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x67, 0x75, 0x72, 0x75, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61,
+	0x77, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x61, 0x77, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x22,
+	0x87, 0x03, 0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x6d, 0x61, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6d, 0x61, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x6d,
+	0x61, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6d, 0x61, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30,
+	0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6c, 0x61,
+	0x73, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x2a, 0x91, 0x01, 0x0a, 0x0a, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x52, 0x41, 0x43,
+	0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x52, 0x41, 0x43, 0x4c, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x5f, 0x47, 0x41, 0x53, 0x5f, 0x50, 0x52, 0x49,
+	0x43, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x52, 0x41, 0x43, 0x4c, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x02, 0x12, 0x15,
+	0x0a, 0x11, 0x4f, 0x52, 0x41, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54,
+	0x4f, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x41, 0x43, 0x4c, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x59, 0x50, 0x54, 0x4f, 0x10, 0x04, 0x2a, 0x83, 0x01,
+	0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1a, 0x0a, 0x16, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41,
+	0x55, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45,
+	0x44, 0x10, 0x03, 0x2a, 0x77, 0x0a, 0x12, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x4e, 0x44,
+	0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c,
+	0x0a, 0x18, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47,
+	0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x52, 0x41, 0x50, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a,
+	0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x10, 0x02, 0x2a, 0x82, 0x01, 0x0a,
+	0x0a, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x51,
+	0x55, 0x4f, 0x52, 0x55, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x51, 0x55, 0x4f, 0x52,
+	0x55, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12,
+	0x1e, 0x0a, 0x1a, 0x51, 0x55, 0x4f, 0x52, 0x55, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x4b, 0x45, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x20, 0x0a, 0x1c, 0x51, 0x55, 0x4f, 0x52, 0x55, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x54,
+	0x52, 0x55, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x10,
+	0x03, 0x2a, 0x5f, 0x0a, 0x0c, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x51, 0x55, 0x4f, 0x52, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f,
+	0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x55, 0x4c, 0x4c,
+	0x10, 0x02, 0x2a, 0xca, 0x01, 0x0a, 0x0f, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x47, 0x47, 0x52,
+	0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x41, 0x56, 0x47,
+	0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14,
 	0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x55, 0x4c, 0x45,
-	0x5f, 0x4d, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x10, 0x03,
-	0x12, 0x1b, 0x0a, 0x17, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x04, 0x42, 0xa5, 0x01,
-	0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x75, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x75, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x47, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x47, 0x75, 0x72, 0x75, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x47, 0x75, 0x72, 0x75, 0x5c, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x47, 0x75, 0x72, 0x75, 0x5c, 0x4f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x10, 0x47, 0x75, 0x72, 0x75, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x4d, 0x41, 0x58, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41,
+	0x4e, 0x10, 0x04, 0x12, 0x2a, 0x0a, 0x26, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x57, 0x45,
+	0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x05, 0x2a,
+	0x7a, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x1d, 0x0a, 0x19, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c,
+	0x0a, 0x18, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
+	0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x52, 0x41,
+	0x57, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x03, 0x42, 0xa5, 0x01, 0x0a, 0x12,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x75, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x67, 0x75, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
+	0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x47, 0x4f,
+	0x58, 0xaa, 0x02, 0x0e, 0x47, 0x75, 0x72, 0x75, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x0e, 0x47, 0x75, 0x72, 0x75, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x47, 0x75, 0x72, 0x75, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x10, 0x47, 0x75, 0x72, 0x75, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3471,27 +6041,40 @@ func file_guru_oracle_v1_oracle_proto_rawDescGZIP() []byte {
 	return file_guru_oracle_v1_oracle_proto_rawDescData
 }
 
-var file_guru_oracle_v1_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_guru_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_guru_oracle_v1_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_guru_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_guru_oracle_v1_oracle_proto_goTypes = []interface{}{
-	(OracleType)(0),          // 0: guru.oracle.v1.OracleType
-	(RequestStatus)(0),       // 1: guru.oracle.v1.RequestStatus
-	(AggregationRule)(0),     // 2: guru.oracle.v1.AggregationRule
-	(*OracleRequestDoc)(nil), // 3: guru.oracle.v1.OracleRequestDoc
-	(*OracleEndpoint)(nil),   // 4: guru.oracle.v1.OracleEndpoint
-	(*SubmitDataSet)(nil),    // 5: guru.oracle.v1.SubmitDataSet
-	(*DataSet)(nil),          // 6: guru.oracle.v1.DataSet
+	(OracleType)(0),           // 0: guru.oracle.v1.OracleType
+	(RequestStatus)(0),        // 1: guru.oracle.v1.RequestStatus
+	(EndpointAssignment)(0),   // 2: guru.oracle.v1.EndpointAssignment
+	(QuorumMode)(0),           // 3: guru.oracle.v1.QuorumMode
+	(CoverageMode)(0),         // 4: guru.oracle.v1.CoverageMode
+	(AggregationRule)(0),      // 5: guru.oracle.v1.AggregationRule
+	(ResultFormat)(0),         // 6: guru.oracle.v1.ResultFormat
+	(*OracleRequestDoc)(nil),  // 7: guru.oracle.v1.OracleRequestDoc
+	(*OracleEndpoint)(nil),    // 8: guru.oracle.v1.OracleEndpoint
+	(*EndpointTransform)(nil), // 9: guru.oracle.v1.EndpointTransform
+	(*SubmitDataSet)(nil),     // 10: guru.oracle.v1.SubmitDataSet
+	(*DataSet)(nil),           // 11: guru.oracle.v1.DataSet
+	(*RoundTiming)(nil),       // 12: guru.oracle.v1.RoundTiming
 }
 var file_guru_oracle_v1_oracle_proto_depIdxs = []int32{
-	0, // 0: guru.oracle.v1.OracleRequestDoc.oracle_type:type_name -> guru.oracle.v1.OracleType
-	4, // 1: guru.oracle.v1.OracleRequestDoc.endpoints:type_name -> guru.oracle.v1.OracleEndpoint
-	2, // 2: guru.oracle.v1.OracleRequestDoc.aggregation_rule:type_name -> guru.oracle.v1.AggregationRule
-	1, // 3: guru.oracle.v1.OracleRequestDoc.status:type_name -> guru.oracle.v1.RequestStatus
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: guru.oracle.v1.OracleRequestDoc.oracle_type:type_name -> guru.oracle.v1.OracleType
+	8,  // 1: guru.oracle.v1.OracleRequestDoc.endpoints:type_name -> guru.oracle.v1.OracleEndpoint
+	5,  // 2: guru.oracle.v1.OracleRequestDoc.aggregation_rule:type_name -> guru.oracle.v1.AggregationRule
+	1,  // 3: guru.oracle.v1.OracleRequestDoc.status:type_name -> guru.oracle.v1.RequestStatus
+	2,  // 4: guru.oracle.v1.OracleRequestDoc.endpoint_assignment:type_name -> guru.oracle.v1.EndpointAssignment
+	3,  // 5: guru.oracle.v1.OracleRequestDoc.quorum_mode:type_name -> guru.oracle.v1.QuorumMode
+	5,  // 6: guru.oracle.v1.OracleRequestDoc.fallback_rules:type_name -> guru.oracle.v1.AggregationRule
+	6,  // 7: guru.oracle.v1.OracleRequestDoc.result_format:type_name -> guru.oracle.v1.ResultFormat
+	4,  // 8: guru.oracle.v1.OracleRequestDoc.coverage_mode:type_name -> guru.oracle.v1.CoverageMode
+	9,  // 9: guru.oracle.v1.OracleEndpoint.transform:type_name -> guru.oracle.v1.EndpointTransform
+	5,  // 10: guru.oracle.v1.DataSet.aggregation_rule:type_name -> guru.oracle.v1.AggregationRule
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_guru_oracle_v1_oracle_proto_init() }
@@ -3525,7 +6108,7 @@ func file_guru_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_guru_oracle_v1_oracle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitDataSet); i {
+			switch v := v.(*EndpointTransform); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3537,6 +6120,18 @@ func file_guru_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_guru_oracle_v1_oracle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitDataSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_guru_oracle_v1_oracle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DataSet); i {
 			case 0:
 				return &v.state
@@ -3548,14 +6143,26 @@ func file_guru_oracle_v1_oracle_proto_init() {
 				return nil
 			}
 		}
+		file_guru_oracle_v1_oracle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundTiming); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_guru_oracle_v1_oracle_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   4,
+			NumEnums:      7,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
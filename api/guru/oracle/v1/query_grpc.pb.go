@@ -25,6 +25,11 @@ const (
 	Query_OracleRequestDoc_FullMethodName  = "/guru.oracle.v1.Query/OracleRequestDoc"
 	Query_OracleRequestDocs_FullMethodName = "/guru.oracle.v1.Query/OracleRequestDocs"
 	Query_ModeratorAddress_FullMethodName  = "/guru.oracle.v1.Query/ModeratorAddress"
+	Query_RequestsByAccount_FullMethodName = "/guru.oracle.v1.Query/RequestsByAccount"
+	Query_PendingRound_FullMethodName      = "/guru.oracle.v1.Query/PendingRound"
+	Query_LatestResults_FullMethodName     = "/guru.oracle.v1.Query/LatestResults"
+	Query_ModuleState_FullMethodName       = "/guru.oracle.v1.Query/ModuleState"
+	Query_RoundTiming_FullMethodName       = "/guru.oracle.v1.Query/RoundTiming"
 )
 
 // QueryClient is the client API for Query service.
@@ -43,6 +48,16 @@ type QueryClient interface {
 	OracleRequestDocs(ctx context.Context, in *QueryOracleRequestDocsRequest, opts ...grpc.CallOption) (*QueryOracleRequestDocsResponse, error)
 	// ModeratorAddress queries the moderator address
 	ModeratorAddress(ctx context.Context, in *QueryModeratorAddressRequest, opts ...grpc.CallOption) (*QueryModeratorAddressResponse, error)
+	// RequestsByAccount queries the oracle request documents that list an account as provider
+	RequestsByAccount(ctx context.Context, in *QueryRequestsByAccountRequest, opts ...grpc.CallOption) (*QueryRequestsByAccountResponse, error)
+	// PendingRound queries the reports received so far for a round that has not finalized
+	PendingRound(ctx context.Context, in *QueryPendingRoundRequest, opts ...grpc.CallOption) (*QueryPendingRoundResponse, error)
+	// LatestResults queries the latest finalized result of several requests in one call
+	LatestResults(ctx context.Context, in *QueryLatestResultsRequest, opts ...grpc.CallOption) (*QueryLatestResultsResponse, error)
+	// ModuleState queries the params, the moderator address and the request counts in one call
+	ModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
+	// RoundTiming queries the moving averages of how long the rounds of a request take to finalize
+	RoundTiming(ctx context.Context, in *QueryRoundTimingRequest, opts ...grpc.CallOption) (*QueryRoundTimingResponse, error)
 }
 
 type queryClient struct {
@@ -107,6 +122,51 @@ func (c *queryClient) ModeratorAddress(ctx context.Context, in *QueryModeratorAd
 	return out, nil
 }
 
+func (c *queryClient) RequestsByAccount(ctx context.Context, in *QueryRequestsByAccountRequest, opts ...grpc.CallOption) (*QueryRequestsByAccountResponse, error) {
+	out := new(QueryRequestsByAccountResponse)
+	err := c.cc.Invoke(ctx, Query_RequestsByAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRound(ctx context.Context, in *QueryPendingRoundRequest, opts ...grpc.CallOption) (*QueryPendingRoundResponse, error) {
+	out := new(QueryPendingRoundResponse)
+	err := c.cc.Invoke(ctx, Query_PendingRound_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestResults(ctx context.Context, in *QueryLatestResultsRequest, opts ...grpc.CallOption) (*QueryLatestResultsResponse, error) {
+	out := new(QueryLatestResultsResponse)
+	err := c.cc.Invoke(ctx, Query_LatestResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error) {
+	out := new(QueryModuleStateResponse)
+	err := c.cc.Invoke(ctx, Query_ModuleState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RoundTiming(ctx context.Context, in *QueryRoundTimingRequest, opts ...grpc.CallOption) (*QueryRoundTimingResponse, error) {
+	out := new(QueryRoundTimingResponse)
+	err := c.cc.Invoke(ctx, Query_RoundTiming_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -123,6 +183,16 @@ type QueryServer interface {
 	OracleRequestDocs(context.Context, *QueryOracleRequestDocsRequest) (*QueryOracleRequestDocsResponse, error)
 	// ModeratorAddress queries the moderator address
 	ModeratorAddress(context.Context, *QueryModeratorAddressRequest) (*QueryModeratorAddressResponse, error)
+	// RequestsByAccount queries the oracle request documents that list an account as provider
+	RequestsByAccount(context.Context, *QueryRequestsByAccountRequest) (*QueryRequestsByAccountResponse, error)
+	// PendingRound queries the reports received so far for a round that has not finalized
+	PendingRound(context.Context, *QueryPendingRoundRequest) (*QueryPendingRoundResponse, error)
+	// LatestResults queries the latest finalized result of several requests in one call
+	LatestResults(context.Context, *QueryLatestResultsRequest) (*QueryLatestResultsResponse, error)
+	// ModuleState queries the params, the moderator address and the request counts in one call
+	ModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
+	// RoundTiming queries the moving averages of how long the rounds of a request take to finalize
+	RoundTiming(context.Context, *QueryRoundTimingRequest) (*QueryRoundTimingResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -148,6 +218,21 @@ func (UnimplementedQueryServer) OracleRequestDocs(context.Context, *QueryOracleR
 func (UnimplementedQueryServer) ModeratorAddress(context.Context, *QueryModeratorAddressRequest) (*QueryModeratorAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModeratorAddress not implemented")
 }
+func (UnimplementedQueryServer) RequestsByAccount(context.Context, *QueryRequestsByAccountRequest) (*QueryRequestsByAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestsByAccount not implemented")
+}
+func (UnimplementedQueryServer) PendingRound(context.Context, *QueryPendingRoundRequest) (*QueryPendingRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRound not implemented")
+}
+func (UnimplementedQueryServer) LatestResults(context.Context, *QueryLatestResultsRequest) (*QueryLatestResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestResults not implemented")
+}
+func (UnimplementedQueryServer) ModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleState not implemented")
+}
+func (UnimplementedQueryServer) RoundTiming(context.Context, *QueryRoundTimingRequest) (*QueryRoundTimingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoundTiming not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -269,6 +354,96 @@ func _Query_ModeratorAddress_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RequestsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestsByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RequestsByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RequestsByAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequestsByAccount(ctx, req.(*QueryRequestsByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingRound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRound(ctx, req.(*QueryPendingRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LatestResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestResults(ctx, req.(*QueryLatestResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ModuleState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleState(ctx, req.(*QueryModuleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RoundTiming_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoundTimingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoundTiming(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RoundTiming_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoundTiming(ctx, req.(*QueryRoundTimingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -300,6 +475,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModeratorAddress",
 			Handler:    _Query_ModeratorAddress_Handler,
 		},
+		{
+			MethodName: "RequestsByAccount",
+			Handler:    _Query_RequestsByAccount_Handler,
+		},
+		{
+			MethodName: "PendingRound",
+			Handler:    _Query_PendingRound_Handler,
+		},
+		{
+			MethodName: "LatestResults",
+			Handler:    _Query_LatestResults_Handler,
+		},
+		{
+			MethodName: "ModuleState",
+			Handler:    _Query_ModuleState_Handler,
+		},
+		{
+			MethodName: "RoundTiming",
+			Handler:    _Query_RoundTiming_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "guru/oracle/v1/query.proto",
@@ -23,6 +23,7 @@ const (
 	Msg_UpdateOracleRequestDoc_FullMethodName   = "/guru.oracle.v1.Msg/UpdateOracleRequestDoc"
 	Msg_SubmitOracleData_FullMethodName         = "/guru.oracle.v1.Msg/SubmitOracleData"
 	Msg_UpdateModeratorAddress_FullMethodName   = "/guru.oracle.v1.Msg/UpdateModeratorAddress"
+	Msg_CancelRound_FullMethodName              = "/guru.oracle.v1.Msg/CancelRound"
 	Msg_UpdateParams_FullMethodName             = "/guru.oracle.v1.Msg/UpdateParams"
 )
 
@@ -38,6 +39,8 @@ type MsgClient interface {
 	SubmitOracleData(ctx context.Context, in *MsgSubmitOracleData, opts ...grpc.CallOption) (*MsgSubmitOracleDataResponse, error)
 	// UpdateModeratorAddress defines a method for updating the moderator address
 	UpdateModeratorAddress(ctx context.Context, in *MsgUpdateModeratorAddress, opts ...grpc.CallOption) (*MsgUpdateModeratorAddressResponse, error)
+	// CancelRound defines a method for the moderator to abort the open round of a request
+	CancelRound(ctx context.Context, in *MsgCancelRound, opts ...grpc.CallOption) (*MsgCancelRoundResponse, error)
 	// UpdateParams defines a governance operation for updating the oracle module parameters
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -86,6 +89,15 @@ func (c *msgClient) UpdateModeratorAddress(ctx context.Context, in *MsgUpdateMod
 	return out, nil
 }
 
+func (c *msgClient) CancelRound(ctx context.Context, in *MsgCancelRound, opts ...grpc.CallOption) (*MsgCancelRoundResponse, error) {
+	out := new(MsgCancelRoundResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelRound_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -107,6 +119,8 @@ type MsgServer interface {
 	SubmitOracleData(context.Context, *MsgSubmitOracleData) (*MsgSubmitOracleDataResponse, error)
 	// UpdateModeratorAddress defines a method for updating the moderator address
 	UpdateModeratorAddress(context.Context, *MsgUpdateModeratorAddress) (*MsgUpdateModeratorAddressResponse, error)
+	// CancelRound defines a method for the moderator to abort the open round of a request
+	CancelRound(context.Context, *MsgCancelRound) (*MsgCancelRoundResponse, error)
 	// UpdateParams defines a governance operation for updating the oracle module parameters
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
@@ -128,6 +142,9 @@ func (UnimplementedMsgServer) SubmitOracleData(context.Context, *MsgSubmitOracle
 func (UnimplementedMsgServer) UpdateModeratorAddress(context.Context, *MsgUpdateModeratorAddress) (*MsgUpdateModeratorAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateModeratorAddress not implemented")
 }
+func (UnimplementedMsgServer) CancelRound(context.Context, *MsgCancelRound) (*MsgCancelRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRound not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -216,6 +233,24 @@ func _Msg_UpdateModeratorAddress_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelRound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRound(ctx, req.(*MsgCancelRound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -257,6 +292,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateModeratorAddress",
 			Handler:    _Msg_UpdateModeratorAddress_Handler,
 		},
+		{
+			MethodName: "CancelRound",
+			Handler:    _Msg_CancelRound_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -2,10 +2,12 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"os"
 	"os/signal"
 	"runtime"
+	"strings"
 	"syscall"
 	"time"
 
@@ -17,9 +19,29 @@ func main() {
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 
+	// SIGUSR1 forces a resync of the submitter sequence with the chain
+	resync := make(chan os.Signal, 1)
+	signal.Notify(resync, syscall.SIGUSR1)
+
+	// SIGUSR2 writes a JSON support bundle of the daemon state into <home>/support
+	dump := make(chan os.Signal, 1)
+	signal.Notify(dump, syscall.SIGUSR2)
+
+	flag.Parse()
 	config.Load()
+
+	if args := flag.Args(); 0 < len(args) {
+		os.Exit(runCommand(args))
+	}
+
+	// The daemon cannot sign without its key, so fail early with a diagnosable error
+	if err := config.CheckKey(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
 	rootCtx := context.Background()
-	delay := 5 * time.Second
+	delay := config.RestartDelay()
 
 	for {
 		ctx, cancel := context.WithCancel(rootCtx)
@@ -30,17 +52,58 @@ func main() {
 			continue
 		}
 
-		select {
-		case <-c:
-			fmt.Println("Thank you oracle daemon!!")
-			cancel()
-			os.Exit(0)
-		case <-dmn.Fatal():
-			cancel()
-			time.Sleep(delay)
-			dmn = nil
-			runtime.GC()
-			continue
+	running:
+		for {
+			select {
+			case <-c:
+				// Let an in-flight submission finish or fail before exiting
+				dmn.Stop()
+				cancel()
+				fmt.Println("Thank you oracle daemon!!")
+				os.Exit(0)
+			case <-resync:
+				go dmn.ResyncSequence()
+			case <-dump:
+				go func() {
+					if _, err := dmn.WriteSupportBundle(); err != nil {
+						fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+					}
+				}()
+			case <-dmn.Fatal():
+				// The next daemon must not start while this one still submits with the same account sequence
+				dmn.Stop()
+				cancel()
+				time.Sleep(delay)
+				dmn = nil
+				runtime.GC()
+				break running
+			}
+		}
+	}
+}
+
+// runCommand handles the non-daemon subcommands and returns the process exit code
+func runCommand(args []string) int {
+	switch {
+	case len(args) == 2 && args[0] == "keys" && args[1] == "list":
+		names, err := config.KeyNames()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+
+		fmt.Printf("keyring backend %q, dir %s\n", config.KeyringBackend(), config.KeyringDir())
+		for _, name := range names {
+			marker := " "
+			if name == config.KeyName() {
+				marker = "*"
+			}
+			fmt.Printf("%s %s\n", marker, name)
 		}
+		return 0
+
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command: %s\nusage: oracled [--home <dir>] [keys list]\n", strings.Join(args, " "))
+		return 2
 	}
 }
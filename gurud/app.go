@@ -541,7 +541,7 @@ func NewExampleApp(
 		&app.TransferKeeper,
 	)
 
-	oracleKeeper := oraclekeeper.NewKeeper(appCodec, keys[oracletypes.StoreKey], authAddr, app.AccountKeeper)
+	oracleKeeper := oraclekeeper.NewKeeper(appCodec, keys[oracletypes.StoreKey], authAddr, app.AccountKeeper, app.StakingKeeper)
 	app.OracleKeeper = *oracleKeeper
 	app.OracleKeeper = *oracleKeeper.SetHooks(
 		oraclekeeper.NewMultiOracleHooks(
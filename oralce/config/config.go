@@ -3,31 +3,55 @@ package config
 import (
 	"flag"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/gurufinglobal/guru/v2/crypto/hd"
 	"github.com/gurufinglobal/guru/v2/encoding"
 	guruconfig "github.com/gurufinglobal/guru/v2/server/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/pelletier/go-toml/v2"
 )
 
 var home = flag.String("home", homeDir(), "oracle daemon home directory")
 
+// BroadcastBlock waits until a submission is included in a block. The SDK dropped the mode,
+// so it is a sync broadcast followed by polling for the transaction.
+const BroadcastBlock = "block"
+
+// MaxMemoLength bounds submit.memo; every byte of the memo is paid for in gas on each submission
+const MaxMemoLength = 64
+
 var (
 	globalConfig configData
 	mu           sync.Mutex
 )
 
 type configData struct {
-	Chain chainConfig `toml:"chain"`
-	Key   keyConfig   `toml:"key"`
-	Gas   gasConfig   `toml:"gas"`
-	Retry retryConfig `toml:"retry"`
+	Chain    chainConfig    `toml:"chain"`
+	Key      keyConfig      `toml:"key"`
+	Gas      gasConfig      `toml:"gas"`
+	Retry    retryConfig    `toml:"retry"`
+	Resubmit resubmitConfig `toml:"resubmit"`
+	Submit   submitConfig   `toml:"submit"`
+	TLS      tlsConfig      `toml:"tls"`
+	Security securityConfig `toml:"security"`
+	Health   healthConfig   `toml:"health"`
+	Secrets  secretsConfig  `toml:"secrets"`
+	Sinks    sinksConfig    `toml:"sinks"`
+	Worker   workerConfig   `toml:"worker"`
 }
 
 type chainConfig struct {
@@ -45,6 +69,8 @@ type gasConfig struct {
 	Limit      uint64  `toml:"limit"`
 	Adjustment float64 `toml:"adjustment"`
 	Prices     string  `toml:"prices"`
+	// MinPrices is an absolute floor for the gas price, e.g. a validator mempool minimum above the feemarket price; empty disables it
+	MinPrices string `toml:"min_prices"`
 }
 
 type retryConfig struct {
@@ -52,6 +78,98 @@ type retryConfig struct {
 	MaxDelaySec int `toml:"max_delay_sec"`
 }
 
+type resubmitConfig struct {
+	QueueSize     int `toml:"queue_size"`
+	MaxBackoffSec int `toml:"max_backoff_sec"`
+}
+
+type submitConfig struct {
+	// DeadlineFraction drops results not submitted within this fraction of the period after the round's fetch started; 0 disables the check
+	DeadlineFraction float64 `toml:"deadline_fraction"`
+	// BroadcastMode is sync, async or block; async submissions are confirmed in the background
+	BroadcastMode string `toml:"broadcast_mode"`
+	// ConfirmTimeoutSec is how long an async or block submission may take to appear in a block
+	ConfirmTimeoutSec int `toml:"confirm_timeout_sec"`
+	// CheckNonce queries the request nonce before every submission and skips rounds the chain already advanced
+	CheckNonce bool `toml:"check_nonce"`
+	// DryRun builds and signs every submission but logs it instead of broadcasting it
+	DryRun bool `toml:"dry_run"`
+	// Memo is set on every submission to tell which node sent it; {version} is replaced with the daemon version
+	Memo string `toml:"memo"`
+}
+
+type tlsConfig struct {
+	// ExpiryWarningDays warns when an endpoint certificate expires within this many days; 0 disables the check
+	ExpiryWarningDays int `toml:"expiry_warning_days"`
+}
+
+type healthConfig struct {
+	// IntervalSec and TimeoutSec default to retry.max_delay_sec, FailureThreshold to retry.max_attempts
+	IntervalSec      int `toml:"interval_sec"`
+	TimeoutSec       int `toml:"timeout_sec"`
+	FailureThreshold int `toml:"failure_threshold"`
+	RestartDelaySec  int `toml:"restart_delay_sec"`
+	// EventSilenceSec restarts the daemon when no oracle event arrived for this long while the node is healthy; 0 disables the watchdog
+	EventSilenceSec int `toml:"event_silence_sec"`
+}
+
+type securityConfig struct {
+	// AllowedHosts restricts which hosts endpoints may point to; empty allows any host.
+	// Entries are hostnames, optionally prefixed with "*." to also match subdomains.
+	AllowedHosts []string `toml:"allowed_hosts"`
+	// SelfHosts lists hosts, optionally with a port, that endpoints must not point to because they serve this chain.
+	// The chain endpoint is always included unless AllowSelfEndpoints is set.
+	SelfHosts          []string `toml:"self_hosts"`
+	AllowSelfEndpoints bool     `toml:"allow_self_endpoints"`
+}
+
+type secretsConfig struct {
+	// File holds NAME = "value" pairs referenced by endpoints as ${NAME}; relative paths are resolved against the home directory.
+	// Environment variables take precedence over the file.
+	File string `toml:"file"`
+	// Headers maps an endpoint host to headers added to its requests; values may reference secrets as ${NAME}
+	Headers map[string]map[string]string `toml:"headers"`
+	// Rotations maps a secret name referenced as ${NAME} to the keys it rotates through
+	Rotations map[string]KeyRotation `toml:"rotations"`
+}
+
+// KeyRotation spreads the requests referencing one secret over several API keys
+type KeyRotation struct {
+	// Keys are the names of the secrets holding the keys, resolved like any other secret
+	Keys []string `toml:"keys"`
+	// Quota is how many requests a key may serve per window; 0 means unlimited
+	Quota int `toml:"quota"`
+	// WindowSec is the quota window and how long a key rejected with HTTP 429 is skipped
+	WindowSec int `toml:"window_sec"`
+}
+
+// Window returns the quota window of the rotation
+func (r KeyRotation) Window() time.Duration { return time.Duration(r.WindowSec) * time.Second }
+
+type sinksConfig struct {
+	// Webhooks receive every fetched result as a JSON POST in addition to the on-chain submission
+	Webhooks   []string `toml:"webhooks"`
+	TimeoutSec int      `toml:"timeout_sec"`
+}
+
+type workerConfig struct {
+	// MaxConsecutiveFailures is the number of failed executions after which a job is degraded
+	MaxConsecutiveFailures int `toml:"max_consecutive_failures"`
+	// DegradedBackoffSec is how long a degraded job is skipped after each further failure
+	DegradedBackoffSec int `toml:"degraded_backoff_sec"`
+	// LeaderElection lets only the primary providers of a round submit at once; the others back them up
+	LeaderElection bool `toml:"leader_election"`
+	// BackupDelaySec is how long each backup rank waits for the round to complete before it submits
+	BackupDelaySec int `toml:"backup_delay_sec"`
+	// JobTTLSec is how long a job may go without running or being updated before the chain is asked whether it is still live
+	JobTTLSec int `toml:"job_ttl_sec"`
+	// CoverageRetrySec is how long a failed round of a full coverage request waits before it is retried
+	CoverageRetrySec int `toml:"coverage_retry_sec"`
+}
+
+// secrets holds the values loaded from secrets.file
+var secrets map[string]string
+
 // Load reads and parses the configuration file from the home directory
 // If the configuration file does not exist, it creates a default one
 // This function panics on any configuration errors to prevent daemon startup with invalid config
@@ -73,10 +191,18 @@ func Load() {
 		panic(fmt.Sprintf("Failed to parse TOML: %v", err))
 	}
 
+	if err := applyEnvOverrides(&globalConfig, os.LookupEnv); err != nil {
+		panic(fmt.Sprintf("Invalid environment override: %v", err))
+	}
+
 	if err := validateConfig(); err != nil {
 		panic(fmt.Sprintf("Invalid config: %v", err))
 	}
 
+	if err := loadSecrets(); err != nil {
+		panic(fmt.Sprintf("Failed to load secrets: %v", err))
+	}
+
 	fmt.Printf("Loaded config from %s\n", path)
 }
 
@@ -118,6 +244,16 @@ func createDefaultConfig(path string) error {
 			MaxAttempts: 4,
 			MaxDelaySec: 10,
 		},
+		Resubmit: resubmitConfig{
+			QueueSize:     256,
+			MaxBackoffSec: 60,
+		},
+		Health: healthConfig{
+			IntervalSec:      10,
+			TimeoutSec:       10,
+			FailureThreshold: 4,
+			RestartDelaySec:  5,
+		},
 	}
 
 	data, err := toml.Marshal(globalConfig)
@@ -167,6 +303,13 @@ func validateConfig() error {
 		return fmt.Errorf("gas prices is required")
 	}
 
+	if globalConfig.Gas.MinPrices != "" {
+		floor, err := sdkmath.LegacyNewDecFromStr(globalConfig.Gas.MinPrices)
+		if err != nil || floor.IsNegative() {
+			return fmt.Errorf("gas min prices must be a non-negative decimal, got %q", globalConfig.Gas.MinPrices)
+		}
+	}
+
 	if globalConfig.Retry.MaxAttempts <= 0 {
 		globalConfig.Retry.MaxAttempts = 6
 	}
@@ -174,9 +317,285 @@ func validateConfig() error {
 		globalConfig.Retry.MaxDelaySec = 8
 	}
 
+	if globalConfig.Resubmit.QueueSize <= 0 {
+		globalConfig.Resubmit.QueueSize = 256
+	}
+	if globalConfig.Resubmit.MaxBackoffSec <= 0 {
+		globalConfig.Resubmit.MaxBackoffSec = 60
+	}
+
+	if globalConfig.Submit.DeadlineFraction < 0 || globalConfig.Submit.DeadlineFraction > 1 {
+		return fmt.Errorf("submit deadline fraction must be between 0 and 1")
+	}
+
+	switch globalConfig.Submit.BroadcastMode {
+	case "":
+		globalConfig.Submit.BroadcastMode = flags.BroadcastSync
+	case flags.BroadcastSync, flags.BroadcastAsync, BroadcastBlock:
+	default:
+		return fmt.Errorf("invalid submit broadcast mode %q: expected sync, async or block", globalConfig.Submit.BroadcastMode)
+	}
+	if globalConfig.Submit.ConfirmTimeoutSec < 0 {
+		return fmt.Errorf("submit confirm timeout cannot be negative")
+	}
+	if globalConfig.Submit.ConfirmTimeoutSec == 0 {
+		globalConfig.Submit.ConfirmTimeoutSec = 30
+	}
+
+	globalConfig.Submit.Memo = strings.ReplaceAll(strings.TrimSpace(globalConfig.Submit.Memo), "{version}", version.Version)
+	if len(globalConfig.Submit.Memo) > MaxMemoLength {
+		return fmt.Errorf("submit memo %q is longer than %d characters", globalConfig.Submit.Memo, MaxMemoLength)
+	}
+
+	if globalConfig.TLS.ExpiryWarningDays < 0 {
+		globalConfig.TLS.ExpiryWarningDays = 0
+	}
+
+	if globalConfig.Health.IntervalSec < 0 || globalConfig.Health.TimeoutSec < 0 ||
+		globalConfig.Health.FailureThreshold < 0 || globalConfig.Health.RestartDelaySec < 0 ||
+		globalConfig.Health.EventSilenceSec < 0 {
+		return fmt.Errorf("health settings cannot be negative")
+	}
+	if globalConfig.Health.IntervalSec == 0 {
+		globalConfig.Health.IntervalSec = globalConfig.Retry.MaxDelaySec
+	}
+	if globalConfig.Health.TimeoutSec == 0 {
+		globalConfig.Health.TimeoutSec = globalConfig.Retry.MaxDelaySec
+	}
+	if globalConfig.Health.TimeoutSec > globalConfig.Health.IntervalSec {
+		return fmt.Errorf("health timeout (%ds) cannot exceed health interval (%ds)", globalConfig.Health.TimeoutSec, globalConfig.Health.IntervalSec)
+	}
+	if globalConfig.Health.FailureThreshold == 0 {
+		globalConfig.Health.FailureThreshold = globalConfig.Retry.MaxAttempts
+	}
+	if globalConfig.Health.RestartDelaySec == 0 {
+		globalConfig.Health.RestartDelaySec = 5
+	}
+
+	for i, host := range globalConfig.Security.AllowedHosts {
+		host = strings.ToLower(strings.TrimSpace(host))
+		if host == "" || strings.ContainsAny(host, "/:") {
+			return fmt.Errorf("invalid allowed host %q: expected a hostname such as api.example.com or *.example.com", globalConfig.Security.AllowedHosts[i])
+		}
+		globalConfig.Security.AllowedHosts[i] = host
+	}
+
+	for i, host := range globalConfig.Security.SelfHosts {
+		host = strings.ToLower(strings.TrimSpace(host))
+		if host == "" || strings.Contains(host, "/") {
+			return fmt.Errorf("invalid self host %q: expected a hostname with an optional port such as localhost:1317", globalConfig.Security.SelfHosts[i])
+		}
+		globalConfig.Security.SelfHosts[i] = host
+	}
+
+	if globalConfig.Worker.MaxConsecutiveFailures < 0 || globalConfig.Worker.DegradedBackoffSec < 0 || globalConfig.Worker.BackupDelaySec < 0 ||
+		globalConfig.Worker.JobTTLSec < 0 || globalConfig.Worker.CoverageRetrySec < 0 {
+		return fmt.Errorf("worker settings cannot be negative")
+	}
+	if globalConfig.Worker.MaxConsecutiveFailures == 0 {
+		globalConfig.Worker.MaxConsecutiveFailures = 5
+	}
+	if globalConfig.Worker.DegradedBackoffSec == 0 {
+		globalConfig.Worker.DegradedBackoffSec = 900
+	}
+	if globalConfig.Worker.BackupDelaySec == 0 {
+		globalConfig.Worker.BackupDelaySec = 10
+	}
+	if globalConfig.Worker.JobTTLSec == 0 {
+		globalConfig.Worker.JobTTLSec = 86400
+	}
+	if globalConfig.Worker.CoverageRetrySec == 0 {
+		globalConfig.Worker.CoverageRetrySec = 15
+	}
+
+	for _, webhook := range globalConfig.Sinks.Webhooks {
+		u, err := url.Parse(webhook)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid sink webhook %q: expected an http or https URL", webhook)
+		}
+	}
+	if globalConfig.Sinks.TimeoutSec < 0 {
+		return fmt.Errorf("sink timeout cannot be negative")
+	}
+	if globalConfig.Sinks.TimeoutSec == 0 {
+		globalConfig.Sinks.TimeoutSec = 5
+	}
+
+	headers := make(map[string]map[string]string, len(globalConfig.Secrets.Headers))
+	for host, values := range globalConfig.Secrets.Headers {
+		headers[strings.ToLower(host)] = values
+	}
+	globalConfig.Secrets.Headers = headers
+
+	for name, rotation := range globalConfig.Secrets.Rotations {
+		if len(rotation.Keys) == 0 {
+			return fmt.Errorf("key rotation %s has no keys", name)
+		}
+		if rotation.Quota < 0 || rotation.WindowSec < 0 {
+			return fmt.Errorf("key rotation %s settings cannot be negative", name)
+		}
+		if rotation.WindowSec == 0 {
+			rotation.WindowSec = 60
+		}
+		globalConfig.Secrets.Rotations[name] = rotation
+	}
+
+	return nil
+}
+
+// envPrefix prefixes environment variables that override config file values
+const envPrefix = "ORACLE"
+
+// applyEnvOverrides sets every scalar config field for which lookup finds ORACLE_<SECTION>_<KEY>,
+// e.g. ORACLE_CHAIN_ENDPOINT for chain.endpoint. Lists are comma separated.
+// Map fields such as secrets.headers and secrets.rotations cannot be overridden.
+func applyEnvOverrides(cfg *configData, lookup func(string) (string, bool)) error {
+	sections := reflect.ValueOf(cfg).Elem()
+	for i := 0; i < sections.NumField(); i++ {
+		section := sections.Field(i)
+		sectionName := sections.Type().Field(i).Tag.Get("toml")
+
+		for j := 0; j < section.NumField(); j++ {
+			field := section.Field(j)
+			name := strings.ToUpper(strings.Join([]string{envPrefix, sectionName, section.Type().Field(j).Tag.Get("toml")}, "_"))
+
+			value, ok := lookup(name)
+			if !ok {
+				continue
+			}
+
+			if err := setField(field, value); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// setField parses value into a config field of a supported kind
+func setField(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", value)
+		}
+		field.SetInt(n)
+	case reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid unsigned integer %q", value)
+		}
+		field.SetUint(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", value)
+		}
+		field.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", value)
+		}
+		field.SetBool(b)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported list type %s", field.Type())
+		}
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("cannot be set from the environment")
+	}
+
+	return nil
+}
+
+// loadSecrets reads secrets.file if one is configured
+func loadSecrets() error {
+	secrets = nil
+	if globalConfig.Secrets.File == "" {
+		return nil
+	}
+
+	path := globalConfig.Secrets.File
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(Home(), path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read secrets file: %w", err)
+	}
+
+	if err := toml.Unmarshal(data, &secrets); err != nil {
+		return fmt.Errorf("failed to parse secrets file %s: %w", path, err)
+	}
+
 	return nil
 }
 
+// Secret resolves a named secret from the environment, falling back to secrets.file
+func Secret(name string) (string, bool) {
+	if value, ok := os.LookupEnv(name); ok {
+		return value, true
+	}
+
+	value, ok := secrets[name]
+	return value, ok
+}
+
+// redactedValue replaces secret values in Redacted
+const redactedValue = "[REDACTED]"
+
+// Redacted returns the effective configuration with every secret header value replaced.
+// Values loaded from secrets.file are never part of the configuration.
+func Redacted() (map[string]any, error) {
+	mu.Lock()
+	cfg := globalConfig
+	mu.Unlock()
+
+	headers := make(map[string]map[string]string, len(cfg.Secrets.Headers))
+	for host, values := range cfg.Secrets.Headers {
+		headers[host] = make(map[string]string, len(values))
+		for name := range values {
+			headers[host][name] = redactedValue
+		}
+	}
+	cfg.Secrets.Headers = headers
+
+	// Webhook paths and queries often carry tokens; keep only scheme and host
+	webhooks := make([]string, len(cfg.Sinks.Webhooks))
+	for i, webhook := range cfg.Sinks.Webhooks {
+		webhooks[i] = redactedValue
+		if u, err := url.Parse(webhook); err == nil {
+			webhooks[i] = u.Scheme + "://" + u.Host + "/" + redactedValue
+		}
+	}
+	cfg.Sinks.Webhooks = webhooks
+
+	data, err := toml.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	var out map[string]any
+	if err := toml.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	return out, nil
+}
+
 // Keyring creates and returns a keyring instance based on the configuration
 // Supports test, file, and OS keyring backends with EthSecp256k1 option
 func Keyring() keyring.Keyring {
@@ -202,6 +621,51 @@ func Keyring() keyring.Keyring {
 	return kr
 }
 
+// KeyNames lists the names of all keys in the configured keyring
+func KeyNames() ([]string, error) {
+	records, err := Keyring().List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list keys: %w", err)
+	}
+
+	names := make([]string, 0, len(records))
+	for _, record := range records {
+		names = append(names, record.Name)
+	}
+
+	return names, nil
+}
+
+// CheckKey verifies that the configured key exists in the keyring and can sign oracle transactions
+// The returned error names the backend, directory and the keys that are available
+func CheckKey() error {
+	if record, err := Keyring().Key(KeyName()); err == nil {
+		pubKey, err := record.GetPubKey()
+		if err != nil {
+			return fmt.Errorf("key %q has no public key: %w", KeyName(), err)
+		}
+		// The chain's ante handler rejects ed25519 transaction signatures, so every submission would fail
+		if _, ok := pubKey.(*ed25519.PubKey); ok {
+			return fmt.Errorf("key %q is an ed25519 key, which cannot sign oracle transactions; use an eth_secp256k1 key", KeyName())
+		}
+		return nil
+	}
+
+	location := fmt.Sprintf("backend %q, dir %s", KeyringBackend(), KeyringDir())
+
+	names, err := KeyNames()
+	if err != nil {
+		return fmt.Errorf("key %q not found (%s): %w", KeyName(), location, err)
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("key %q not found: keyring is empty (%s); add a key with `gurud keys add %s --keyring-backend %s --keyring-dir %s`",
+			KeyName(), location, KeyName(), KeyringBackend(), KeyringDir())
+	}
+
+	return fmt.Errorf("key %q not found (%s); available keys: %s; set key.name in %s",
+		KeyName(), location, strings.Join(names, ", "), filepath.Join(Home(), "config.toml"))
+}
+
 // Address retrieves the account address from the configured key name
 // Returns the address that will be used to sign Oracle transactions
 func Address() sdk.AccAddress {
@@ -209,6 +673,9 @@ func Address() sdk.AccAddress {
 
 	info, err := kr.Key(KeyName())
 	if err != nil {
+		if checkErr := CheckKey(); checkErr != nil {
+			panic(fmt.Sprintf("Failed to get key info: %v", checkErr))
+		}
 		panic(fmt.Sprintf("Failed to get key info: %v", err))
 	}
 
@@ -229,6 +696,18 @@ func GasPrices() string {
 	return globalConfig.Gas.Prices
 }
 
+// GasPriceFloor returns the configured minimum gas price, or false if none is set
+func GasPriceFloor() (sdkmath.LegacyDec, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if globalConfig.Gas.MinPrices == "" {
+		return sdkmath.LegacyDec{}, false
+	}
+
+	return sdkmath.LegacyMustNewDecFromStr(globalConfig.Gas.MinPrices), true
+}
+
 // SetGasPrice updates the gas prices configuration with thread safety
 // Allows dynamic gas price adjustment based on network conditions
 func SetGasPrice(gasPrice string) {
@@ -251,6 +730,75 @@ func RetryMaxAttempts() int  { return globalConfig.Retry.MaxAttempts }
 func RetryMaxDelaySec() time.Duration {
 	return time.Duration(globalConfig.Retry.MaxDelaySec) * time.Second
 }
+func ResubmitQueueSize() int { return globalConfig.Resubmit.QueueSize }
+func ResubmitMaxBackoff() time.Duration {
+	return time.Duration(globalConfig.Resubmit.MaxBackoffSec) * time.Second
+}
+func SubmitDeadlineFraction() float64 { return globalConfig.Submit.DeadlineFraction }
+func AllowedHosts() []string          { return globalConfig.Security.AllowedHosts }
+func BroadcastMode() string           { return globalConfig.Submit.BroadcastMode }
+func SubmitCheckNonce() bool          { return globalConfig.Submit.CheckNonce }
+func SubmitDryRun() bool              { return globalConfig.Submit.DryRun }
+func SubmitMemo() string              { return globalConfig.Submit.Memo }
+func ConfirmTimeout() time.Duration {
+	return time.Duration(globalConfig.Submit.ConfirmTimeoutSec) * time.Second
+}
+func SecretHeaders(host string) map[string]string {
+	return globalConfig.Secrets.Headers[strings.ToLower(host)]
+}
+func SecretRotation(name string) (KeyRotation, bool) {
+	rotation, ok := globalConfig.Secrets.Rotations[name]
+	return rotation, ok
+}
+func MaxConsecutiveFailures() int { return globalConfig.Worker.MaxConsecutiveFailures }
+func DegradedBackoff() time.Duration {
+	return time.Duration(globalConfig.Worker.DegradedBackoffSec) * time.Second
+}
+func LeaderElection() bool { return globalConfig.Worker.LeaderElection }
+func BackupDelay() time.Duration {
+	return time.Duration(globalConfig.Worker.BackupDelaySec) * time.Second
+}
+func JobTTL() time.Duration {
+	return time.Duration(globalConfig.Worker.JobTTLSec) * time.Second
+}
+func CoverageRetry() time.Duration {
+	return time.Duration(globalConfig.Worker.CoverageRetrySec) * time.Second
+}
+func SinkWebhooks() []string { return globalConfig.Sinks.Webhooks }
+func SinkTimeout() time.Duration {
+	return time.Duration(globalConfig.Sinks.TimeoutSec) * time.Second
+}
+func HealthInterval() time.Duration {
+	return time.Duration(globalConfig.Health.IntervalSec) * time.Second
+}
+func HealthTimeout() time.Duration {
+	return time.Duration(globalConfig.Health.TimeoutSec) * time.Second
+}
+func HealthFailureThreshold() int { return globalConfig.Health.FailureThreshold }
+func RestartDelay() time.Duration {
+	return time.Duration(globalConfig.Health.RestartDelaySec) * time.Second
+}
+func EventSilenceTimeout() time.Duration {
+	return time.Duration(globalConfig.Health.EventSilenceSec) * time.Second
+}
+func CertExpiryWarning() time.Duration {
+	return time.Duration(globalConfig.TLS.ExpiryWarningDays) * 24 * time.Hour
+}
+
+// SelfHosts returns the hosts endpoints must not point to: the chain endpoint and security.self_hosts.
+// It returns nil when security.allow_self_endpoints is set.
+func SelfHosts() []string {
+	if globalConfig.Security.AllowSelfEndpoints {
+		return nil
+	}
+
+	hosts := slices.Clone(globalConfig.Security.SelfHosts)
+	if u, err := url.Parse(globalConfig.Chain.Endpoint); err == nil && u.Hostname() != "" {
+		hosts = append(hosts, strings.ToLower(u.Host))
+	}
+
+	return hosts
+}
 
 func TestConfig() error {
 	globalConfig = configData{
@@ -272,6 +820,27 @@ func TestConfig() error {
 			MaxAttempts: 4,
 			MaxDelaySec: 10,
 		},
+		Resubmit: resubmitConfig{
+			QueueSize:     256,
+			MaxBackoffSec: 60,
+		},
+		Health: healthConfig{
+			IntervalSec:      10,
+			TimeoutSec:       10,
+			FailureThreshold: 4,
+			RestartDelaySec:  5,
+		},
+		Submit: submitConfig{
+			BroadcastMode:     flags.BroadcastSync,
+			ConfirmTimeoutSec: 30,
+		},
+		Worker: workerConfig{
+			MaxConsecutiveFailures: 5,
+			DegradedBackoffSec:     900,
+			BackupDelaySec:         10,
+			JobTTLSec:              86400,
+			CoverageRetrySec:       15,
+		},
 	}
 
 	return nil
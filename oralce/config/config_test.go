@@ -0,0 +1,144 @@
+package config
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/gurufinglobal/guru/v2/crypto/hd"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedacted(t *testing.T) {
+	require.NoError(t, TestConfig())
+	globalConfig.Secrets = secretsConfig{
+		File:    "secrets.toml",
+		Headers: map[string]map[string]string{"api.example.com": {"X-Api-Key": "super-secret"}},
+	}
+	globalConfig.Sinks.Webhooks = []string{"https://hooks.example.com/services/T000/B000?token=hook-secret"}
+	secrets = map[string]string{"API_KEY": "file-secret"}
+	t.Cleanup(func() { secrets = nil })
+
+	redacted, err := Redacted()
+	require.NoError(t, err)
+
+	data, err := json.Marshal(redacted)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "super-secret")
+	require.NotContains(t, string(data), "file-secret")
+	require.NotContains(t, string(data), "hook-secret")
+	require.Contains(t, string(data), `"https://hooks.example.com/[REDACTED]"`)
+	require.Contains(t, string(data), `"X-Api-Key":"[REDACTED]"`)
+	require.Contains(t, string(data), `"file":"secrets.toml"`)
+
+	// The live configuration keeps the real value
+	require.Equal(t, "super-secret", SecretHeaders("api.example.com")["X-Api-Key"])
+}
+
+func TestGasPriceFloor(t *testing.T) {
+	require.NoError(t, TestConfig())
+
+	_, ok := GasPriceFloor()
+	require.False(t, ok)
+
+	globalConfig.Gas.MinPrices = "700000000000"
+	require.NoError(t, validateConfig())
+	floor, ok := GasPriceFloor()
+	require.True(t, ok)
+	require.Equal(t, "700000000000.000000000000000000", floor.String())
+
+	for _, invalid := range []string{"abc", "-1"} {
+		globalConfig.Gas.MinPrices = invalid
+		require.Error(t, validateConfig(), invalid)
+	}
+}
+
+func TestApplyEnvOverrides(t *testing.T) {
+	require.NoError(t, TestConfig())
+	globalConfig.Health.IntervalSec = 0
+	globalConfig.Health.TimeoutSec = 0
+	globalConfig.Retry.MaxDelaySec = 7
+
+	env := map[string]string{
+		"ORACLE_CHAIN_ENDPOINT":           "http://node:26657",
+		"ORACLE_GAS_LIMIT":                "90000",
+		"ORACLE_GAS_ADJUSTMENT":           "2.5",
+		"ORACLE_SECURITY_ALLOWED_HOSTS":   "api.example.com, *.example.org",
+		"ORACLE_SUBMIT_DEADLINE_FRACTION": "0.5",
+	}
+	lookup := func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+
+	require.NoError(t, applyEnvOverrides(&globalConfig, lookup))
+	require.NoError(t, validateConfig())
+
+	// Environment values win over the file
+	require.Equal(t, "http://node:26657", ChainEndpoint())
+	require.Equal(t, uint64(90000), GasLimit())
+	require.Equal(t, 2.5, GasAdjustment())
+	require.Equal(t, []string{"api.example.com", "*.example.org"}, AllowedHosts())
+	require.Equal(t, 0.5, SubmitDeadlineFraction())
+
+	// File values remain where no variable is set
+	require.Equal(t, "guru_631-1", ChainID())
+
+	// Defaults still fill fields left empty by both
+	require.Equal(t, 7*time.Second, HealthInterval())
+
+	env = map[string]string{"ORACLE_GAS_LIMIT": "lots"}
+	require.ErrorContains(t, applyEnvOverrides(&globalConfig, lookup), "ORACLE_GAS_LIMIT")
+
+	env = map[string]string{"ORACLE_SECRETS_HEADERS": "x"}
+	require.ErrorContains(t, applyEnvOverrides(&globalConfig, lookup), "cannot be set from the environment")
+}
+
+func TestSelfHosts(t *testing.T) {
+	require.NoError(t, TestConfig())
+	globalConfig.Security.SelfHosts = []string{" Node.Internal:1317 ", "rpc.example.com"}
+	require.NoError(t, validateConfig())
+
+	// The chain endpoint is always part of the list
+	require.Equal(t, []string{"node.internal:1317", "rpc.example.com", "localhost:26657"}, SelfHosts())
+
+	globalConfig.Security.AllowSelfEndpoints = true
+	require.Nil(t, SelfHosts())
+
+	globalConfig.Security.SelfHosts = []string{"http://node/"}
+	require.ErrorContains(t, validateConfig(), "invalid self host")
+}
+
+func TestSubmitMemo(t *testing.T) {
+	require.NoError(t, TestConfig())
+	globalConfig.Submit.Memo = " node-1/{version} "
+	require.NoError(t, validateConfig())
+	require.Equal(t, "node-1/"+version.Version, SubmitMemo())
+
+	globalConfig.Submit.Memo = strings.Repeat("x", MaxMemoLength+1)
+	require.ErrorContains(t, validateConfig(), "submit memo")
+}
+
+func TestCheckKey(t *testing.T) {
+	require.NoError(t, TestConfig())
+	globalConfig.Key.KeyringDir = t.TempDir()
+	globalConfig.Key.KeyringBackend = "test"
+
+	require.ErrorContains(t, CheckKey(), "keyring is empty")
+
+	kr := Keyring()
+	_, _, err := kr.NewMnemonic(KeyName(), keyring.English, sdk.GetConfig().GetFullBIP44Path(), keyring.DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+	require.NoError(t, CheckKey())
+
+	// An ed25519 key cannot sign the transaction that carries the dataset
+	require.NoError(t, kr.Delete(KeyName()))
+	_, err = kr.SaveOfflineKey(KeyName(), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	require.ErrorContains(t, CheckKey(), "cannot sign oracle transactions")
+}
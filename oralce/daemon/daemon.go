@@ -2,15 +2,19 @@ package daemon
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"cosmossdk.io/log"
 	guruconfig "github.com/gurufinglobal/guru/v2/cmd/gurud/config"
 	"github.com/gurufinglobal/guru/v2/encoding"
 	"github.com/gurufinglobal/guru/v2/oralce/config"
+	"github.com/gurufinglobal/guru/v2/oralce/sink"
 	"github.com/gurufinglobal/guru/v2/oralce/submiter"
 	"github.com/gurufinglobal/guru/v2/oralce/subscriber"
 	"github.com/gurufinglobal/guru/v2/oralce/types"
@@ -30,19 +34,32 @@ import (
 type Daemon struct {
 	logger    log.Logger
 	fatalCh   chan error
+	errors    *errorBus
 	clientCtx client.Context
 
 	subscriber *subscriber.Subscriber
 	worker     *worker.WorkerPool
 	submitter  *submiter.Submitter
+	sinks      *sink.Fanout
+
+	// lastEventAt is the unix time of the last event received from any subscription
+	lastEventAt atomic.Int64
+
+	// cancel stops every goroutine started by New; wg tracks the ones owned by the daemon itself
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	stopOnce sync.Once
 }
 
 // New creates and initializes a new Oracle daemon instance
 // Sets up all necessary components including encoding, client context, and sub-services
 func New(ctx context.Context) *Daemon {
 	d := new(Daemon)
+	ctx, d.cancel = context.WithCancel(ctx)
 	d.logger = log.NewLogger(os.Stdout, log.LevelOption(zerolog.DebugLevel), log.TimeFormatOption(time.RFC3339), log.OutputJSONOption())
 	d.fatalCh = make(chan error, 1)
+	d.errors = newErrorBus(config.ChannelSize(), errorLogInterval, d.fatalCh)
+	d.lastEventAt.Store(time.Now().Unix())
 
 	encCfg := encoding.MakeConfig(guruconfig.GuruChainID)
 	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
@@ -77,33 +94,89 @@ func New(ctx context.Context) *Daemon {
 		WithClient(cometClient).
 		WithFromAddress(config.Address()).
 		WithFromName(config.KeyName()).
-		WithBroadcastMode(flags.BroadcastSync)
+		WithBroadcastMode(broadcastMode(config.BroadcastMode()))
 
 	queryClient := oracletypes.NewQueryClient(d.clientCtx)
 	d.subscriber = subscriber.New(ctx, d.logger, cometClient, queryClient)
-	d.worker = worker.New(ctx, d.logger)
+	d.worker = worker.New(ctx, d.logger, queryClient)
 	d.submitter = submiter.NewSubmitter(d.logger, d.clientCtx)
-
-	go d.serveOracleResult(ctx)
-	go d.runEventLoop(ctx, queryClient)
-	go func() {
-		<-ctx.Done()
-		if cometClient.IsRunning() {
-			cometClient.Stop()
-		}
-	}()
+	d.sinks = sink.FromConfig(d.logger)
+
+	d.spawn(func() { d.errors.run(ctx, d.logger) })
+	d.spawn(func() { d.logStartupStatus(ctx, queryClient) })
+	d.spawn(func() { d.serveOracleResult(ctx) })
+	d.spawn(func() { d.submitter.RunResubmitLoop(ctx) })
+	d.spawn(func() { d.submitter.RunConfirmLoop(ctx, d.handleSubmitError) })
+	d.spawn(func() {
+		d.sinks.Run(ctx, func(name string, err error) {
+			d.errors.Publish(severityWarn, "sink", fmt.Errorf("%s: %w", name, err))
+		})
+	})
+	d.spawn(func() { d.runHealthcheck(ctx) })
+	d.spawn(func() { d.runEventLoop(ctx, queryClient) })
 
 	d.logger.Info("daemon initialized")
 
 	return d
 }
 
+// broadcastMode returns the client broadcast mode for the configured submit mode.
+// Block mode broadcasts synchronously and the submitter then waits for inclusion.
+func broadcastMode(mode string) string {
+	if mode == config.BroadcastBlock {
+		return flags.BroadcastSync
+	}
+	return mode
+}
+
 // Fatal returns a channel that signals unrecoverable errors to a supervisor
 func (d *Daemon) Fatal() <-chan error { return d.fatalCh }
 
-func (d *Daemon) runEventLoop(ctx context.Context, queryClient oracletypes.QueryClient) {
-	go d.runHealthcheck(ctx)
+// spawn runs fn in a goroutine that Stop waits for
+func (d *Daemon) spawn(fn func()) {
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		fn()
+	}()
+}
+
+// Stop cancels the daemon and returns once all of its goroutines exited and the node connection is closed.
+// Components stop in dependency order:
+//  1. Producers. The subscriber and the worker pool each close their output channel after their last send,
+//     and every send also watches the context, so no send can block or hit a closed channel.
+//  2. Consumers and background loops. The event loop, result submission, resubmission, sinks, health check
+//     and error bus return on the canceled context or the closed channels; an in-flight broadcast fails fast.
+//  3. The node connection, last, because every stage above may still use it.
+//
+// A replacement daemon must only be created after Stop returned, otherwise two submitters share the account sequence.
+func (d *Daemon) Stop() {
+	d.stopOnce.Do(func() {
+		d.cancel()
+
+		if d.subscriber != nil {
+			d.subscriber.Wait()
+		}
+		d.worker.Wait()
+		d.wg.Wait()
+
+		if client, ok := d.clientCtx.Client.(*comethttp.HTTP); ok && client.IsRunning() {
+			if err := client.Stop(); err != nil {
+				d.logger.Error("stop comet client", "error", err)
+			}
+		}
+		d.logger.Info("daemon stopped")
+	})
+}
+
+// ResyncSequence forces the submitter to reload its account sequence from the chain
+// Intended for operators when the cached sequence drifted (e.g. after manual txs from the same key)
+func (d *Daemon) ResyncSequence() {
+	d.logger.Info("sequence resync requested")
+	d.submitter.SyncSequence()
+}
 
+func (d *Daemon) runEventLoop(ctx context.Context, queryClient oracletypes.QueryClient) {
 	for {
 		select {
 		case <-ctx.Done():
@@ -112,21 +185,14 @@ func (d *Daemon) runEventLoop(ctx context.Context, queryClient oracletypes.Query
 
 		case oracleEvent, ok := <-d.subscriber.EventCh():
 			if !ok {
-				d.logger.Info("subscriber closed")
-				select {
-				case d.fatalCh <- fmt.Errorf("subscriber closed"):
-				default:
-				}
+				d.errors.Publish(severityFatal, "subscriber", fmt.Errorf("subscriber closed"))
 				return
 			}
+			d.lastEventAt.Store(time.Now().Unix())
 
 			switch event := oracleEvent.(type) {
 			case error:
-				d.logger.Error("event error", "error", event)
-				select {
-				case d.fatalCh <- event:
-				default:
-				}
+				d.errors.Publish(severityFatal, "subscriber", event)
 				return
 
 			case oracletypes.OracleRequestDoc:
@@ -134,7 +200,7 @@ func (d *Daemon) runEventLoop(ctx context.Context, queryClient oracletypes.Query
 				if event.Nonce != 0 {
 					res, err := queryClient.OracleData(ctx, &oracletypes.QueryOracleDataRequest{RequestId: event.RequestId})
 					if err != nil {
-						d.logger.Error("query error", "error", err, "request_id", event.RequestId)
+						d.errors.Publish(severityWarn, "query", fmt.Errorf("query oracle data of request %d: %w", event.RequestId, err))
 						continue
 					}
 
@@ -146,23 +212,64 @@ func (d *Daemon) runEventLoop(ctx context.Context, queryClient oracletypes.Query
 				for i, reqID := range event.Events[types.CompleteID] {
 					nonce, err := strconv.ParseUint(event.Events[types.CompleteNonce][i], 10, 64)
 					if err != nil {
-						d.logger.Error("parse nonce error", "error", err, "req_id", reqID)
+						d.errors.Publish(severityWarn, "subscriber", fmt.Errorf("parse nonce of request %s: %w", reqID, err))
 						continue
 					}
 
 					timestamp, err := strconv.ParseUint(event.Events[types.CompleteTime][i], 10, 64)
 					if err != nil {
-						d.logger.Error("parse time error", "error", err, "req_id", reqID)
+						d.errors.Publish(severityWarn, "subscriber", fmt.Errorf("parse time of request %s: %w", reqID, err))
 						continue
 					}
 
 					d.worker.ProcessComplete(ctx, reqID, nonce, timestamp)
 				}
+
+				// round_completed is emitted in the same block as the complete event, so it arrives on the complete subscription
+				d.logRoundSummaries(event.Events)
+
+				for i, reqID := range event.Events[types.CancelID] {
+					nonce, err := strconv.ParseUint(event.Events[types.CancelNonce][i], 10, 64)
+					if err != nil {
+						d.errors.Publish(severityWarn, "subscriber", fmt.Errorf("parse cancelled nonce of request %s: %w", reqID, err))
+						continue
+					}
+
+					timestamp, err := strconv.ParseUint(event.Events[types.CancelTime][i], 10, 64)
+					if err != nil {
+						d.errors.Publish(severityWarn, "subscriber", fmt.Errorf("parse cancel time of request %s: %w", reqID, err))
+						continue
+					}
+
+					d.worker.ProcessCancel(ctx, reqID, nonce, timestamp)
+				}
 			}
 		}
 	}
 }
 
+// logRoundSummaries logs one line per round_completed event finalized in a block
+func (d *Daemon) logRoundSummaries(events map[string][]string) {
+	attr := func(key string, i int) string {
+		if i < len(events[key]) {
+			return events[key][i]
+		}
+		return ""
+	}
+
+	for i, reqID := range events[types.RoundID] {
+		d.logger.Info("round completed",
+			"request_id", reqID,
+			"nonce", attr(types.RoundNonce, i),
+			"reports", attr(types.RoundReports, i),
+			"quorum", attr(types.RoundQuorum, i),
+			"value", attr(types.RoundValue, i),
+			"excluded", attr(types.RoundExcluded, i),
+			"missed", attr(types.RoundMissed, i),
+			"duration_sec", attr(types.RoundDuration, i))
+	}
+}
+
 // serveOracleResult processes completed Oracle jobs and submits results to blockchain
 // Runs in a separate goroutine to handle result submission asynchronously
 func (d *Daemon) serveOracleResult(ctx context.Context) {
@@ -179,21 +286,31 @@ func (d *Daemon) serveOracleResult(ctx context.Context) {
 			}
 
 			if result == nil {
-				d.logger.Error("http client error")
-				select {
-				case d.fatalCh <- fmt.Errorf("oracle result is nil"):
-				default:
-				}
+				d.errors.Publish(severityFatal, "worker", fmt.Errorf("oracle result is nil"))
 				return
 			}
+			d.sinks.Publish(*result)
 			d.logger.Info("submit result", "id", result.ID, "nonce", result.Nonce)
-			d.submitter.BroadcastTxWithRetry(ctx, *result)
+			if err := d.submitter.BroadcastTxWithRetry(ctx, *result); err != nil {
+				d.handleSubmitError(*result, err)
+			}
 		}
 	}
 }
 
+// handleSubmitError reacts to a failed submission, whether the submitter returned it (sync and block)
+// or the confirmation loop found it in a block (async)
+func (d *Daemon) handleSubmitError(result types.OracleJobResult, err error) {
+	if errors.Is(err, submiter.ErrRequestNotEnabled) {
+		d.logger.Info("request disabled on chain, removing job", "id", result.ID)
+		d.worker.RemoveJob(result.ID)
+		return
+	}
+	d.errors.Publish(severityWarn, "submitter", fmt.Errorf("submit request %d: %w", result.ID, err))
+}
+
 func (d *Daemon) runHealthcheck(ctx context.Context) {
-	ticker := time.NewTicker(config.RetryMaxDelaySec())
+	ticker := time.NewTicker(config.HealthInterval())
 	defer ticker.Stop()
 
 	failures := 0
@@ -206,29 +323,45 @@ func (d *Daemon) runHealthcheck(ctx context.Context) {
 		case <-ticker.C:
 			if d.isWebSocketHealthy(ctx) {
 				failures = 0
+				if err := d.checkEventSilence(time.Now()); err != nil {
+					d.errors.Publish(severityFatal, "healthcheck", err)
+					return
+				}
 				continue
 			}
 
 			failures++
-			if failures <= config.RetryMaxAttempts() {
+			if failures <= config.HealthFailureThreshold() {
 				d.logger.Info("websocket unhealthy", "attempt", failures)
 				continue
 			}
 
-			d.logger.Error("websocket unhealthy limit reached")
-			select {
-			case d.fatalCh <- fmt.Errorf("websocket connection failed after %d attempts", failures):
-			default:
-			}
+			d.errors.Publish(severityFatal, "healthcheck", fmt.Errorf("websocket connection failed after %d attempts", failures))
 			return
 		}
 	}
 }
 
+// checkEventSilence returns an error when the connection is up but no event arrived within
+// health.event_silence_sec, e.g. because the subscription queries no longer match after an upgrade
+func (d *Daemon) checkEventSilence(now time.Time) error {
+	timeout := config.EventSilenceTimeout()
+	if timeout <= 0 {
+		return nil
+	}
+
+	silence := now.Sub(time.Unix(d.lastEventAt.Load(), 0))
+	if silence < timeout {
+		return nil
+	}
+
+	return fmt.Errorf("no oracle events received for %s while the node is healthy", silence.Truncate(time.Second))
+}
+
 // isWebSocketHealthy checks if WebSocket connection is working by attempting a lightweight operation
 // Returns true if the WebSocket client is running and can successfully call Status API
 func (d *Daemon) isWebSocketHealthy(ctx context.Context) bool {
-	ctx, cancel := context.WithTimeout(ctx, config.RetryMaxDelaySec())
+	ctx, cancel := context.WithTimeout(ctx, config.HealthTimeout())
 	defer cancel()
 
 	client := d.clientCtx.Client.(*comethttp.HTTP)
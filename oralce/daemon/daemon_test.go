@@ -0,0 +1,41 @@
+package daemon
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/gurufinglobal/guru/v2/oralce/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogRoundSummaries(t *testing.T) {
+	var buf bytes.Buffer
+	d := &Daemon{logger: log.NewLogger(&buf, log.OutputJSONOption())}
+
+	// A complete subscription event carrying two finalized rounds
+	d.logRoundSummaries(map[string][]string{
+		types.CompleteID:    {"1", "2"},
+		types.RoundID:       {"1", "2"},
+		types.RoundNonce:    {"7", "3"},
+		types.RoundReports:  {"3", "2"},
+		types.RoundQuorum:   {"2", "2"},
+		types.RoundValue:    {"65000.1", "1.5"},
+		types.RoundExcluded: {"guru1excluded", ""},
+		types.RoundMissed:   {"", ""},
+		types.RoundDuration: {"12", "30"},
+	})
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	require.Contains(t, string(lines[0]), `"message":"round completed"`)
+	require.Contains(t, string(lines[0]), `"request_id":"1"`)
+	require.Contains(t, string(lines[0]), `"excluded":"guru1excluded"`)
+	require.Contains(t, string(lines[1]), `"nonce":"3"`)
+	require.Contains(t, string(lines[1]), `"duration_sec":"30"`)
+
+	// A cancel event has no round_completed attributes and logs nothing
+	buf.Reset()
+	d.logRoundSummaries(map[string][]string{types.CancelID: {"1"}})
+	require.Zero(t, buf.Len())
+}
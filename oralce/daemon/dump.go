@@ -0,0 +1,83 @@
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	comethttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/gurufinglobal/guru/v2/oralce/config"
+	"github.com/gurufinglobal/guru/v2/oralce/submiter"
+	"github.com/gurufinglobal/guru/v2/oralce/worker"
+)
+
+// supportBundle is a snapshot of the daemon state handed to support when diagnosing a node
+type supportBundle struct {
+	Time               time.Time              `json:"time"`
+	ChainID            string                 `json:"chain_id"`
+	Address            string                 `json:"address"`
+	WebSocketRunning   bool                   `json:"websocket_running"`
+	Jobs               []worker.JobState      `json:"jobs"`
+	Resubmit           submiter.ResubmitStats `json:"resubmit"`
+	MissedDeadlines    uint64                 `json:"missed_deadlines"`
+	SkippedStale       uint64                 `json:"skipped_stale"`
+	DryRuns            uint64                 `json:"dry_runs"`
+	Unconfirmed        uint64                 `json:"unconfirmed"`
+	SinkDropped        uint64                 `json:"sink_dropped"`
+	DegradedJobs       int                    `json:"degraded_jobs"`
+	JobStoreSize       int                    `json:"job_store_size"`
+	WrappedAssignments uint64                 `json:"wrapped_assignments"`
+	Config             map[string]any         `json:"config"`
+}
+
+// WriteSupportBundle writes the current jobs, their recent executions, the resubmission queue
+// statistics and the redacted configuration as JSON into <home>/support and returns the file path.
+// Secret values and keyring contents are never included.
+func (d *Daemon) WriteSupportBundle() (string, error) {
+	cfg, err := config.Redacted()
+	if err != nil {
+		return "", err
+	}
+
+	bundle := supportBundle{
+		Time:     time.Now().UTC(),
+		ChainID:  d.clientCtx.ChainID,
+		Address:  d.clientCtx.GetFromAddress().String(),
+		Jobs:     d.worker.Jobs(),
+		Resubmit: d.submitter.Stats(),
+		Config:   cfg,
+
+		MissedDeadlines: d.submitter.MissedDeadlines(),
+		SkippedStale:    d.submitter.SkippedStale(),
+		DryRuns:         d.submitter.DryRuns(),
+		Unconfirmed:     d.submitter.Unconfirmed(),
+		SinkDropped:     d.sinks.Dropped(),
+		DegradedJobs:    d.worker.DegradedJobs(),
+		JobStoreSize:    d.worker.JobCount(),
+
+		WrappedAssignments: d.worker.WrappedAssignments(),
+	}
+	if client, ok := d.clientCtx.Client.(*comethttp.HTTP); ok {
+		bundle.WebSocketRunning = client.IsRunning()
+	}
+
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal support bundle: %w", err)
+	}
+
+	dir := filepath.Join(config.Home(), "support")
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("oracled-%s.json", bundle.Time.Format("20060102T150405Z")))
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return "", fmt.Errorf("failed to write support bundle: %w", err)
+	}
+
+	d.logger.Info("support bundle written", "path", path, "jobs", len(bundle.Jobs))
+	return path, nil
+}
@@ -0,0 +1,137 @@
+package daemon
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/log"
+)
+
+// errorLogInterval is how often the same error from the same source is logged
+const errorLogInterval = time.Minute
+
+// maxTrackedErrors bounds the number of distinct errors remembered for rate limiting
+const maxTrackedErrors = 1024
+
+type severity int
+
+const (
+	severityInfo severity = iota
+	severityWarn
+	severityFatal
+)
+
+// errorEvent is an error published by a daemon component
+type errorEvent struct {
+	severity severity
+	source   string
+	err      error
+}
+
+// errorState tracks when an error was last logged and how many repeats were suppressed since
+type errorState struct {
+	lastLogged time.Time
+	suppressed int
+}
+
+// errorBus collects component errors so the daemon handles them in one place.
+// Repeats of the same error from the same source are logged at most once per interval;
+// fatal errors are always logged and routed to the daemon's fatal channel.
+type errorBus struct {
+	ch       chan errorEvent
+	fatalCh  chan<- error
+	interval time.Duration
+	seen     map[string]*errorState
+}
+
+func newErrorBus(size int, interval time.Duration, fatalCh chan<- error) *errorBus {
+	return &errorBus{
+		ch:       make(chan errorEvent, size),
+		fatalCh:  fatalCh,
+		interval: interval,
+		seen:     make(map[string]*errorState),
+	}
+}
+
+// Publish hands an error to the bus without blocking.
+// A fatal error is routed to the fatal channel immediately, so it is never lost to a full bus;
+// other errors are dropped when the bus is full.
+func (b *errorBus) Publish(sev severity, source string, err error) {
+	if sev == severityFatal {
+		select {
+		case b.fatalCh <- err:
+		default:
+		}
+	}
+
+	select {
+	case b.ch <- errorEvent{severity: sev, source: source, err: err}:
+	default:
+	}
+}
+
+// shouldLog reports whether an event is due for logging and how many repeats were suppressed before it
+func (b *errorBus) shouldLog(event errorEvent, now time.Time) (bool, int) {
+	key := event.source + "\x00" + event.err.Error()
+
+	state, ok := b.seen[key]
+	if !ok {
+		if len(b.seen) >= maxTrackedErrors {
+			b.prune(now)
+		}
+		b.seen[key] = &errorState{lastLogged: now}
+		return true, 0
+	}
+
+	if now.Sub(state.lastLogged) < b.interval {
+		state.suppressed++
+		return false, 0
+	}
+
+	suppressed := state.suppressed
+	state.lastLogged = now
+	state.suppressed = 0
+	return true, suppressed
+}
+
+// prune forgets errors that have not been logged within the interval
+func (b *errorBus) prune(now time.Time) {
+	for key, state := range b.seen {
+		if now.Sub(state.lastLogged) >= b.interval {
+			delete(b.seen, key)
+		}
+	}
+}
+
+// run logs published errors with rate limiting until ctx is done
+func (b *errorBus) run(ctx context.Context, logger log.Logger) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case event := <-b.ch:
+			if event.severity == severityFatal {
+				logger.Error("fatal error", "source", event.source, "error", event.err)
+				continue
+			}
+
+			ok, suppressed := b.shouldLog(event, time.Now())
+			if !ok {
+				continue
+			}
+
+			keyvals := []any{"source", event.source, "error", event.err}
+			if 0 < suppressed {
+				keyvals = append(keyvals, "suppressed", suppressed)
+			}
+
+			switch event.severity {
+			case severityWarn:
+				logger.Warn("component error", keyvals...)
+			default:
+				logger.Info("component error", keyvals...)
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package daemon
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorBus_RateLimitsRepeats(t *testing.T) {
+	bus := newErrorBus(8, time.Minute, make(chan error, 1))
+	now := time.Now()
+	event := errorEvent{severity: severityWarn, source: "submitter", err: errors.New("broadcast failed")}
+
+	ok, suppressed := bus.shouldLog(event, now)
+	require.True(t, ok)
+	require.Zero(t, suppressed)
+
+	// Repeats within the interval are suppressed
+	for i := 0; i < 3; i++ {
+		ok, _ = bus.shouldLog(event, now.Add(time.Second))
+		require.False(t, ok)
+	}
+
+	// The same error from another source is tracked separately
+	ok, _ = bus.shouldLog(errorEvent{severity: severityWarn, source: "query", err: event.err}, now.Add(time.Second))
+	require.True(t, ok)
+
+	// After the interval the error is logged again with the suppressed count
+	ok, suppressed = bus.shouldLog(event, now.Add(time.Minute))
+	require.True(t, ok)
+	require.Equal(t, 3, suppressed)
+}
+
+func TestErrorBus_FatalIsRouted(t *testing.T) {
+	fatalCh := make(chan error, 1)
+	bus := newErrorBus(1, time.Minute, fatalCh)
+
+	// Fill the bus so only the fatal channel can take the error
+	bus.Publish(severityWarn, "query", errors.New("query failed"))
+	bus.Publish(severityFatal, "healthcheck", errors.New("websocket down"))
+
+	require.EqualError(t, <-fatalCh, "websocket down")
+}
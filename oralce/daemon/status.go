@@ -0,0 +1,92 @@
+package daemon
+
+import (
+	"context"
+	"slices"
+
+	"github.com/gurufinglobal/guru/v2/oralce/config"
+	oracletypes "github.com/gurufinglobal/guru/v2/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// assignment describes a request document that lists this instance as a provider
+type assignment struct {
+	RequestID     uint64
+	Name          string
+	Status        oracletypes.RequestStatus
+	EndpointIndex int
+	Providers     int
+}
+
+// assignmentsFor returns the assignments of address in docs, computing the endpoint the worker will use
+func assignmentsFor(address string, docs []oracletypes.OracleRequestDoc) []assignment {
+	assignments := make([]assignment, 0, len(docs))
+	for _, doc := range docs {
+		index := slices.Index(doc.AccountList, address)
+		if index == -1 || len(doc.Endpoints) == 0 {
+			continue
+		}
+
+		assignments = append(assignments, assignment{
+			RequestID:     doc.RequestId,
+			Name:          doc.Name,
+			Status:        doc.Status,
+			EndpointIndex: (index + 1) % len(doc.AccountList) % len(doc.Endpoints),
+			Providers:     len(doc.AccountList),
+		})
+	}
+
+	return assignments
+}
+
+// logStartupStatus logs whether this instance is listed as a provider and which requests it serves.
+// Providers cannot add themselves to account lists; an unassigned instance needs the moderator to update the requests.
+func (d *Daemon) logStartupStatus(ctx context.Context, queryClient oracletypes.QueryClient) {
+	address := config.Address().String()
+
+	var docs []oracletypes.OracleRequestDoc
+	var nextKey []byte
+	for {
+		res, err := queryClient.RequestsByAccount(ctx, &oracletypes.QueryRequestsByAccountRequest{
+			Account:    address,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			d.logger.Error("query assigned requests", "address", address, "error", err)
+			return
+		}
+
+		docs = append(docs, res.RequestDocs...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = res.Pagination.NextKey
+	}
+
+	assignments := assignmentsFor(address, docs)
+	if len(assignments) == 0 {
+		moderator := ""
+		if res, err := queryClient.ModeratorAddress(ctx, &oracletypes.QueryModeratorAddressRequest{}); err == nil {
+			moderator = res.ModeratorAddress
+		}
+		d.logger.Info("provider not assigned to any request; ask the moderator to add this address to request account lists",
+			"address", address,
+			"moderator", moderator)
+		return
+	}
+
+	enabled := 0
+	for _, a := range assignments {
+		if a.Status == oracletypes.RequestStatus_REQUEST_STATUS_ENABLED {
+			enabled++
+		}
+		d.logger.Info("assigned request",
+			"request_id", a.RequestID,
+			"name", a.Name,
+			"status", a.Status,
+			"endpoint_index", a.EndpointIndex,
+			"providers", a.Providers)
+	}
+
+	d.logger.Info("provider status", "address", address, "assigned", len(assignments), "enabled", enabled)
+}
@@ -0,0 +1,29 @@
+package daemon
+
+import (
+	"testing"
+
+	oracletypes "github.com/gurufinglobal/guru/v2/x/oracle/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignmentsFor(t *testing.T) {
+	endpoints := []*oracletypes.OracleEndpoint{{Url: "https://a"}, {Url: "https://b"}}
+	docs := []oracletypes.OracleRequestDoc{
+		{RequestId: 1, Name: "BTC/USD", AccountList: []string{"alice", "bob", "carol"}, Endpoints: endpoints, Status: oracletypes.RequestStatus_REQUEST_STATUS_ENABLED},
+		{RequestId: 2, Name: "ETH/USD", AccountList: []string{"alice"}, Endpoints: endpoints, Status: oracletypes.RequestStatus_REQUEST_STATUS_PAUSED},
+		{RequestId: 3, Name: "SOL/USD", AccountList: []string{"carol"}, Endpoints: endpoints},
+	}
+
+	require.Equal(t, []assignment{
+		{RequestID: 1, Name: "BTC/USD", Status: oracletypes.RequestStatus_REQUEST_STATUS_ENABLED, EndpointIndex: 0, Providers: 3},
+	}, assignmentsFor("bob", docs))
+
+	// Alice is last to wrap in request 1 and alone in request 2
+	got := assignmentsFor("alice", docs)
+	require.Len(t, got, 2)
+	require.Equal(t, 1, got[0].EndpointIndex)
+	require.Equal(t, 0, got[1].EndpointIndex)
+
+	require.Empty(t, assignmentsFor("dave", docs))
+}
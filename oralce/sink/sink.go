@@ -0,0 +1,144 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/gurufinglobal/guru/v2/oralce/config"
+	"github.com/gurufinglobal/guru/v2/oralce/types"
+)
+
+// ResultSink receives oracle results in addition to the on-chain submitter
+type ResultSink interface {
+	// Name identifies the sink in logs and errors
+	Name() string
+	// Send delivers a single result; it must return once ctx is done
+	Send(ctx context.Context, result types.OracleJobResult) error
+}
+
+// payload is the JSON body posted by Webhook
+type payload struct {
+	RequestID uint64 `json:"request_id"`
+	Nonce     uint64 `json:"nonce"`
+	Data      string `json:"data"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// Webhook posts each result as JSON to a URL
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook returns a sink posting to url with the given request timeout
+func NewWebhook(url string, timeout time.Duration) *Webhook {
+	return &Webhook{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (w *Webhook) Name() string { return "webhook " + w.url }
+
+func (w *Webhook) Send(ctx context.Context, result types.OracleJobResult) error {
+	body, err := json.Marshal(payload{
+		RequestID: result.ID,
+		Nonce:     result.Nonce,
+		Data:      result.Data,
+		Timestamp: time.Now().Unix(),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal result: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post result: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("post result: unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Fanout delivers results to sinks in the background so a slow or failing sink never delays submission
+type Fanout struct {
+	logger  log.Logger
+	sinks   []ResultSink
+	ch      chan types.OracleJobResult
+	dropped atomic.Uint64
+}
+
+// NewFanout returns a fanout over sinks buffering up to size results
+func NewFanout(logger log.Logger, size int, sinks ...ResultSink) *Fanout {
+	return &Fanout{
+		logger: logger,
+		sinks:  sinks,
+		ch:     make(chan types.OracleJobResult, size),
+	}
+}
+
+// FromConfig builds a fanout over the sinks configured in the sinks section
+func FromConfig(logger log.Logger) *Fanout {
+	var sinks []ResultSink
+	for _, url := range config.SinkWebhooks() {
+		sinks = append(sinks, NewWebhook(url, config.SinkTimeout()))
+	}
+
+	return NewFanout(logger, config.ChannelSize(), sinks...)
+}
+
+// Publish queues a result for every sink. It never blocks; results are dropped while the buffer is full.
+func (f *Fanout) Publish(result types.OracleJobResult) {
+	if len(f.sinks) == 0 {
+		return
+	}
+
+	select {
+	case f.ch <- result:
+	default:
+		f.dropped.Add(1)
+	}
+}
+
+// Dropped returns the number of results discarded because the buffer was full
+func (f *Fanout) Dropped() uint64 { return f.dropped.Load() }
+
+// Run delivers queued results until ctx is done, reporting sink failures to onError
+func (f *Fanout) Run(ctx context.Context, onError func(sink string, err error)) {
+	if len(f.sinks) == 0 {
+		return
+	}
+	f.logger.Info("result sinks started", "sinks", len(f.sinks))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case result := <-f.ch:
+			for _, s := range f.sinks {
+				if err := s.Send(ctx, result); err != nil {
+					onError(s.Name(), err)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/gurufinglobal/guru/v2/oralce/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhook_PostsResult(t *testing.T) {
+	received := make(chan payload, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var body payload
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		received <- body
+	}))
+	defer server.Close()
+
+	webhook := NewWebhook(server.URL, time.Second)
+	require.NoError(t, webhook.Send(context.Background(), types.OracleJobResult{ID: 3, Nonce: 7, Data: "1388.95"}))
+
+	body := <-received
+	require.Equal(t, uint64(3), body.RequestID)
+	require.Equal(t, uint64(7), body.Nonce)
+	require.Equal(t, "1388.95", body.Data)
+}
+
+func TestWebhook_RejectsErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := NewWebhook(server.URL, time.Second).Send(context.Background(), types.OracleJobResult{ID: 1, Nonce: 1, Data: "1"})
+	require.ErrorContains(t, err, "unexpected status 503")
+}
+
+type failingSink struct{}
+
+func (failingSink) Name() string { return "failing" }
+func (failingSink) Send(context.Context, types.OracleJobResult) error {
+	return errors.New("unavailable")
+}
+
+func TestFanout_IsolatesSinkErrors(t *testing.T) {
+	received := make(chan payload, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body payload
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		received <- body
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	failures := make(chan string, 1)
+	fanout := NewFanout(log.NewNopLogger(), 1, failingSink{}, NewWebhook(server.URL, time.Second))
+	go fanout.Run(ctx, func(sink string, err error) { failures <- sink })
+
+	fanout.Publish(types.OracleJobResult{ID: 1, Nonce: 2, Data: "42"})
+
+	// A failing sink neither blocks publishing nor prevents delivery to the others
+	require.Equal(t, "failing", <-failures)
+	require.Equal(t, uint64(2), (<-received).Nonce)
+}
+
+func TestFanout_DropsWhenFull(t *testing.T) {
+	fanout := NewFanout(log.NewNopLogger(), 1, failingSink{})
+
+	fanout.Publish(types.OracleJobResult{ID: 1})
+	fanout.Publish(types.OracleJobResult{ID: 2})
+	require.Equal(t, uint64(1), fanout.Dropped())
+}
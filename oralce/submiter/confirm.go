@@ -0,0 +1,159 @@
+package submiter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gurufinglobal/guru/v2/oralce/config"
+	"github.com/gurufinglobal/guru/v2/oralce/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// confirmInterval is how often async and block submissions are looked up
+var confirmInterval = time.Second
+
+// pendingTx is an async broadcast waiting to appear in a block
+type pendingTx struct {
+	hash   string
+	result types.OracleJobResult
+	sentAt time.Time
+}
+
+// trackPending records an async broadcast for the confirmation loop
+func (s *Submitter) trackPending(hash string, result types.OracleJobResult) {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+
+	s.pending = append(s.pending, &pendingTx{hash: hash, result: result, sentAt: time.Now()})
+}
+
+// Unconfirmed returns how many async or block submissions were not included within submit.confirm_timeout_sec
+func (s *Submitter) Unconfirmed() uint64 { return s.unconfirmed.Load() }
+
+// RunConfirmLoop confirms async broadcasts until ctx is done, reporting submissions that failed in a block
+// to onFailure. It returns immediately in the other modes, which learn the outcome of a submission before returning.
+func (s *Submitter) RunConfirmLoop(ctx context.Context, onFailure func(result types.OracleJobResult, err error)) {
+	if config.BroadcastMode() != flags.BroadcastAsync {
+		return
+	}
+
+	ticker := time.NewTicker(confirmInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("confirm loop done")
+			return
+
+		case <-ticker.C:
+			s.checkPending(time.Now(), onFailure)
+		}
+	}
+}
+
+// checkPending resolves the async broadcasts that were included or timed out.
+// A failed transaction that was included is handed to onFailure, as a sync submission would return it.
+// A transaction still missing after submit.confirm_timeout_sec was most likely rejected by CheckTx,
+// which consumes no sequence, so the cached sequence is resynced before its result is resubmitted.
+func (s *Submitter) checkPending(now time.Time, onFailure func(result types.OracleJobResult, err error)) {
+	s.pendingMu.Lock()
+	pending := s.pending
+	s.pending = nil
+	s.pendingMu.Unlock()
+
+	var waiting []*pendingTx
+	resync := false
+	for _, tx := range pending {
+		res, err := s.queryTx(tx.hash)
+		if err != nil {
+			if now.Sub(tx.sentAt) < config.ConfirmTimeout() {
+				waiting = append(waiting, tx)
+				continue
+			}
+
+			s.unconfirmed.Add(1)
+			s.logger.Warn("async broadcast not included, resubmitting",
+				"tx_hash", tx.hash,
+				"id", tx.result.ID,
+				"nonce", tx.result.Nonce,
+				"error", err)
+			s.enqueueResubmit(tx.result)
+			resync = true
+			continue
+		}
+
+		if err := includedResult(res); err != nil {
+			s.logger.Warn("async broadcast failed in block",
+				"tx_hash", tx.hash,
+				"id", tx.result.ID,
+				"nonce", tx.result.Nonce,
+				"error", err)
+			onFailure(tx.result, err)
+			continue
+		}
+		s.logger.Debug("async broadcast confirmed", "tx_hash", tx.hash, "height", res.Height)
+	}
+
+	s.pendingMu.Lock()
+	s.pending = append(waiting, s.pending...)
+	s.pendingMu.Unlock()
+
+	if resync {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		before := s.sequenceN
+		if err := s.syncWithChain(); err != nil {
+			s.logger.Warn("failed to resync sequence after dropped broadcast", "error", err)
+			return
+		}
+		s.logger.Info("sequence resynced after dropped broadcast", "before", before, "after", s.sequenceN)
+	}
+}
+
+// awaitInclusion polls for a transaction accepted by CheckTx until it is included or
+// submit.confirm_timeout_sec passed. A transaction that is still missing is reported as
+// errAttemptsExhausted so its result is resubmitted; the resubmission is dropped if the round
+// finalized meanwhile.
+func (s *Submitter) awaitInclusion(ctx context.Context, hash string, jobResult types.OracleJobResult) error {
+	deadline := time.Now().Add(config.ConfirmTimeout())
+	for {
+		if res, err := s.queryTx(hash); err == nil {
+			if err := includedResult(res); err != nil {
+				s.logger.Warn("broadcast failed in block", "tx_hash", hash, "id", jobResult.ID, "nonce", jobResult.Nonce, "error", err)
+				return err
+			}
+			s.logger.Info("broadcast included", "tx_hash", hash, "height", res.Height)
+			return nil
+		}
+
+		if !time.Now().Before(deadline) {
+			s.unconfirmed.Add(1)
+			return fmt.Errorf("%w: tx %s not included within %s", errAttemptsExhausted, hash, config.ConfirmTimeout())
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(confirmInterval):
+		}
+	}
+}
+
+// includedResult interprets the result of a submission included in a block.
+// A nonce mismatch means the round was certified without this submission and is not an error.
+func includedResult(res *sdk.TxResponse) error {
+	switch {
+	case res.Code == 0:
+		return nil
+	case isRequestNotEnabled(res.Codespace, res.Code):
+		return ErrRequestNotEnabled
+	case isNonceMismatch(res.Codespace, res.Code):
+		return nil
+	default:
+		return fmt.Errorf("transaction failed in block with code %d: %s", res.Code, res.RawLog)
+	}
+}
@@ -0,0 +1,162 @@
+package submiter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gurufinglobal/guru/v2/oralce/config"
+	"github.com/gurufinglobal/guru/v2/oralce/types"
+	oracletypes "github.com/gurufinglobal/guru/v2/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAccountRetriever reports a fixed on-chain sequence
+type mockAccountRetriever struct {
+	client.AccountRetriever
+	sequence uint64
+}
+
+func (m mockAccountRetriever) GetAccountNumberSequence(client.Context, sdk.AccAddress) (uint64, uint64, error) {
+	return 1, m.sequence, nil
+}
+
+// mockTxs answers queryTx from the included transactions
+type mockTxs struct {
+	mu  sync.Mutex
+	txs map[string]*sdk.TxResponse
+}
+
+func (m *mockTxs) include(hash string, res *sdk.TxResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.txs[hash] = res
+}
+
+func (m *mockTxs) query(hash string) (*sdk.TxResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if res, ok := m.txs[hash]; ok {
+		return res, nil
+	}
+	return nil, errors.New("tx not found")
+}
+
+func newConfirmTestSubmitter(t *testing.T, chainSequence uint64) (*Submitter, *mockTxs) {
+	t.Helper()
+	txs := &mockTxs{txs: make(map[string]*sdk.TxResponse)}
+
+	s := newTestSubmitter(t)
+	s.clientCtx = client.Context{}.WithAccountRetriever(mockAccountRetriever{sequence: chainSequence})
+	s.queryTx = txs.query
+	return s, txs
+}
+
+func TestRecordAccepted_SequenceByMode(t *testing.T) {
+	for _, mode := range []string{flags.BroadcastSync, flags.BroadcastAsync, config.BroadcastBlock} {
+		s, _ := newConfirmTestSubmitter(t, 0)
+		s.sequenceN = 10
+
+		s.recordAccepted(mode, "A", types.OracleJobResult{ID: 1, Nonce: 1})
+		s.recordAccepted(mode, "B", types.OracleJobResult{ID: 2, Nonce: 1})
+
+		// Every accepted broadcast takes the next sequence; only async leaves them to be confirmed
+		require.Equal(t, uint64(12), s.sequenceN, mode)
+		if mode == flags.BroadcastAsync {
+			require.Len(t, s.pending, 2, mode)
+		} else {
+			require.Empty(t, s.pending, mode)
+		}
+	}
+}
+
+func TestCheckPending_Async(t *testing.T) {
+	s, txs := newConfirmTestSubmitter(t, 11)
+	s.sequenceN = 10
+
+	for _, hash := range []string{"ok", "failed", "late", "dropped"} {
+		s.recordAccepted(flags.BroadcastAsync, hash, types.OracleJobResult{ID: uint64(len(hash)), Nonce: 3})
+	}
+	require.Equal(t, uint64(14), s.sequenceN)
+
+	txs.include("ok", &sdk.TxResponse{Code: 0})
+	txs.include("failed", &sdk.TxResponse{Code: 11, RawLog: "out of gas"})
+
+	var failed []uint64
+	onFailure := func(result types.OracleJobResult, err error) { failed = append(failed, result.ID) }
+
+	// Included transactions are resolved; a failure is reported like a sync one, not resubmitted, and needs no resync
+	s.checkPending(time.Now(), onFailure)
+	require.Len(t, s.pending, 2)
+	require.Equal(t, uint64(14), s.sequenceN)
+	require.Equal(t, []uint64{6}, failed)
+	require.Zero(t, s.Stats().Depth)
+
+	// "late" lands in time; "dropped" never does, so the sequence falls back to the chain's
+	txs.include("late", &sdk.TxResponse{Code: 0})
+	s.checkPending(time.Now().Add(config.ConfirmTimeout()), onFailure)
+	require.Empty(t, s.pending)
+	require.Equal(t, uint64(11), s.sequenceN)
+	require.Equal(t, uint64(1), s.Unconfirmed())
+	require.Equal(t, 1, s.Stats().Depth)
+	require.Len(t, failed, 1)
+}
+
+func TestCheckPending_AsyncRequestNotEnabled(t *testing.T) {
+	s, txs := newConfirmTestSubmitter(t, 0)
+
+	s.recordAccepted(flags.BroadcastAsync, "A", types.OracleJobResult{ID: 1, Nonce: 3})
+	txs.include("A", &sdk.TxResponse{
+		Codespace: oracletypes.ErrRequestNotEnabled.Codespace(),
+		Code:      oracletypes.ErrRequestNotEnabled.ABCICode(),
+	})
+
+	// A disabled request is reported so its job is removed, and not resubmitted
+	var reported error
+	s.checkPending(time.Now(), func(result types.OracleJobResult, err error) { reported = err })
+	require.Empty(t, s.pending)
+	require.Zero(t, s.Stats().Depth)
+	require.ErrorIs(t, reported, ErrRequestNotEnabled)
+}
+
+func TestAwaitInclusion_Block(t *testing.T) {
+	confirmInterval = 10 * time.Millisecond
+	t.Cleanup(func() { confirmInterval = time.Second })
+
+	s, txs := newConfirmTestSubmitter(t, 0)
+	s.sequenceN = 10
+	ctx := context.Background()
+	result := types.OracleJobResult{ID: 1, Nonce: 3}
+
+	txs.include("ok", &sdk.TxResponse{Code: 0})
+	require.NoError(t, s.awaitInclusion(ctx, "ok", result))
+
+	// Losing the round to another provider is not an error
+	txs.include("certified", &sdk.TxResponse{
+		Codespace: oracletypes.ErrNonceMismatch.Codespace(),
+		Code:      oracletypes.ErrNonceMismatch.ABCICode(),
+	})
+	require.NoError(t, s.awaitInclusion(ctx, "certified", result))
+
+	txs.include("failed", &sdk.TxResponse{Code: 11, RawLog: "out of gas"})
+	require.ErrorContains(t, s.awaitInclusion(ctx, "failed", result), "failed in block")
+
+	// A transaction included after a few polls is still confirmed
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		txs.include("slow", &sdk.TxResponse{Code: 0})
+	}()
+	require.NoError(t, s.awaitInclusion(ctx, "slow", result))
+
+	// Waiting never touches the sequence taken at broadcast
+	require.Equal(t, uint64(10), s.sequenceN)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, s.awaitInclusion(canceled, "missing", result), context.Canceled)
+}
@@ -0,0 +1,162 @@
+package submiter
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gurufinglobal/guru/v2/oralce/config"
+	"github.com/gurufinglobal/guru/v2/oralce/types"
+	oracletypes "github.com/gurufinglobal/guru/v2/x/oracle/types"
+)
+
+// resubmitEntry is a result whose broadcast failed after all retries
+type resubmitEntry struct {
+	result   types.OracleJobResult
+	attempts int
+	nextAt   time.Time
+}
+
+// ResubmitStats reports the resubmission queue depth and its lifetime outcomes
+type ResubmitStats struct {
+	Depth     int
+	Succeeded uint64
+	Dropped   uint64
+}
+
+// RunResubmitLoop retries queued results with exponential backoff until they
+// are accepted or the on-chain nonce moves past them. It returns when ctx is done.
+func (s *Submitter) RunResubmitLoop(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("resubmit loop done")
+			return
+
+		case <-ticker.C:
+			for _, entry := range s.dueResubmits(time.Now()) {
+				s.resubmit(ctx, entry)
+			}
+		}
+	}
+}
+
+// Stats returns a snapshot of the resubmission queue
+func (s *Submitter) Stats() ResubmitStats {
+	s.resubmitMu.Lock()
+	depth := len(s.resubmits)
+	s.resubmitMu.Unlock()
+
+	return ResubmitStats{
+		Depth:     depth,
+		Succeeded: s.resubmitSucceeded.Load(),
+		Dropped:   s.resubmitDropped.Load(),
+	}
+}
+
+// enqueueResubmit queues a failed result, keeping only the newest nonce per request.
+// When the queue is full the oldest entry is evicted.
+func (s *Submitter) enqueueResubmit(result types.OracleJobResult) {
+	s.resubmitMu.Lock()
+	defer s.resubmitMu.Unlock()
+
+	entry := &resubmitEntry{result: result, nextAt: time.Now().Add(time.Second)}
+
+	for i, queued := range s.resubmits {
+		if queued.result.ID != result.ID {
+			continue
+		}
+		if result.Nonce < queued.result.Nonce {
+			return
+		}
+		s.resubmits[i] = entry
+		s.logger.Info("resubmit queued", "id", result.ID, "nonce", result.Nonce, "depth", len(s.resubmits))
+		return
+	}
+
+	if len(s.resubmits) >= config.ResubmitQueueSize() {
+		evicted := s.resubmits[0]
+		s.resubmits = s.resubmits[1:]
+		s.resubmitDropped.Add(1)
+		s.logger.Warn("resubmit queue full, evicting oldest", "id", evicted.result.ID, "nonce", evicted.result.Nonce)
+	}
+
+	s.resubmits = append(s.resubmits, entry)
+	s.logger.Info("resubmit queued", "id", result.ID, "nonce", result.Nonce, "depth", len(s.resubmits))
+}
+
+// dueResubmits removes and returns the entries whose backoff has elapsed
+func (s *Submitter) dueResubmits(now time.Time) []*resubmitEntry {
+	s.resubmitMu.Lock()
+	defer s.resubmitMu.Unlock()
+
+	var due []*resubmitEntry
+	pending := s.resubmits[:0]
+	for _, entry := range s.resubmits {
+		if now.Before(entry.nextAt) {
+			pending = append(pending, entry)
+			continue
+		}
+		due = append(due, entry)
+	}
+	s.resubmits = pending
+
+	return due
+}
+
+// requeueResubmit puts an entry back with a doubled backoff capped at the configured maximum
+func (s *Submitter) requeueResubmit(entry *resubmitEntry) {
+	entry.attempts++
+	backoff := min(time.Duration(1<<min(entry.attempts, 16))*time.Second, config.ResubmitMaxBackoff())
+	entry.nextAt = time.Now().Add(backoff)
+
+	s.resubmitMu.Lock()
+	defer s.resubmitMu.Unlock()
+
+	for _, queued := range s.resubmits {
+		if queued.result.ID == entry.result.ID && queued.result.Nonce >= entry.result.Nonce {
+			return
+		}
+	}
+	s.resubmits = append(s.resubmits, entry)
+}
+
+// resubmit broadcasts a queued result if its nonce is still the one the chain expects
+func (s *Submitter) resubmit(ctx context.Context, entry *resubmitEntry) {
+	result := entry.result
+
+	res, err := s.queryClient.OracleRequestDoc(ctx, &oracletypes.QueryOracleRequestDocRequest{RequestId: result.ID})
+	if err != nil {
+		s.logger.Debug("resubmit nonce check failed", "id", result.ID, "error", err)
+		s.requeueResubmit(entry)
+		return
+	}
+
+	if result.Nonce <= res.RequestDoc.Nonce || res.RequestDoc.Status != oracletypes.RequestStatus_REQUEST_STATUS_ENABLED {
+		s.resubmitDropped.Add(1)
+		s.logger.Info("resubmit dropped, round no longer current", "id", result.ID, "nonce", result.Nonce, "chain_nonce", res.RequestDoc.Nonce)
+		return
+	}
+
+	if err := s.submit(ctx, result); err != nil {
+		if errors.Is(err, errAttemptsExhausted) {
+			s.requeueResubmit(entry)
+			s.logger.Info("resubmit failed, backing off", "id", result.ID, "nonce", result.Nonce, "attempts", entry.attempts)
+			return
+		}
+
+		s.resubmitDropped.Add(1)
+		if errors.Is(err, ErrRequestNotEnabled) {
+			s.logger.Info("resubmit dropped, request not enabled", "id", result.ID, "nonce", result.Nonce)
+			return
+		}
+		s.logger.Error("resubmit rejected", "id", result.ID, "nonce", result.Nonce, "error", err)
+		return
+	}
+
+	s.resubmitSucceeded.Add(1)
+	s.logger.Info("resubmit finished", "id", result.ID, "nonce", result.Nonce, "attempts", entry.attempts+1)
+}
@@ -0,0 +1,219 @@
+package submiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	guruconfig "github.com/gurufinglobal/guru/v2/cmd/gurud/config"
+	"github.com/gurufinglobal/guru/v2/crypto/hd"
+	"github.com/gurufinglobal/guru/v2/encoding"
+	"github.com/gurufinglobal/guru/v2/oralce/config"
+	"github.com/gurufinglobal/guru/v2/oralce/types"
+	evmtypes "github.com/gurufinglobal/guru/v2/types"
+	oracletypes "github.com/gurufinglobal/guru/v2/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func newTestSubmitter(t *testing.T) *Submitter {
+	t.Helper()
+	require.NoError(t, config.TestConfig())
+	return &Submitter{logger: log.NewNopLogger()}
+}
+
+func TestEnqueueResubmit_KeepsNewestNonce(t *testing.T) {
+	s := newTestSubmitter(t)
+
+	s.enqueueResubmit(types.OracleJobResult{ID: 1, Nonce: 5})
+	s.enqueueResubmit(types.OracleJobResult{ID: 1, Nonce: 4})
+	s.enqueueResubmit(types.OracleJobResult{ID: 1, Nonce: 6})
+	s.enqueueResubmit(types.OracleJobResult{ID: 2, Nonce: 1})
+
+	require.Equal(t, 2, s.Stats().Depth)
+	require.Equal(t, uint64(6), s.resubmits[0].result.Nonce)
+	require.Equal(t, uint64(1), s.resubmits[1].result.Nonce)
+}
+
+func TestEnqueueResubmit_EvictsOldestWhenFull(t *testing.T) {
+	s := newTestSubmitter(t)
+
+	size := config.ResubmitQueueSize()
+	for i := 0; i <= size; i++ {
+		s.enqueueResubmit(types.OracleJobResult{ID: uint64(i), Nonce: 1})
+	}
+
+	stats := s.Stats()
+	require.Equal(t, size, stats.Depth)
+	require.Equal(t, uint64(1), stats.Dropped)
+	require.Equal(t, uint64(1), s.resubmits[0].result.ID)
+}
+
+func TestDueResubmits_RespectsBackoff(t *testing.T) {
+	s := newTestSubmitter(t)
+
+	s.enqueueResubmit(types.OracleJobResult{ID: 1, Nonce: 1})
+	require.Empty(t, s.dueResubmits(time.Now()))
+
+	due := s.dueResubmits(time.Now().Add(2 * time.Second))
+	require.Len(t, due, 1)
+	require.Equal(t, 0, s.Stats().Depth)
+
+	s.requeueResubmit(due[0])
+	require.Equal(t, 1, due[0].attempts)
+	require.Empty(t, s.dueResubmits(time.Now().Add(time.Second)))
+	require.Len(t, s.dueResubmits(time.Now().Add(3*time.Second)), 1)
+}
+
+func TestIsRequestNotEnabled(t *testing.T) {
+	require.True(t, isRequestNotEnabled(oracletypes.ModuleName, oracletypes.ErrRequestNotEnabled.ABCICode()))
+	require.False(t, isRequestNotEnabled("sdk", oracletypes.ErrRequestNotEnabled.ABCICode()))
+	require.False(t, isRequestNotEnabled(oracletypes.ModuleName, oracletypes.ErrQuorumNotMet.ABCICode()))
+	require.False(t, isRequestNotEnabled("sdk", 18))
+}
+
+func TestIsNonceMismatch(t *testing.T) {
+	require.True(t, isNonceMismatch(oracletypes.ModuleName, oracletypes.ErrNonceMismatch.ABCICode()))
+	require.False(t, isNonceMismatch(oracletypes.ModuleName, oracletypes.ErrRequestNotEnabled.ABCICode()))
+	require.False(t, isNonceMismatch("sdk", oracletypes.ErrNonceMismatch.ABCICode()))
+}
+
+func TestBroadcastTxWithRetry_MissedDeadline(t *testing.T) {
+	s := newTestSubmitter(t)
+
+	// An expired result is dropped before any tx is built
+	err := s.BroadcastTxWithRetry(context.Background(), types.OracleJobResult{ID: 1, Nonce: 2, Deadline: time.Now().Add(-time.Second)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), s.MissedDeadlines())
+	require.Equal(t, 0, s.Stats().Depth)
+}
+
+// newSigningSubmitter returns a test submitter with an in-memory key and no node client
+func newSigningSubmitter(t *testing.T) *Submitter {
+	t.Helper()
+	s := newTestSubmitter(t)
+
+	encCfg := encoding.MakeConfig(guruconfig.GuruChainID)
+	oracletypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	kr := keyring.NewInMemory(encCfg.Codec, hd.EthSecp256k1Option())
+	record, _, err := kr.NewMnemonic(config.KeyName(), keyring.English, evmtypes.BIP44HDPath, keyring.DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+
+	s.clientCtx = client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithTxConfig(encCfg.TxConfig).
+		WithKeyring(kr).
+		WithFromAddress(addr).
+		WithFromName(config.KeyName())
+	s.accountN, s.sequenceN = 3, 7
+	return s
+}
+
+func TestBroadcastTxWithRetry_DryRun(t *testing.T) {
+	s := newSigningSubmitter(t)
+	s.dryRun = true
+
+	// Without a node client any broadcast would fail, so a nil error means nothing was sent
+	err := s.BroadcastTxWithRetry(context.Background(), types.OracleJobResult{ID: 1, Nonce: 2, Data: "1388.95"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), s.DryRuns())
+	require.Equal(t, uint64(7), s.sequenceN)
+	require.Equal(t, uint64(0), s.Unconfirmed())
+	require.Equal(t, 0, s.Stats().Depth)
+}
+
+func TestBuildTransaction_Memo(t *testing.T) {
+	s := newSigningSubmitter(t)
+	s.memo = "node-1/v2.0.0"
+
+	factory, txBuilder := s.buildTransaction(types.OracleJobResult{ID: 1, Nonce: 2, Data: "1388.95"})
+	require.NotNil(t, txBuilder)
+	txBytes := s.signTransaction(context.Background(), factory, txBuilder)
+	require.NotNil(t, txBytes)
+
+	decoded, err := s.clientCtx.TxConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	memoTx, ok := decoded.(sdk.TxWithMemo)
+	require.True(t, ok)
+	require.Equal(t, "node-1/v2.0.0", memoTx.GetMemo())
+
+	// The memo is part of the signed body, so the signature still verifies
+	var raw txtypes.TxRaw
+	require.NoError(t, s.clientCtx.Codec.Unmarshal(txBytes, &raw))
+	signDoc := txtypes.SignDoc{BodyBytes: raw.BodyBytes, AuthInfoBytes: raw.AuthInfoBytes, ChainId: config.ChainID(), AccountNumber: 3}
+	signBytes, err := signDoc.Marshal()
+	require.NoError(t, err)
+	sigs, err := memoTx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, sigs[0].PubKey.VerifySignature(signBytes, raw.Signatures[0]))
+}
+
+func TestBuildTransaction_Ed25519Key(t *testing.T) {
+	s := newSigningSubmitter(t)
+
+	// Replace the eth_secp256k1 key with an imported ed25519 key
+	privKey := ed25519.GenPrivKey()
+	armor := sdkcrypto.EncryptArmorPrivKey(privKey, "passphrase", string(ed25519.PubKeyName))
+	kr := keyring.NewInMemory(s.clientCtx.Codec, hd.EthSecp256k1Option())
+	require.NoError(t, kr.ImportPrivKey(config.KeyName(), armor, "passphrase"))
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	s.clientCtx = s.clientCtx.WithKeyring(kr).WithFromAddress(addr)
+
+	_, txBuilder := s.buildTransaction(types.OracleJobResult{ID: 1, Nonce: 2, Data: "1388.95"})
+	require.NotNil(t, txBuilder)
+
+	msgs := txBuilder.GetTx().GetMsgs()
+	require.Len(t, msgs, 1)
+	dataSet := msgs[0].(*oracletypes.MsgSubmitOracleData).DataSet
+	require.Equal(t, addr.String(), dataSet.Provider)
+	require.Len(t, dataSet.Signature, ed25519.SignatureSize)
+
+	signBytes, err := dataSet.Bytes(config.ChainID())
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifySignature(signBytes, dataSet.Signature))
+}
+
+// mockQueryClient answers OracleRequestDoc with a fixed request nonce
+type mockQueryClient struct {
+	oracletypes.QueryClient
+	nonce uint64
+	err   error
+}
+
+func (m mockQueryClient) OracleRequestDoc(context.Context, *oracletypes.QueryOracleRequestDocRequest, ...grpc.CallOption) (*oracletypes.QueryOracleRequestDocResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &oracletypes.QueryOracleRequestDocResponse{RequestDoc: oracletypes.OracleRequestDoc{Nonce: m.nonce}}, nil
+}
+
+func TestRoundAdvanced(t *testing.T) {
+	s := newTestSubmitter(t)
+	s.queryClient = mockQueryClient{nonce: 5}
+
+	// The result advances the current chain nonce -> submit
+	require.False(t, s.roundAdvanced(context.Background(), types.OracleJobResult{ID: 1, Nonce: 6}))
+	require.Equal(t, uint64(0), s.SkippedStale())
+
+	// Another node already completed the round, or the result is ahead of the chain -> skip
+	require.True(t, s.roundAdvanced(context.Background(), types.OracleJobResult{ID: 1, Nonce: 5}))
+	require.True(t, s.roundAdvanced(context.Background(), types.OracleJobResult{ID: 1, Nonce: 8}))
+	require.Equal(t, uint64(2), s.SkippedStale())
+
+	// A failed query does not hold the submission back
+	s.queryClient = mockQueryClient{err: errors.New("node unreachable")}
+	require.False(t, s.roundAdvanced(context.Background(), types.OracleJobResult{ID: 1, Nonce: 6}))
+	require.Equal(t, uint64(2), s.SkippedStale())
+}
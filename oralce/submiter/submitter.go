@@ -2,6 +2,10 @@ package submiter
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"cosmossdk.io/log"
@@ -10,16 +14,50 @@ import (
 	"github.com/gurufinglobal/guru/v2/oralce/types"
 	oracletypes "github.com/gurufinglobal/guru/v2/x/oracle/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
 
+// errAttemptsExhausted marks a broadcast that kept failing transiently and may succeed if resubmitted later
+var errAttemptsExhausted = errors.New("broadcast attempts exhausted")
+
+// ErrRequestNotEnabled marks a submission the chain rejected because the request was paused or disabled.
+// The request should no longer be scheduled.
+var ErrRequestNotEnabled = errors.New("request not enabled")
+
 type Submitter struct {
-	logger    log.Logger
-	clientCtx client.Context
-	accountN  uint64
-	sequenceN uint64
+	logger      log.Logger
+	clientCtx   client.Context
+	queryClient oracletypes.QueryClient
+	accountN    uint64
+	sequenceN   uint64
+
+	mu      sync.Mutex
+	syncing atomic.Bool
+
+	resubmitMu        sync.Mutex
+	resubmits         []*resubmitEntry
+	resubmitSucceeded atomic.Uint64
+	resubmitDropped   atomic.Uint64
+
+	missedDeadlines atomic.Uint64
+	skippedStale    atomic.Uint64
+
+	// dryRun logs built transactions instead of broadcasting them and leaves the sequence untouched
+	dryRun  bool
+	dryRuns atomic.Uint64
+
+	// memo is set on every submission so operators can tell which node sent it
+	memo string
+
+	// queryTx looks up an included transaction by hash for async and block confirmations
+	queryTx     func(hash string) (*sdk.TxResponse, error)
+	pendingMu   sync.Mutex
+	pending     []*pendingTx
+	unconfirmed atomic.Uint64
 }
 
 func NewSubmitter(logger log.Logger, clientCtx client.Context) *Submitter {
@@ -28,29 +66,109 @@ func NewSubmitter(logger log.Logger, clientCtx client.Context) *Submitter {
 		panic(err)
 	}
 
+	if config.SubmitDryRun() {
+		logger.Warn("dry run enabled, results are logged instead of broadcast")
+	}
+
 	return &Submitter{
-		logger:    logger,
-		clientCtx: clientCtx,
-		accountN:  acc,
-		sequenceN: seq,
+		logger:      logger,
+		clientCtx:   clientCtx,
+		queryClient: oracletypes.NewQueryClient(clientCtx),
+		accountN:    acc,
+		sequenceN:   seq,
+		dryRun:      config.SubmitDryRun(),
+		memo:        config.SubmitMemo(),
+		queryTx: func(hash string) (*sdk.TxResponse, error) {
+			return authtx.QueryTx(clientCtx, hash)
+		},
 	}
 }
 
 // BroadcastTxWithRetry submits Oracle results to blockchain with automatic retry
-// Handles various transaction errors and sequence number management
-func (s *Submitter) BroadcastTxWithRetry(ctx context.Context, jobResult types.OracleJobResult) {
+// Results that still fail after all attempts are handed to the resubmission queue
+// Returns ErrRequestNotEnabled if the request was paused or disabled on chain
+func (s *Submitter) BroadcastTxWithRetry(ctx context.Context, jobResult types.OracleJobResult) error {
+	if !jobResult.Deadline.IsZero() && time.Now().After(jobResult.Deadline) {
+		s.missedDeadlines.Add(1)
+		s.logger.Warn("missed submission deadline, dropping result", "id", jobResult.ID, "nonce", jobResult.Nonce, "deadline", jobResult.Deadline)
+		return nil
+	}
+
+	if config.SubmitCheckNonce() && s.roundAdvanced(ctx, jobResult) {
+		return nil
+	}
+
+	err := s.submit(ctx, jobResult)
+	if errors.Is(err, errAttemptsExhausted) {
+		s.enqueueResubmit(jobResult)
+		return nil
+	}
+	return err
+}
+
+// MissedDeadlines returns how many results were dropped because they arrived after their submission deadline
+func (s *Submitter) MissedDeadlines() uint64 { return s.missedDeadlines.Load() }
+
+// SkippedStale returns how many results were skipped because the chain nonce no longer matched
+func (s *Submitter) SkippedStale() uint64 { return s.skippedStale.Load() }
+
+// DryRuns returns how many transactions were logged instead of broadcast in dry run mode
+func (s *Submitter) DryRuns() uint64 { return s.dryRuns.Load() }
+
+// roundAdvanced reports whether the chain nonce of the request is no longer the one the result advances,
+// typically because another node already completed the round. A failed query is not treated as advanced
+// so the submission still goes out.
+func (s *Submitter) roundAdvanced(ctx context.Context, jobResult types.OracleJobResult) bool {
+	res, err := s.queryClient.OracleRequestDoc(ctx, &oracletypes.QueryOracleRequestDocRequest{RequestId: jobResult.ID})
+	if err != nil {
+		s.logger.Debug("pre-submit nonce check failed", "id", jobResult.ID, "error", err)
+		return false
+	}
+
+	if res.RequestDoc.Nonce+1 == jobResult.Nonce {
+		return false
+	}
+
+	s.skippedStale.Add(1)
+	s.logger.Info("skipping submission, chain nonce moved on", "id", jobResult.ID, "nonce", jobResult.Nonce, "chain_nonce", res.RequestDoc.Nonce)
+	return true
+}
+
+// submit broadcasts a result and, in block mode, waits until the transaction is included.
+// The wait happens after the sequence lock is released so other submissions are not held up.
+func (s *Submitter) submit(ctx context.Context, jobResult types.OracleJobResult) error {
+	hash, err := s.broadcastWithRetry(ctx, jobResult)
+	if err != nil || hash == "" || config.BroadcastMode() != config.BroadcastBlock {
+		return err
+	}
+
+	return s.awaitInclusion(ctx, hash, jobResult)
+}
+
+// broadcastWithRetry handles various transaction errors and sequence number management
+// It returns the hash of an accepted transaction, which is empty when the round was already certified.
+// Returns errAttemptsExhausted when only transient failures occurred, so the result is worth resubmitting
+func (s *Submitter) broadcastWithRetry(ctx context.Context, jobResult types.OracleJobResult) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	maxAttempts := max(1, config.RetryMaxAttempts())
 	for attempt := 0; attempt < maxAttempts; attempt++ {
 		factory, txBuilder := s.buildTransaction(jobResult)
 		if txBuilder == nil {
 			s.logger.Error("failed to build tx", "attempt", attempt)
-			return
+			return "", fmt.Errorf("failed to build tx")
 		}
 
 		txBytes := s.signTransaction(ctx, factory, txBuilder)
 		if txBytes == nil {
 			s.logger.Error("failed to sign tx", "attempt", attempt)
-			return
+			return "", fmt.Errorf("failed to sign tx")
+		}
+
+		if s.dryRun {
+			s.logDryRun(factory, txBuilder, txBytes, jobResult)
+			return "", nil
 		}
 
 		res, err := s.clientCtx.BroadcastTx(txBytes)
@@ -61,21 +179,29 @@ func (s *Submitter) BroadcastTxWithRetry(ctx context.Context, jobResult types.Or
 		}
 
 		if res.Code == 0 {
-			s.logger.Info("broadcast success", "tx_hash", res.TxHash)
-			s.sequenceN++
-			return
+			s.recordAccepted(config.BroadcastMode(), res.TxHash, jobResult)
+			return res.TxHash, nil
+		}
+
+		if isRequestNotEnabled(res.Codespace, res.Code) {
+			s.logger.Info("request not enabled on chain", "id", jobResult.ID, "nonce", jobResult.Nonce, "raw_log", res.RawLog)
+			return "", ErrRequestNotEnabled
+		}
+
+		if isNonceMismatch(res.Codespace, res.Code) {
+			s.logger.Info("already certified", "id", jobResult.ID, "nonce", jobResult.Nonce, "raw_log", res.RawLog)
+			return "", nil
 		}
 
 		switch res.Code {
 		case 18:
 			s.logger.Info("already certified", "attempt", attempt+1, "max_attempts", maxAttempts)
-			return
+			return "", nil
 		case 32:
 			failedSeq := s.sequenceN
-			_, s.sequenceN, err = s.clientCtx.AccountRetriever.GetAccountNumberSequence(s.clientCtx, s.clientCtx.GetFromAddress())
-			if err != nil {
+			if err := s.syncWithChain(); err != nil {
 				s.logger.Warn("failed to get account number and sequence", "error", err)
-				return
+				return "", fmt.Errorf("%w: %v", errAttemptsExhausted, err)
 			}
 
 			s.logger.Info("sequence number rolled back", "failed_seq", failedSeq, "new_seq", s.sequenceN)
@@ -83,11 +209,93 @@ func (s *Submitter) BroadcastTxWithRetry(ctx context.Context, jobResult types.Or
 			continue
 		default:
 			s.logger.Error("unexpected error code", "attempt", attempt+1, "max_attempts", maxAttempts, "code", res.Code, "raw_log", res.RawLog)
-			return
+			return "", fmt.Errorf("unexpected error code %d: %s", res.Code, res.RawLog)
 		}
 	}
 
 	s.logger.Info("failed to broadcast tx after max attempts", "max_attempts", maxAttempts)
+	return "", errAttemptsExhausted
+}
+
+// logDryRun logs the transaction that would have been broadcast for a result
+func (s *Submitter) logDryRun(factory tx.Factory, txBuilder client.TxBuilder, txBytes []byte, jobResult types.OracleJobResult) {
+	s.dryRuns.Add(1)
+
+	builtTx := txBuilder.GetTx()
+	txJSON, err := s.clientCtx.TxConfig.TxJSONEncoder()(builtTx)
+	if err != nil {
+		s.logger.Error("failed to encode dry run tx", "id", jobResult.ID, "nonce", jobResult.Nonce, "error", err)
+	}
+
+	s.logger.Info("dry run, not broadcasting tx",
+		"id", jobResult.ID,
+		"nonce", jobResult.Nonce,
+		"raw_data", jobResult.Data,
+		"gas", builtTx.GetGas(),
+		"fee", builtTx.GetFee().String(),
+		"account_number", factory.AccountNumber(),
+		"sequence", factory.Sequence(),
+		"size", len(txBytes),
+		"tx", string(txJSON))
+}
+
+// recordAccepted advances the sequence after a broadcast was accepted.
+// An async broadcast skips CheckTx, so the sequence is advanced optimistically and the transaction is
+// tracked until the confirmation loop sees it in a block or resyncs the sequence.
+// The caller must hold s.mu.
+func (s *Submitter) recordAccepted(mode, hash string, jobResult types.OracleJobResult) {
+	s.sequenceN++
+	if mode == flags.BroadcastAsync {
+		s.trackPending(hash, jobResult)
+		s.logger.Info("broadcast sent", "tx_hash", hash)
+		return
+	}
+
+	s.logger.Info("broadcast success", "tx_hash", hash)
+}
+
+// isRequestNotEnabled reports whether a CheckTx result is the oracle module's request-not-enabled rejection
+func isRequestNotEnabled(codespace string, code uint32) bool {
+	return codespace == oracletypes.ErrRequestNotEnabled.Codespace() && code == oracletypes.ErrRequestNotEnabled.ABCICode()
+}
+
+// isNonceMismatch reports whether a CheckTx result is the oracle module's nonce mismatch rejection,
+// meaning the round was already certified without this submission
+func isNonceMismatch(codespace string, code uint32) bool {
+	return codespace == oracletypes.ErrNonceMismatch.Codespace() && code == oracletypes.ErrNonceMismatch.ABCICode()
+}
+
+// SyncSequence reloads the account sequence from the chain on operator demand.
+// It is a no-op when another resync is already in progress.
+func (s *Submitter) SyncSequence() {
+	if !s.syncing.CompareAndSwap(false, true) {
+		s.logger.Info("sequence resync already in progress")
+		return
+	}
+	defer s.syncing.Store(false)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := s.sequenceN
+	if err := s.syncWithChain(); err != nil {
+		s.logger.Error("sequence resync failed", "error", err, "sequence", before)
+		return
+	}
+
+	s.logger.Info("sequence resynced", "before", before, "after", s.sequenceN)
+}
+
+// syncWithChain overwrites the cached sequence with the on-chain value.
+// The caller must hold s.mu.
+func (s *Submitter) syncWithChain() error {
+	_, seq, err := s.clientCtx.AccountRetriever.GetAccountNumberSequence(s.clientCtx, s.clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	s.sequenceN = seq
+	return nil
 }
 
 // buildTransaction creates an unsigned transaction for Oracle data submission
@@ -98,6 +306,10 @@ func (s *Submitter) buildTransaction(jobResult types.OracleJobResult) (tx.Factor
 		s.logger.Error("failed to parse gas price", "error", err)
 		return tx.Factory{}, nil
 	}
+	if floor, ok := config.GasPriceFloor(); ok && gasPrice.Amount.LT(floor) {
+		s.logger.Info("gas price floor applied", "gas_price", gasPrice.Amount, "floor", floor)
+		gasPrice.Amount = floor
+	}
 
 	factory := tx.Factory{}.
 		WithTxConfig(s.clientCtx.TxConfig).
@@ -109,6 +321,7 @@ func (s *Submitter) buildTransaction(jobResult types.OracleJobResult) (tx.Factor
 		WithGasPrices(gasPrice.String()).
 		WithAccountNumber(s.accountN).
 		WithSequence(s.sequenceN).
+		WithMemo(s.memo).
 		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
 
 	msg := &oracletypes.MsgSubmitOracleData{
@@ -122,17 +335,23 @@ func (s *Submitter) buildTransaction(jobResult types.OracleJobResult) (tx.Factor
 		},
 	}
 
-	signBytes, err := msg.DataSet.Bytes()
+	signBytes, err := msg.DataSet.Bytes(config.ChainID())
 	if err != nil {
 		s.logger.Error("failed to get sign bytes", "error", err)
 		return tx.Factory{}, nil
 	}
 
-	signature, _, err := s.clientCtx.Keyring.Sign(config.KeyName(), signBytes, factory.SignMode())
+	// The keyring signs with the scheme of the key it holds; the chain verifies against the provider's key type
+	signature, pubKey, err := s.clientCtx.Keyring.Sign(config.KeyName(), signBytes, factory.SignMode())
 	if err != nil {
 		s.logger.Error("failed to sign tx", "error", err)
 		return tx.Factory{}, nil
 	}
+	signature, err = oracletypes.SubmitSignature(pubKey, signature)
+	if err != nil {
+		s.logger.Error("key cannot sign oracle data", "key_type", pubKey.Type(), "error", err)
+		return tx.Factory{}, nil
+	}
 	msg.DataSet.Signature = signature
 
 	txBuilder, err := factory.BuildUnsignedTx(msg)
@@ -27,13 +27,13 @@ func New(ctx context.Context, logger log.Logger, subsClient *http.HTTP, queryCli
 		eventCh: make(chan any, config.ChannelSize()),
 	}
 
-	registerCh, updateCh, completeCh := s.subscribeToEvents(ctx, subsClient)
-	if registerCh == nil || updateCh == nil || completeCh == nil {
+	registerCh, updateCh, completeCh, cancelCh := s.subscribeToEvents(ctx, subsClient)
+	if registerCh == nil || updateCh == nil || completeCh == nil || cancelCh == nil {
 		s.logger.Error("subscribe streams failed")
 		return nil
 	}
 
-	go s.runEventLoop(ctx, queryClient, registerCh, updateCh, completeCh)
+	go s.runEventLoop(ctx, queryClient, registerCh, updateCh, completeCh, cancelCh)
 
 	return s
 }
@@ -43,9 +43,15 @@ func (s *Subscriber) EventCh() <-chan any {
 	return s.eventCh
 }
 
-// subscribeToEvents subscribes to register, update, and completion events.
+// Queries of the Tx events the subscriber follows
+const (
+	registerQuery = "tm.event='Tx' AND message.action='/guru.oracle.v1.MsgRegisterOracleRequestDoc'"
+	updateQuery   = "tm.event='Tx' AND message.action='/guru.oracle.v1.MsgUpdateOracleRequestDoc'"
+)
+
+// subscribeToEvents subscribes to register, update, completion and round cancellation events.
 // It automatically unsubscribes when the context is canceled.
-func (s *Subscriber) subscribeToEvents(ctx context.Context, subsClient *http.HTTP) (<-chan coretypes.ResultEvent, <-chan coretypes.ResultEvent, <-chan coretypes.ResultEvent) {
+func (s *Subscriber) subscribeToEvents(ctx context.Context, subsClient *http.HTTP) (<-chan coretypes.ResultEvent, <-chan coretypes.ResultEvent, <-chan coretypes.ResultEvent, <-chan coretypes.ResultEvent) {
 	go func() {
 		<-ctx.Done()
 		// Use a fresh background context with timeout for cleanup operations
@@ -60,55 +66,45 @@ func (s *Subscriber) subscribeToEvents(ctx context.Context, subsClient *http.HTT
 		}
 	}()
 
-	registerCh, err := subsClient.Subscribe(ctx, "", "tm.event='Tx' AND message.action='/guru.oracle.v1.MsgRegisterOracleRequestDoc'", config.ChannelSize())
-	if err != nil {
-		s.logger.Error("subscribe register failed", "error", err)
-		return nil, nil, nil
-	}
-
-	updateCh, err := subsClient.Subscribe(ctx, "", "tm.event='Tx' AND message.action='/guru.oracle.v1.MsgUpdateOracleRequestDoc'", config.ChannelSize())
-	if err != nil {
-		s.logger.Error("subscribe update failed", "error", err)
-		// Cleanup already successful subscription
-		cleanupCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
-		defer cancel()
-		err = subsClient.Unsubscribe(cleanupCtx, "", "tm.event='Tx' AND message.action='/guru.oracle.v1.MsgRegisterOracleRequestDoc'")
-		if err != nil {
-			s.logger.Error("unsubscribe register failed", "error", err)
-		} else {
-			s.logger.Info("unsubscribed register")
-		}
-		return nil, nil, nil
+	completeQuery := fmt.Sprintf("tm.event='NewBlock' AND %s.%s EXISTS", oracletypes.EventTypeCompleteOracleDataSet, oracletypes.AttributeKeyRequestId)
+	cancelQuery := fmt.Sprintf("tm.event='Tx' AND %s.%s EXISTS", oracletypes.EventTypeRoundCancelled, oracletypes.AttributeKeyRequestId)
+
+	subscriptions := []struct {
+		name  string
+		query string
+	}{
+		{"register", registerQuery},
+		{"update", updateQuery},
+		{"complete", completeQuery},
+		{"cancel", cancelQuery},
 	}
 
-	completeQuery := fmt.Sprintf("tm.event='NewBlock' AND %s.%s EXISTS", oracletypes.EventTypeCompleteOracleDataSet, oracletypes.AttributeKeyRequestId)
-	completeCh, err := subsClient.Subscribe(ctx, "", completeQuery, config.ChannelSize())
-	if err != nil {
-		s.logger.Error("subscribe complete failed", "error", err)
-		// Cleanup already successful subscriptions
-		cleanupCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
-		defer cancel()
-		err = subsClient.Unsubscribe(cleanupCtx, "", "tm.event='Tx' AND message.action='/guru.oracle.v1.MsgRegisterOracleRequestDoc'")
-		if err != nil {
-			s.logger.Error("unsubscribe register failed", "error", err)
-		} else {
-			s.logger.Info("unsubscribed register")
-		}
-		err = subsClient.Unsubscribe(cleanupCtx, "", "tm.event='Tx' AND message.action='/guru.oracle.v1.MsgUpdateOracleRequestDoc'")
+	channels := make([]<-chan coretypes.ResultEvent, 0, len(subscriptions))
+	for i, sub := range subscriptions {
+		ch, err := subsClient.Subscribe(ctx, "", sub.query, config.ChannelSize())
 		if err != nil {
-			s.logger.Error("unsubscribe update failed", "error", err)
-		} else {
-			s.logger.Info("unsubscribed update")
+			s.logger.Error("subscribe "+sub.name+" failed", "error", err)
+			// Cleanup already successful subscriptions
+			cleanupCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+			defer cancel()
+			for _, done := range subscriptions[:i] {
+				if err := subsClient.Unsubscribe(cleanupCtx, "", done.query); err != nil {
+					s.logger.Error("unsubscribe "+done.name+" failed", "error", err)
+				} else {
+					s.logger.Info("unsubscribed " + done.name)
+				}
+			}
+			return nil, nil, nil, nil
 		}
-		return nil, nil, nil
+		channels = append(channels, ch)
 	}
 
-	return registerCh, updateCh, completeCh
+	return channels[0], channels[1], channels[2], channels[3]
 }
 
 // runEventLoop boots current docs, then forwards subscription events to eventCh.
 // It closes the output channel when the loop exits.
-func (s *Subscriber) runEventLoop(ctx context.Context, queryClient oracletypes.QueryClient, registerCh <-chan coretypes.ResultEvent, updateCh <-chan coretypes.ResultEvent, completeCh <-chan coretypes.ResultEvent) {
+func (s *Subscriber) runEventLoop(ctx context.Context, queryClient oracletypes.QueryClient, registerCh <-chan coretypes.ResultEvent, updateCh <-chan coretypes.ResultEvent, completeCh <-chan coretypes.ResultEvent, cancelCh <-chan coretypes.ResultEvent) {
 	defer func() {
 		close(s.eventCh)
 		s.logger.Info("event monitor stopped")
@@ -117,15 +113,21 @@ func (s *Subscriber) runEventLoop(ctx context.Context, queryClient oracletypes.Q
 	res, err := queryClient.OracleRequestDocs(ctx, &oracletypes.QueryOracleRequestDocsRequest{Status: oracletypes.RequestStatus_REQUEST_STATUS_ENABLED})
 	if err != nil {
 		s.logger.Debug("query request docs error", "error", err)
-		s.eventCh <- err
+		s.send(ctx, err)
 		return
 	}
 
-	time.Sleep(time.Second * 5)
+	select {
+	case <-time.After(time.Second * 5):
+	case <-ctx.Done():
+		return
+	}
 
 	for _, doc := range res.OracleRequestDocs {
 		s.logger.Info("loaded request", "id", doc.RequestId, "nonce", doc.Nonce)
-		s.eventCh <- *doc
+		if !s.send(ctx, *doc) {
+			return
+		}
 	}
 
 	s.logger.Info("event monitor started")
@@ -149,7 +151,9 @@ func (s *Subscriber) runEventLoop(ctx context.Context, queryClient oracletypes.Q
 				continue
 			}
 
-			s.eventCh <- queryRes.RequestDoc
+			if !s.send(ctx, queryRes.RequestDoc) {
+				return
+			}
 			s.logger.Info("request watch", "id", queryRes.RequestDoc.RequestId, "nonce", queryRes.RequestDoc.Nonce)
 
 		case event := <-updateCh:
@@ -165,7 +169,9 @@ func (s *Subscriber) runEventLoop(ctx context.Context, queryClient oracletypes.Q
 				continue
 			}
 
-			s.eventCh <- queryRes.RequestDoc
+			if !s.send(ctx, queryRes.RequestDoc) {
+				return
+			}
 			s.logger.Info("update watch", "id", queryRes.RequestDoc.RequestId, "nonce", queryRes.RequestDoc.Nonce)
 
 		case event := <-completeCh:
@@ -175,12 +181,38 @@ func (s *Subscriber) runEventLoop(ctx context.Context, queryClient oracletypes.Q
 				s.logger.Debug("gas price updated", "gas_price", gasPrice)
 			}
 
-			s.eventCh <- event
+			if !s.send(ctx, event) {
+				return
+			}
 			s.logger.Info("complete watch", "id", event.Events[types.CompleteID][0], "nonce", event.Events[types.CompleteNonce][0])
+
+		case event := <-cancelCh:
+			if !s.send(ctx, event) {
+				return
+			}
+			s.logger.Info("cancel watch", "id", event.Events[types.CancelID], "nonce", event.Events[types.CancelNonce])
 		}
 	}
 }
 
+// send forwards an event unless ctx is done; it reports whether the event was sent.
+// The consumer stops reading on shutdown, so a plain send could block the loop and keep eventCh open forever.
+func (s *Subscriber) send(ctx context.Context, event any) bool {
+	select {
+	case s.eventCh <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Wait discards pending events until the event loop stopped and closed the channel.
+// Call it after canceling the context passed to New.
+func (s *Subscriber) Wait() {
+	for range s.eventCh {
+	}
+}
+
 // parseRequestIDFromEvent extracts a request ID by attribute key from the event map.
 func parseRequestIDFromEvent(event coretypes.ResultEvent, eventType string) (uint64, error) {
 	valsId, ok := event.Events[eventType]
@@ -54,6 +54,26 @@ func (m *mockQueryClient) ModeratorAddress(ctx context.Context, in *oracletypes.
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockQueryClient) RequestsByAccount(ctx context.Context, in *oracletypes.QueryRequestsByAccountRequest, opts ...grpc.CallOption) (*oracletypes.QueryRequestsByAccountResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockQueryClient) PendingRound(ctx context.Context, in *oracletypes.QueryPendingRoundRequest, opts ...grpc.CallOption) (*oracletypes.QueryPendingRoundResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockQueryClient) LatestResults(ctx context.Context, in *oracletypes.QueryLatestResultsRequest, opts ...grpc.CallOption) (*oracletypes.QueryLatestResultsResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockQueryClient) ModuleState(ctx context.Context, in *oracletypes.QueryModuleStateRequest, opts ...grpc.CallOption) (*oracletypes.QueryModuleStateResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockQueryClient) RoundTiming(ctx context.Context, in *oracletypes.QueryRoundTimingRequest, opts ...grpc.CallOption) (*oracletypes.QueryRoundTimingResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
 func TestParseRequestIDFromEvent(t *testing.T) {
 	// 1) missing key
 	{
@@ -104,9 +124,10 @@ func TestRunEventLoop_QueryDocsError(t *testing.T) {
 	regCh := make(chan coretypes.ResultEvent, 2)
 	updCh := make(chan coretypes.ResultEvent, 2)
 	compCh := make(chan coretypes.ResultEvent, 2)
+	cancelCh := make(chan coretypes.ResultEvent, 2)
 
 	// Act
-	go s.runEventLoop(ctx, mqc, regCh, updCh, compCh)
+	go s.runEventLoop(ctx, mqc, regCh, updCh, compCh, cancelCh)
 
 	// Assert first item is error
 	select {
@@ -124,3 +145,27 @@ func TestRunEventLoop_QueryDocsError(t *testing.T) {
 		t.Fatal("timeout waiting for channel close")
 	}
 }
+
+func TestRunEventLoop_StopsWithoutConsumer(t *testing.T) {
+	// Repeated start/stop while nobody reads the events must neither block the loop nor leave the channel open
+	for i := 0; i < 50; i++ {
+		s := &Subscriber{logger: log.NewNopLogger(), eventCh: make(chan any)}
+		ctx, cancel := context.WithCancel(context.Background())
+
+		mqc := &mockQueryClient{errDocs: fmt.Errorf("boom")}
+		go s.runEventLoop(ctx, mqc, nil, nil, nil, nil)
+		cancel()
+
+		done := make(chan struct{})
+		go func() {
+			s.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(2 * time.Second):
+			t.Fatal("subscriber did not stop")
+		}
+	}
+}
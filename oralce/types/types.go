@@ -1,6 +1,7 @@
 package types
 
 import (
+	"sync"
 	"time"
 
 	feemarkettypes "github.com/gurufinglobal/guru/v2/x/feemarket/types"
@@ -17,6 +18,19 @@ var (
 	CompleteNonce = oracletypes.EventTypeCompleteOracleDataSet + "." + oracletypes.AttributeKeyNonce
 	CompleteTime  = oracletypes.EventTypeCompleteOracleDataSet + "." + oracletypes.AttributeKeyBlockTime
 
+	CancelID    = oracletypes.EventTypeRoundCancelled + "." + oracletypes.AttributeKeyRequestId
+	CancelNonce = oracletypes.EventTypeRoundCancelled + "." + oracletypes.AttributeKeyNonce
+	CancelTime  = oracletypes.EventTypeRoundCancelled + "." + oracletypes.AttributeKeyBlockTime
+
+	RoundID       = oracletypes.EventTypeRoundCompleted + "." + oracletypes.AttributeKeyRequestId
+	RoundNonce    = oracletypes.EventTypeRoundCompleted + "." + oracletypes.AttributeKeyNonce
+	RoundReports  = oracletypes.EventTypeRoundCompleted + "." + oracletypes.AttributeKeyReports
+	RoundQuorum   = oracletypes.EventTypeRoundCompleted + "." + oracletypes.AttributeKeyQuorum
+	RoundValue    = oracletypes.EventTypeRoundCompleted + "." + oracletypes.AttributeKeyRawData
+	RoundExcluded = oracletypes.EventTypeRoundCompleted + "." + oracletypes.AttributeKeyExcluded
+	RoundMissed   = oracletypes.EventTypeRoundCompleted + "." + oracletypes.AttributeKeyMissed
+	RoundDuration = oracletypes.EventTypeRoundCompleted + "." + oracletypes.AttributeKeyRoundDuration
+
 	MinGasPrice = feemarkettypes.EventTypeChangeMinGasPrice + "." + feemarkettypes.AttributeKeyMinGasPrice
 )
 
@@ -28,10 +42,96 @@ type OracleJob struct {
 	Delay  time.Duration
 	Period time.Duration
 	Status oracletypes.RequestStatus
+
+	// OracleType decides whether extracted values are normalized to an OracleValue
+	OracleType oracletypes.OracleType
+
+	// Transform is applied to the extracted value before submission, if set
+	Transform *oracletypes.EndpointTransform
+
+	// MessageType names the protobuf message the endpoint serves; empty for JSON and the other text formats
+	MessageType string
+
+	// Accounts is the request's provider list and Primaries how many of them submit each round
+	// without waiting when leader election is enabled
+	Accounts  []string
+	Primaries int
+
+	// FullCoverage requests only finalize once every provider reported, so a failed round is retried
+	FullCoverage bool
+
+	// History keeps the most recent executions of this job for debugging
+	History *ExecutionHistory
+
+	// Failures counts consecutive failed executions; RetryAt is when a degraded job may run again
+	Failures int
+	RetryAt  time.Time
+
+	// UpdatedAt is when the job was last stored after running; stale jobs are swept after worker.job_ttl_sec
+	UpdatedAt time.Time
 }
 
 type OracleJobResult struct {
 	ID    uint64
 	Data  string
 	Nonce uint64
+
+	// Deadline is when the result is considered too late to submit; zero means no deadline
+	Deadline time.Time
+}
+
+// MaxExecutionHistory bounds the number of executions kept per job
+const MaxExecutionHistory = 16
+
+// ExecutionRecord describes a single job execution
+type ExecutionRecord struct {
+	Time     time.Time
+	Nonce    uint64
+	Value    string
+	Error    string
+	Duration time.Duration
+}
+
+// ExecutionHistory is a fixed-size ring buffer of recent executions, safe for concurrent use
+type ExecutionHistory struct {
+	mu      sync.Mutex
+	records [MaxExecutionHistory]ExecutionRecord
+	next    int
+	count   int
+}
+
+func NewExecutionHistory() *ExecutionHistory {
+	return &ExecutionHistory{}
+}
+
+// Add records an execution, overwriting the oldest one when the buffer is full
+func (h *ExecutionHistory) Add(record ExecutionRecord) {
+	if h == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.records[h.next] = record
+	h.next = (h.next + 1) % MaxExecutionHistory
+	h.count = min(h.count+1, MaxExecutionHistory)
+}
+
+// Records returns a copy of the recorded executions, oldest first
+func (h *ExecutionHistory) Records() []ExecutionRecord {
+	if h == nil {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	out := make([]ExecutionRecord, 0, h.count)
+	start := (h.next - h.count + MaxExecutionHistory) % MaxExecutionHistory
+	for i := 0; i < h.count; i++ {
+		out = append(out, h.records[(start+i)%MaxExecutionHistory])
+	}
+
+	return out
 }
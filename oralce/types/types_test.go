@@ -0,0 +1,27 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionHistory_Wraps(t *testing.T) {
+	h := NewExecutionHistory()
+	require.Empty(t, h.Records())
+
+	for i := 1; i <= MaxExecutionHistory+3; i++ {
+		h.Add(ExecutionRecord{Nonce: uint64(i)})
+	}
+
+	records := h.Records()
+	require.Len(t, records, MaxExecutionHistory)
+	require.Equal(t, uint64(4), records[0].Nonce)
+	require.Equal(t, uint64(MaxExecutionHistory+3), records[len(records)-1].Nonce)
+}
+
+func TestExecutionHistory_Nil(t *testing.T) {
+	var h *ExecutionHistory
+	h.Add(ExecutionRecord{Nonce: 1})
+	require.Nil(t, h.Records())
+}
@@ -1,16 +1,32 @@
 package worker
 
 import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/big"
+	"mime"
+	"net"
 	"net/http"
+	neturl "net/url"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"cosmossdk.io/log"
+	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/gurufinglobal/guru/v2/oralce/config"
+	oracletypes "github.com/gurufinglobal/guru/v2/x/oracle/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
 )
 
 const (
@@ -21,16 +37,128 @@ const (
 	// maxErrorBodyPreview limits how much of the response body is included in error messages
 	// to prevent log flooding and disk space exhaustion.
 	maxErrorBodyPreview = 500 // 500 bytes
+
+	// transformPrecision is the big.Float mantissa size used for transforms
+	transformPrecision = 256
+
+	// certWarningInterval limits how often an expiring certificate is reported per host
+	certWarningInterval = time.Hour
 )
 
+// secretRefPattern matches ${NAME} secret references in endpoint URLs and headers
+var secretRefPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
 type httpClient struct {
 	logger log.Logger
 	client *http.Client
+
+	// certWarned records the last expiry warning per host
+	certWarned sync.Map
+
+	// keys picks the API key for secrets configured with a key rotation
+	keys *keyRotator
+}
+
+// rotatedKey is the key a request uses for a rotated secret
+type rotatedKey struct {
+	name   string
+	index  int
+	secret string
+}
+
+// keyState tracks the quota usage of one key of a rotation
+type keyState struct {
+	used        int
+	windowStart time.Time
+	// blockedUntil is set when the provider answered HTTP 429 for the key
+	blockedUntil time.Time
+}
+
+// keyRotator hands out the keys of each secret rotation round-robin, skipping keys whose quota
+// is used up or that were rate limited until their window resets
+type keyRotator struct {
+	lookup func(name string) (config.KeyRotation, bool)
+
+	mu     sync.Mutex
+	states map[string][]keyState
+	next   map[string]int
+}
+
+func newKeyRotator(lookup func(name string) (config.KeyRotation, bool)) *keyRotator {
+	return &keyRotator{
+		lookup: lookup,
+		states: make(map[string][]keyState),
+		next:   make(map[string]int),
+	}
+}
+
+// pick returns the next available key for the secret name and counts it against the key's quota.
+// ok is false if name has no rotation. It fails if every key of the rotation is exhausted.
+func (kr *keyRotator) pick(name string, now time.Time) (key rotatedKey, ok bool, err error) {
+	rotation, ok := kr.lookup(name)
+	if !ok {
+		return rotatedKey{}, false, nil
+	}
+
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	states := kr.states[name]
+	if len(states) != len(rotation.Keys) {
+		states = make([]keyState, len(rotation.Keys))
+		kr.states[name] = states
+	}
+
+	for i := range states {
+		index := (kr.next[name] + i) % len(states)
+		state := &states[index]
+		if rotation.Window() <= now.Sub(state.windowStart) {
+			state.used = 0
+			state.windowStart = now
+		}
+		if now.Before(state.blockedUntil) || (0 < rotation.Quota && rotation.Quota <= state.used) {
+			continue
+		}
+
+		state.used++
+		kr.next[name] = index + 1
+		return rotatedKey{name: name, index: index, secret: rotation.Keys[index]}, true, nil
+	}
+
+	return rotatedKey{}, true, fmt.Errorf("all %d keys of %s are exhausted or rate limited", len(states), name)
+}
+
+// rateLimited skips key until the provider's Retry-After has passed, or for the rotation window
+func (kr *keyRotator) rateLimited(key rotatedKey, now time.Time, retryAfter time.Duration) {
+	rotation, ok := kr.lookup(key.name)
+	if !ok {
+		return
+	}
+	if retryAfter <= 0 {
+		retryAfter = rotation.Window()
+	}
+
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	if states := kr.states[key.name]; key.index < len(states) {
+		states[key.index].blockedUntil = now.Add(retryAfter)
+	}
+}
+
+// retryAfter parses a Retry-After header given in seconds; dates and missing values yield 0
+func retryAfter(header http.Header) time.Duration {
+	seconds, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
 }
 
 func newHTTPClient(logger log.Logger) *httpClient {
 	hc := new(httpClient)
 	hc.logger = logger
+	hc.keys = newKeyRotator(config.SecretRotation)
 
 	hc.client = &http.Client{
 		Timeout: time.Duration(30) * time.Second,
@@ -49,11 +177,68 @@ func newHTTPClient(logger log.Logger) *httpClient {
 		},
 	}
 
+	// Redirects must not lead outside the allowed hosts
+	hc.client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if 10 <= len(via) {
+			return fmt.Errorf("stopped after 10 redirects")
+		}
+		if err := checkNotSelf(req.URL.String(), config.SelfHosts()); err != nil {
+			return err
+		}
+		return checkHostAllowed(req.URL.String(), config.AllowedHosts())
+	}
+
+	// Certificate expiry checks are opt-in so the default transport stays untouched
+	if 0 < config.CertExpiryWarning() {
+		hc.client.Transport.(*http.Transport).TLSClientConfig = &tls.Config{
+			VerifyConnection: hc.checkCertExpiry,
+		}
+	}
+
 	return hc
 }
 
+// checkCertExpiry warns when a peer certificate expires within the configured window.
+// It never fails the handshake; regular certificate verification has already run.
+func (hc *httpClient) checkCertExpiry(cs tls.ConnectionState) error {
+	cert := expiringCert(cs.PeerCertificates, time.Now(), config.CertExpiryWarning())
+	if cert == nil {
+		return nil
+	}
+
+	now := time.Now()
+	if last, ok := hc.certWarned.Load(cs.ServerName); ok && now.Sub(last.(time.Time)) < certWarningInterval {
+		return nil
+	}
+	hc.certWarned.Store(cs.ServerName, now)
+
+	hc.logger.Warn("endpoint certificate expiring soon",
+		"host", cs.ServerName,
+		"subject", cert.Subject.String(),
+		"not_after", cert.NotAfter.UTC().Format(time.RFC3339),
+		"remaining_hours", int(cert.NotAfter.Sub(now).Hours()))
+
+	return nil
+}
+
+// expiringCert returns the certificate in the chain that expires first within window, or nil.
+func expiringCert(certs []*x509.Certificate, now time.Time, window time.Duration) *x509.Certificate {
+	var earliest *x509.Certificate
+	for _, cert := range certs {
+		if cert.NotAfter.Sub(now) > window {
+			continue
+		}
+		if earliest == nil || cert.NotAfter.Before(earliest.NotAfter) {
+			earliest = cert
+		}
+	}
+
+	return earliest
+}
+
 // fetchRawData retrieves bytes from an external endpoint with bounded retries.
-func (hc *httpClient) fetchRawData(url string) ([]byte, error) {
+// It also returns the response Content-Type, which selects the body parser.
+func (hc *httpClient) fetchRawData(url string) ([]byte, string, error) {
 	maxAttempts := max(1, config.RetryMaxAttempts())
 	var lastErr error
 
@@ -69,16 +254,30 @@ func (hc *httpClient) fetchRawData(url string) ([]byte, error) {
 			time.Sleep(actualDelay)
 		}
 
-		req, err := http.NewRequest(http.MethodGet, url, nil)
+		// Secrets are resolved on every attempt so a rate limited key is replaced by the next one
+		reqURL, headers, keys, err := hc.resolveRequest(url)
+		if err != nil {
+			return nil, "", err
+		}
+
+		req, err := http.NewRequest(http.MethodGet, reqURL, nil)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+			return nil, "", fmt.Errorf("failed to create HTTP request for %s", url)
 		}
 
 		req.Header.Set("User-Agent", "Guru-V2-Oracle/1.0")
 		req.Header.Set("Accept", "application/json")
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
 
 		res, err := hc.client.Do(req)
 		if err != nil {
+			// keep resolved secrets out of the error
+			var urlErr *neturl.Error
+			if errors.As(err, &urlErr) {
+				urlErr.URL = url
+			}
 			lastErr = err
 			hc.logger.Warn("HTTP request failed",
 				"url", url,
@@ -91,7 +290,7 @@ func (hc *httpClient) fetchRawData(url string) ([]byte, error) {
 		// Check Content-Length header if present
 		if res.ContentLength > maxResponseSize {
 			res.Body.Close()
-			return nil, fmt.Errorf("response too large: Content-Length=%d bytes (max: %d)", res.ContentLength, maxResponseSize)
+			return nil, "", fmt.Errorf("response too large: Content-Length=%d bytes (max: %d)", res.ContentLength, maxResponseSize)
 		}
 
 		// Use LimitReader to enforce size limit during read
@@ -99,12 +298,12 @@ func (hc *httpClient) fetchRawData(url string) ([]byte, error) {
 		body, err := io.ReadAll(limitedReader)
 		res.Body.Close()
 		if err != nil {
-			return nil, fmt.Errorf("failed to read response body: %w", err)
+			return nil, "", fmt.Errorf("failed to read response body: %w", err)
 		}
 
 		// Verify actual size (handles missing/incorrect Content-Length)
 		if len(body) > maxResponseSize {
-			return nil, fmt.Errorf("response exceeded size limit: %d bytes (max: %d)", len(body), maxResponseSize)
+			return nil, "", fmt.Errorf("response exceeded size limit: %d bytes (max: %d)", len(body), maxResponseSize)
 		}
 
 		switch {
@@ -114,7 +313,7 @@ func (hc *httpClient) fetchRawData(url string) ([]byte, error) {
 					"url", url,
 					"attempts", attempt+1)
 			}
-			return body, nil
+			return body, res.Header.Get("Content-Type"), nil
 
 		case 500 <= res.StatusCode:
 			lastErr = fmt.Errorf("HTTP %d: %s", res.StatusCode, string(body))
@@ -130,6 +329,12 @@ func (hc *httpClient) fetchRawData(url string) ([]byte, error) {
 			res.StatusCode == http.StatusTooManyRequests ||
 			res.StatusCode == http.StatusConflict:
 			lastErr = fmt.Errorf("HTTP %d: %s", res.StatusCode, string(body))
+			if res.StatusCode == http.StatusTooManyRequests {
+				for _, key := range keys {
+					hc.keys.rateLimited(key, time.Now(), retryAfter(res.Header))
+					hc.logger.Warn("API key rate limited, rotating", "url", url, "secret", key.name, "key", key.secret)
+				}
+			}
 			hc.logger.Warn("retryable HTTP error",
 				"url", url,
 				"status_code", res.StatusCode,
@@ -140,23 +345,288 @@ func (hc *httpClient) fetchRawData(url string) ([]byte, error) {
 		default:
 			// Truncate body in error message to prevent log flooding
 			preview := truncateForError(body, maxErrorBodyPreview)
-			return nil, fmt.Errorf("HTTP %d: %s", res.StatusCode, preview)
+			return nil, "", fmt.Errorf("HTTP %d: %s", res.StatusCode, preview)
 		}
 	}
 
 	if lastErr != nil {
-		return nil, fmt.Errorf("failed to fetch raw data after %d attempts, last error: %w", maxAttempts, lastErr)
+		return nil, "", fmt.Errorf("failed to fetch raw data after %d attempts, last error: %w", maxAttempts, lastErr)
+	}
+	return nil, "", fmt.Errorf("failed to fetch raw data after %d attempts", maxAttempts)
+}
+
+// resolveRequest resolves the secrets referenced by url and by the headers configured for its host,
+// then checks the resolved URL against the self and allowed hosts.
+// A secret with a key rotation resolves to its next available key, the same one wherever it is referenced,
+// and the picked keys are returned so a rate limited response can take them out of rotation.
+func (hc *httpClient) resolveRequest(url string) (string, map[string]string, []rotatedKey, error) {
+	picked := make(map[string]rotatedKey)
+	var pickErr error
+	lookup := func(name string) (string, bool) {
+		if key, ok := picked[name]; ok {
+			return config.Secret(key.secret)
+		}
+
+		key, rotated, err := hc.keys.pick(name, time.Now())
+		switch {
+		case err != nil:
+			pickErr = errors.Join(pickErr, err)
+			return "", true
+		case !rotated:
+			return config.Secret(name)
+		}
+		picked[name] = key
+		return config.Secret(key.secret)
+	}
+
+	// url may reference secrets as ${NAME}; only the unresolved form is logged
+	reqURL, err := resolveSecrets(url, lookup, neturl.QueryEscape)
+	if err == nil {
+		err = checkNotSelf(reqURL, config.SelfHosts())
+	}
+	if err == nil {
+		err = checkHostAllowed(reqURL, config.AllowedHosts())
+	}
+	var headers map[string]string
+	if err == nil {
+		headers, err = secretHeaders(reqURL, lookup)
+	}
+	if err = errors.Join(pickErr, err); err != nil {
+		return "", nil, nil, err
+	}
+
+	keys := make([]rotatedKey, 0, len(picked))
+	for _, key := range picked {
+		keys = append(keys, key)
+	}
+	return reqURL, headers, keys, nil
+}
+
+// resolveSecrets replaces ${NAME} references in s with the values returned by lookup.
+// Each value is passed through escape before it is inserted.
+// All missing names are reported together.
+func resolveSecrets(s string, lookup func(string) (string, bool), escape func(string) string) (string, error) {
+	var missing []string
+	resolved := secretRefPattern.ReplaceAllStringFunc(s, func(ref string) string {
+		name := ref[2 : len(ref)-1]
+		value, ok := lookup(name)
+		if !ok {
+			missing = append(missing, name)
+			return ref
+		}
+		return escape(value)
+	})
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("missing secrets %s: set them as environment variables or in secrets.file", strings.Join(missing, ", "))
+	}
+
+	return resolved, nil
+}
+
+// secretHeaders returns the configured headers for the host of rawURL with secrets resolved by lookup
+func secretHeaders(rawURL string, lookup func(string) (string, bool)) (map[string]string, error) {
+	parsed, err := neturl.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	return resolveHeaders(config.SecretHeaders(parsed.Hostname()), lookup)
+}
+
+// resolveHeaders resolves ${NAME} references in header values
+func resolveHeaders(configured map[string]string, lookup func(string) (string, bool)) (map[string]string, error) {
+	headers := make(map[string]string, len(configured))
+	for name, value := range configured {
+		resolved, err := resolveSecrets(value, lookup, func(s string) string { return s })
+		if err != nil {
+			return nil, fmt.Errorf("header %s: %w", name, err)
+		}
+		headers[name] = resolved
 	}
-	return nil, fmt.Errorf("failed to fetch raw data after %d attempts", maxAttempts)
+
+	return headers, nil
+}
+
+// checkHostAllowed rejects URLs whose host is not in the allow-list.
+// An empty allow-list permits every host.
+func checkHostAllowed(rawURL string, allowed []string) error {
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	parsed, err := neturl.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	for _, entry := range allowed {
+		if host == entry {
+			return nil
+		}
+		if suffix, ok := strings.CutPrefix(entry, "*"); ok && strings.HasSuffix(host, suffix) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("host %q is not in the allowed hosts list", host)
+}
+
+// checkNotSelf rejects URLs pointing at the chain this daemon submits to, which would feed results back into themselves.
+// Entries without a port match every port of the host.
+func checkNotSelf(rawURL string, selfHosts []string) error {
+	if len(selfHosts) == 0 {
+		return nil
+	}
+
+	parsed, err := neturl.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	port := parsed.Port()
+	if port == "" {
+		switch parsed.Scheme {
+		case "http":
+			port = "80"
+		case "https":
+			port = "443"
+		}
+	}
+
+	for _, entry := range selfHosts {
+		entryHost, entryPort, err := net.SplitHostPort(entry)
+		if err != nil {
+			entryHost, entryPort = strings.Trim(entry, "[]"), ""
+		}
+		if host == entryHost && (entryPort == "" || entryPort == port) {
+			return fmt.Errorf("endpoint host %q matches self host %q: the request would read data from the chain it reports to", parsed.Host, entry)
+		}
+	}
+
+	return nil
+}
+
+// parseBody parses a response body according to its Content-Type.
+// Form-encoded and plain key=value bodies produce the same map shape as a JSON object,
+// so parse rules address their keys the same way.
+func (hc *httpClient) parseBody(body []byte, contentType string) (map[string]any, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		return parseFormData(body)
+	case "text/plain":
+		// Some feeds serve JSON as text/plain
+		if data, err := hc.parseRawData(body); err == nil {
+			return data, nil
+		}
+		return parseKeyValueLines(body)
+	default:
+		return hc.parseRawData(body)
+	}
+}
+
+// parseMessage decodes a protobuf body as the message type named messageType.
+// The type is looked up among the descriptors linked into the daemon, gogoproto ones included.
+// Fields are keyed by their proto names and unset fields are kept, so parse rules address them like JSON fields.
+func (hc *httpClient) parseMessage(body []byte, messageType string) (map[string]any, error) {
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(messageType))
+	if err != nil {
+		return nil, fmt.Errorf("message type %s is not registered: %w", messageType, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message type", messageType)
+	}
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := proto.Unmarshal(body, msg); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", messageType, err)
+	}
+
+	jsonBody, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s: %w", messageType, err)
+	}
+
+	return hc.parseRawData(jsonBody)
+}
+
+// parseFormData parses an application/x-www-form-urlencoded body
+func parseFormData(body []byte) (map[string]any, error) {
+	values, err := neturl.ParseQuery(strings.TrimSpace(string(body)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse form data: %w", err)
+	}
+
+	return valuesToMap(values)
+}
+
+// parseKeyValueLines parses a body of key=value lines, URL-decoding the values.
+// Blank lines are skipped.
+func parseKeyValueLines(body []byte) (map[string]any, error) {
+	values := neturl.Values{}
+	for i, line := range strings.Split(string(body), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("failed to parse key-value data: line %d is not key=value", i+1)
+		}
+
+		decoded, err := neturl.QueryUnescape(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse key-value data: line %d: %w", i+1, err)
+		}
+		values.Add(strings.TrimSpace(key), decoded)
+	}
+
+	return valuesToMap(values)
+}
+
+// valuesToMap converts decoded values to the map consumed by extractDataByPath.
+// A repeated key becomes an array so its values can be addressed by index.
+func valuesToMap(values neturl.Values) (map[string]any, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no key-value pairs in response")
+	}
+
+	data := make(map[string]any, len(values))
+	for key, vals := range values {
+		if len(vals) == 1 {
+			data[key] = vals[0]
+			continue
+		}
+
+		list := make([]any, len(vals))
+		for i, v := range vals {
+			list[i] = v
+		}
+		data[key] = list
+	}
+
+	return data, nil
 }
 
 // parseRawData parses JSON bytes and returns a map for object or first element of array.
+// Numbers are kept as json.Number so values beyond float64 precision round-trip exactly.
 func (hc *httpClient) parseRawData(rawData []byte) (map[string]any, error) {
 	var result any
 
-	if err := json.Unmarshal(rawData, &result); err != nil {
+	decoder := json.NewDecoder(bytes.NewReader(rawData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to parse JSON: %w", err)
 	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("failed to parse JSON: unexpected data after top-level value")
+	}
 
 	switch v := result.(type) {
 	case map[string]any:
@@ -206,7 +676,72 @@ func (hc *httpClient) extractDataByPath(data map[string]any, path string) (strin
 		}
 	}
 
-	return fmt.Sprintf("%v", current), nil
+	switch v := current.(type) {
+	case json.Number:
+		// Keep the literal from the response instead of a float64 rendering
+		return v.String(), nil
+	default:
+		return fmt.Sprintf("%v", current), nil
+	}
+}
+
+// applyTransform inverts and/or scales an extracted numeric value.
+// The value is returned untouched when no transform is set.
+func applyTransform(value string, transform *oracletypes.EndpointTransform) (string, error) {
+	if transform == nil || (transform.Scale == "" && !transform.Invert) {
+		return value, nil
+	}
+
+	result, ok := new(big.Float).SetPrec(transformPrecision).SetString(value)
+	if !ok {
+		return "", fmt.Errorf("cannot transform non-numeric value %q", value)
+	}
+
+	if transform.Invert {
+		if result.Sign() == 0 {
+			return "", fmt.Errorf("cannot invert zero value")
+		}
+		result.Quo(new(big.Float).SetPrec(transformPrecision).SetInt64(1), result)
+	}
+
+	if transform.Scale != "" {
+		scale, ok := new(big.Float).SetPrec(transformPrecision).SetString(transform.Scale)
+		if !ok {
+			return "", fmt.Errorf("invalid transform scale %q", transform.Scale)
+		}
+		result.Mul(result, scale)
+	}
+
+	// Round to the decimals the chain keeps and drop trailing zeros
+	oracleValue, err := oracletypes.OracleValueFromBigFloat(result)
+	if err != nil {
+		return "", err
+	}
+
+	return oracleValue.String(), nil
+}
+
+// normalizeValue converts an extracted value of a numeric oracle type to its canonical OracleValue form,
+// so values such as "1.5e3" or "65000.100" are submitted as "1500" and "65000.1".
+// Values of other oracle types are returned untouched.
+func normalizeValue(value string, oracleType oracletypes.OracleType) (string, error) {
+	if !oracleType.IsNumeric() {
+		return value, nil
+	}
+
+	number, ok := new(big.Float).SetPrec(transformPrecision).SetString(value)
+	if !ok {
+		return "", fmt.Errorf("extracted value %q is not a number", value)
+	}
+	oracleValue, err := oracletypes.OracleValueFromBigFloat(number)
+	if err != nil {
+		return "", err
+	}
+	if !oracleValue.IsPositive() {
+		return "", fmt.Errorf("extracted value %q must be positive", value)
+	}
+
+	return oracleValue.String(), nil
 }
 
 // parseArrayIndex converts a path segment into a non-negative array index.
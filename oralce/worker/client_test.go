@@ -1,13 +1,17 @@
 package worker
 
 import (
+	"crypto/x509"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	neturl "net/url"
 	"testing"
 	"time"
 
 	"cosmossdk.io/log"
 	"github.com/gurufinglobal/guru/v2/oralce/config"
+	oracletypes "github.com/gurufinglobal/guru/v2/x/oracle/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
@@ -62,7 +66,7 @@ func (c *ClientTestSuite) TestFetchRawData_Success() {
 		}))
 		defer server.Close()
 
-		data, err := c.client.fetchRawData(server.URL)
+		data, _, err := c.client.fetchRawData(server.URL)
 		assert.NoError(c.T(), err)
 		assert.NotNil(c.T(), data)
 		assert.Contains(c.T(), string(data), "KRW")
@@ -77,7 +81,7 @@ func (c *ClientTestSuite) TestFetchRawData_Success() {
 		}))
 		defer server.Close()
 
-		data, err := c.client.fetchRawData(server.URL)
+		data, _, err := c.client.fetchRawData(server.URL)
 		assert.NoError(c.T(), err)
 		assert.NotNil(c.T(), data)
 		assert.Contains(c.T(), string(data), "rates")
@@ -96,7 +100,7 @@ func (c *ClientTestSuite) TestFetchRawData_HTTPErrors() {
 		}))
 		defer server.Close()
 
-		data, err := c.client.fetchRawData(server.URL)
+		data, _, err := c.client.fetchRawData(server.URL)
 		assert.Error(c.T(), err)
 		assert.Nil(c.T(), data)
 		assert.Contains(c.T(), err.Error(), "HTTP 404")
@@ -110,7 +114,7 @@ func (c *ClientTestSuite) TestFetchRawData_HTTPErrors() {
 		}))
 		defer server.Close()
 
-		data, err := c.client.fetchRawData(server.URL)
+		data, _, err := c.client.fetchRawData(server.URL)
 		assert.Error(c.T(), err)
 		assert.Nil(c.T(), data)
 		assert.Contains(c.T(), err.Error(), "HTTP 400")
@@ -124,7 +128,7 @@ func (c *ClientTestSuite) TestFetchRawData_HTTPErrors() {
 		}))
 		defer server.Close()
 
-		data, err := c.client.fetchRawData(server.URL)
+		data, _, err := c.client.fetchRawData(server.URL)
 		assert.Error(c.T(), err)
 		assert.Nil(c.T(), data)
 		assert.Contains(c.T(), err.Error(), "failed to fetch raw data")
@@ -138,7 +142,7 @@ func (c *ClientTestSuite) TestFetchRawData_HTTPErrors() {
 		}))
 		defer server.Close()
 
-		data, err := c.client.fetchRawData(server.URL)
+		data, _, err := c.client.fetchRawData(server.URL)
 		assert.Error(c.T(), err)
 		assert.Nil(c.T(), data)
 		assert.Contains(c.T(), err.Error(), "failed to fetch raw data")
@@ -150,14 +154,14 @@ func (c *ClientTestSuite) TestFetchRawData_InvalidURL() {
 
 	// 1) Invalid URL -> should return error
 	{
-		data, err := c.client.fetchRawData("invalid-url")
+		data, _, err := c.client.fetchRawData("invalid-url")
 		assert.Error(c.T(), err)
 		assert.Nil(c.T(), data)
 	}
 
 	// 2) Empty URL -> should return error
 	{
-		data, err := c.client.fetchRawData("")
+		data, _, err := c.client.fetchRawData("")
 		assert.Error(c.T(), err)
 		assert.Nil(c.T(), data)
 	}
@@ -175,7 +179,7 @@ func (c *ClientTestSuite) TestParseRawData_ValidJSON() {
 		assert.Equal(c.T(), "USD", result["base"])
 		rates, ok := result["rates"].(map[string]any)
 		assert.True(c.T(), ok)
-		assert.Equal(c.T(), 1388.95, rates["KRW"])
+		assert.Equal(c.T(), json.Number("1388.95"), rates["KRW"])
 	}
 
 	// 2) Valid JSON array with object -> should return first element
@@ -184,7 +188,7 @@ func (c *ClientTestSuite) TestParseRawData_ValidJSON() {
 		result, err := c.client.parseRawData(jsonData)
 		assert.NoError(c.T(), err)
 		assert.NotNil(c.T(), result)
-		assert.Equal(c.T(), float64(1), result["id"])
+		assert.Equal(c.T(), json.Number("1"), result["id"])
 		assert.Equal(c.T(), "test", result["name"])
 	}
 
@@ -199,7 +203,23 @@ func (c *ClientTestSuite) TestParseRawData_ValidJSON() {
 		currency, ok := data["currency"].(map[string]any)
 		assert.True(c.T(), ok)
 		assert.Equal(c.T(), "USD", currency["from"])
-		assert.Equal(c.T(), 1388.95, currency["rate"])
+		assert.Equal(c.T(), json.Number("1388.95"), currency["rate"])
+	}
+
+	// 4) Numbers beyond float64 precision -> should be kept exactly
+	{
+		jsonData := []byte(`{"id":12345678901234567890,"amount":"1","wei":1234567.123456789012345678}`)
+		result, err := c.client.parseRawData(jsonData)
+		assert.NoError(c.T(), err)
+		assert.Equal(c.T(), json.Number("12345678901234567890"), result["id"])
+
+		id, err := c.client.extractDataByPath(result, "id")
+		assert.NoError(c.T(), err)
+		assert.Equal(c.T(), "12345678901234567890", id)
+
+		wei, err := c.client.extractDataByPath(result, "wei")
+		assert.NoError(c.T(), err)
+		assert.Equal(c.T(), "1234567.123456789012345678", wei)
 	}
 }
 
@@ -258,6 +278,15 @@ func (c *ClientTestSuite) TestParseRawData_InvalidJSON() {
 		assert.Error(c.T(), err)
 		assert.Nil(c.T(), result)
 	}
+
+	// 7) Trailing data after the top-level value -> should return error
+	{
+		jsonData := []byte(`{"rates":{"KRW":1388.95}} {"extra":1}`)
+		result, err := c.client.parseRawData(jsonData)
+		assert.Error(c.T(), err)
+		assert.Nil(c.T(), result)
+		assert.Contains(c.T(), err.Error(), "failed to parse JSON")
+	}
 }
 
 func (c *ClientTestSuite) TestExtractDataByPath_ValidPaths() {
@@ -548,7 +577,7 @@ func (c *ClientTestSuite) TestIntegration_FetchParseExtract() {
 	// Full integration test: fetch -> parse -> extract
 	{
 		// 1) Fetch data
-		rawData, err := c.client.fetchRawData(server.URL)
+		rawData, _, err := c.client.fetchRawData(server.URL)
 		assert.NoError(c.T(), err)
 		assert.NotNil(c.T(), rawData)
 
@@ -573,3 +602,430 @@ func (c *ClientTestSuite) TestIntegration_FetchParseExtract() {
 		assert.Equal(c.T(), "USD", base)
 	}
 }
+
+func (c *ClientTestSuite) TestExpiringCert() {
+	c.T().Log("testing expiring cert")
+
+	now := time.Now()
+	window := 7 * 24 * time.Hour
+	leaf := &x509.Certificate{NotAfter: now.Add(90 * 24 * time.Hour)}
+	intermediate := &x509.Certificate{NotAfter: now.Add(3 * 24 * time.Hour)}
+	expired := &x509.Certificate{NotAfter: now.Add(-time.Hour)}
+
+	// No certificate inside the window -> nil
+	{
+		assert.Nil(c.T(), expiringCert([]*x509.Certificate{leaf}, now, window))
+		assert.Nil(c.T(), expiringCert(nil, now, window))
+	}
+
+	// Certificate inside the window -> returned
+	{
+		assert.Equal(c.T(), intermediate, expiringCert([]*x509.Certificate{leaf, intermediate}, now, window))
+	}
+
+	// Several inside the window -> earliest wins
+	{
+		assert.Equal(c.T(), expired, expiringCert([]*x509.Certificate{intermediate, expired}, now, window))
+	}
+}
+
+func (c *ClientTestSuite) TestApplyTransform() {
+	c.T().Log("testing apply transform")
+
+	// No transform -> value untouched
+	{
+		result, err := applyTransform("1388.950", nil)
+		assert.NoError(c.T(), err)
+		assert.Equal(c.T(), "1388.950", result)
+
+		result, err = applyTransform("1388.950", &oracletypes.EndpointTransform{})
+		assert.NoError(c.T(), err)
+		assert.Equal(c.T(), "1388.950", result)
+	}
+
+	// Scale
+	{
+		result, err := applyTransform("1388.95", &oracletypes.EndpointTransform{Scale: "1000"})
+		assert.NoError(c.T(), err)
+		assert.Equal(c.T(), "1388950", result)
+
+		result, err = applyTransform("1388.95", &oracletypes.EndpointTransform{Scale: "0.01"})
+		assert.NoError(c.T(), err)
+		assert.Equal(c.T(), "13.8895", result)
+	}
+
+	// Invert
+	{
+		result, err := applyTransform("1250", &oracletypes.EndpointTransform{Invert: true})
+		assert.NoError(c.T(), err)
+		assert.Equal(c.T(), "0.0008", result)
+
+		result, err = applyTransform("3", &oracletypes.EndpointTransform{Invert: true})
+		assert.NoError(c.T(), err)
+		assert.Equal(c.T(), "0.333333333333333333", result)
+	}
+
+	// Invert then scale
+	{
+		result, err := applyTransform("1250", &oracletypes.EndpointTransform{Invert: true, Scale: "1000"})
+		assert.NoError(c.T(), err)
+		assert.Equal(c.T(), "0.8", result)
+	}
+
+	// Errors
+	{
+		_, err := applyTransform("abc", &oracletypes.EndpointTransform{Scale: "10"})
+		assert.Error(c.T(), err)
+
+		_, err = applyTransform("0", &oracletypes.EndpointTransform{Invert: true})
+		assert.Error(c.T(), err)
+	}
+}
+
+func (c *ClientTestSuite) TestNormalizeValue() {
+	c.T().Log("testing normalize value")
+
+	// Numeric types -> canonical OracleValue form
+	{
+		for value, expected := range map[string]string{
+			"65000.100":             "65000.1",
+			"1.5e3":                 "1500",
+			"42":                    "42",
+			"0.0000000000000000015": "0.000000000000000002",
+		} {
+			result, err := normalizeValue(value, oracletypes.OracleType_ORACLE_TYPE_CRYPTO)
+			assert.NoError(c.T(), err, value)
+			assert.Equal(c.T(), expected, result, value)
+		}
+	}
+
+	// Non-numeric types -> value untouched
+	{
+		result, err := normalizeValue("abc", oracletypes.OracleType_ORACLE_TYPE_UNSPECIFIED)
+		assert.NoError(c.T(), err)
+		assert.Equal(c.T(), "abc", result)
+	}
+
+	// Errors
+	{
+		for _, value := range []string{"abc", "Inf", "0", "-1", "0.0000000000000000001"} {
+			_, err := normalizeValue(value, oracletypes.OracleType_ORACLE_TYPE_CRYPTO)
+			assert.Error(c.T(), err, value)
+		}
+	}
+}
+
+func (c *ClientTestSuite) TestCheckHostAllowed() {
+	c.T().Log("testing check host allowed")
+
+	allowed := []string{"api.coinbase.com", "*.binance.com"}
+
+	// Empty allow-list -> every host allowed
+	{
+		assert.NoError(c.T(), checkHostAllowed("http://10.0.0.1/internal", nil))
+	}
+
+	// Exact and wildcard matches -> allowed
+	{
+		assert.NoError(c.T(), checkHostAllowed("https://api.coinbase.com/v2/prices/BTC-USD/spot", allowed))
+		assert.NoError(c.T(), checkHostAllowed("https://API.Coinbase.com:443/v2", allowed))
+		assert.NoError(c.T(), checkHostAllowed("https://api.binance.com/api/v3/ticker/price", allowed))
+	}
+
+	// Other hosts -> rejected
+	{
+		err := checkHostAllowed("http://169.254.169.254/latest/meta-data", allowed)
+		assert.Error(c.T(), err)
+		assert.Contains(c.T(), err.Error(), "not in the allowed hosts list")
+
+		assert.Error(c.T(), checkHostAllowed("https://binance.com/api", allowed))
+		assert.Error(c.T(), checkHostAllowed("https://evilbinance.com/api", allowed))
+		assert.Error(c.T(), checkHostAllowed("https://api.coinbase.com.evil.io/", allowed))
+	}
+}
+
+func (c *ClientTestSuite) TestCheckNotSelf() {
+	c.T().Log("testing check not self")
+
+	self := []string{"localhost:26657", "node.internal", "[::1]:1317"}
+
+	// Empty list -> every host allowed
+	{
+		assert.NoError(c.T(), checkNotSelf("http://localhost:26657/status", nil))
+	}
+
+	// Chain endpoint, any port of a bare host, and bracketed IPv6 -> rejected
+	{
+		err := checkNotSelf("http://LOCALHOST:26657/abci_query", self)
+		assert.Error(c.T(), err)
+		assert.Contains(c.T(), err.Error(), "matches self host")
+
+		assert.Error(c.T(), checkNotSelf("https://node.internal/cosmos/base", self))
+		assert.Error(c.T(), checkNotSelf("http://node.internal:1317/guru/oracle/v1/data", self))
+		assert.Error(c.T(), checkNotSelf("http://[::1]:1317/guru/oracle/v1/data", self))
+	}
+
+	// Other ports of a host listed with a port, and other hosts -> allowed
+	{
+		assert.NoError(c.T(), checkNotSelf("http://localhost:8080/price", self))
+		assert.NoError(c.T(), checkNotSelf("https://api.coinbase.com/v2/prices/BTC-USD/spot", self))
+		assert.NoError(c.T(), checkNotSelf("https://node.internal.example.com/", self))
+	}
+}
+
+func (c *ClientTestSuite) TestResolveSecrets() {
+	c.T().Log("testing resolve secrets")
+
+	lookup := func(name string) (string, bool) {
+		value, ok := map[string]string{"API_KEY": "a b&c", "TOKEN": "xyz"}[name]
+		return value, ok
+	}
+
+	// No references -> unchanged
+	{
+		resolved, err := resolveSecrets("https://api.example.com/v1/price", lookup, neturl.QueryEscape)
+		assert.NoError(c.T(), err)
+		assert.Equal(c.T(), "https://api.example.com/v1/price", resolved)
+	}
+
+	// References are resolved and escaped
+	{
+		resolved, err := resolveSecrets("https://api.example.com/v1/price?key=${API_KEY}&t=${TOKEN}", lookup, neturl.QueryEscape)
+		assert.NoError(c.T(), err)
+		assert.Equal(c.T(), "https://api.example.com/v1/price?key=a+b%26c&t=xyz", resolved)
+	}
+
+	// Missing secrets -> all reported, value not leaked
+	{
+		_, err := resolveSecrets("https://api.example.com/?a=${MISSING_A}&b=${TOKEN}&c=${MISSING_B}", lookup, neturl.QueryEscape)
+		assert.Error(c.T(), err)
+		assert.Contains(c.T(), err.Error(), "MISSING_A, MISSING_B")
+		assert.NotContains(c.T(), err.Error(), "xyz")
+	}
+}
+
+func (c *ClientTestSuite) TestResolveHeaders() {
+	c.T().Log("testing resolve headers")
+
+	lookup := func(name string) (string, bool) {
+		value, ok := map[string]string{"API_KEY": "a b&c"}[name]
+		return value, ok
+	}
+
+	// Header values are resolved without escaping
+	{
+		headers, err := resolveHeaders(map[string]string{"X-Api-Key": "${API_KEY}", "Accept-Language": "en"}, lookup)
+		assert.NoError(c.T(), err)
+		assert.Equal(c.T(), map[string]string{"X-Api-Key": "a b&c", "Accept-Language": "en"}, headers)
+	}
+
+	// Missing secret -> header named in error
+	{
+		_, err := resolveHeaders(map[string]string{"Authorization": "Bearer ${MISSING}"}, lookup)
+		assert.Error(c.T(), err)
+		assert.Contains(c.T(), err.Error(), "Authorization")
+		assert.Contains(c.T(), err.Error(), "MISSING")
+	}
+}
+
+func (c *ClientTestSuite) TestFetchRawData_Secrets() {
+	c.T().Log("testing fetch raw data with secrets")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("key") != "query-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"price": "1"}`))
+	}))
+	defer server.Close()
+
+	c.T().Setenv("ORACLE_TEST_QUERY_KEY", "query-secret")
+
+	data, _, err := c.client.fetchRawData(server.URL + "?key=${ORACLE_TEST_QUERY_KEY}")
+	assert.NoError(c.T(), err)
+	assert.Equal(c.T(), `{"price": "1"}`, string(data))
+
+	_, _, err = c.client.fetchRawData(server.URL + "?key=${ORACLE_TEST_UNSET_KEY}")
+	assert.Error(c.T(), err)
+	assert.Contains(c.T(), err.Error(), "ORACLE_TEST_UNSET_KEY")
+}
+
+func (c *ClientTestSuite) TestFetchRawData_KeyRotation() {
+	c.T().Log("testing fetch raw data with a key rotation")
+
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.URL.Query().Get("key")
+		seen = append(seen, key)
+		if key == "key-a" {
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"price": "1"}`))
+	}))
+	defer server.Close()
+
+	c.T().Setenv("ORACLE_TEST_KEY_A", "key-a")
+	c.T().Setenv("ORACLE_TEST_KEY_B", "key-b")
+
+	client := newHTTPClient(log.NewTestLogger(c.T()))
+	client.keys = newKeyRotator(func(name string) (config.KeyRotation, bool) {
+		return config.KeyRotation{Keys: []string{"ORACLE_TEST_KEY_A", "ORACLE_TEST_KEY_B"}, WindowSec: 60}, name == "ORACLE_TEST_KEY"
+	})
+
+	// A 429 on the first key rotates to the next one
+	data, _, err := client.fetchRawData(server.URL + "?key=${ORACLE_TEST_KEY}")
+	c.Require().NoError(err)
+	assert.Equal(c.T(), `{"price": "1"}`, string(data))
+	assert.Equal(c.T(), []string{"key-a", "key-b"}, seen)
+
+	// The rate limited key is skipped until its Retry-After has passed
+	_, _, err = client.fetchRawData(server.URL + "?key=${ORACLE_TEST_KEY}")
+	c.Require().NoError(err)
+	assert.Equal(c.T(), []string{"key-a", "key-b", "key-b"}, seen)
+}
+
+func (c *ClientTestSuite) TestKeyRotator() {
+	c.T().Log("testing key rotator quotas")
+
+	rotator := newKeyRotator(func(name string) (config.KeyRotation, bool) {
+		return config.KeyRotation{Keys: []string{"KEY_A", "KEY_B"}, Quota: 2, WindowSec: 60}, name == "API_KEY"
+	})
+	now := time.Now()
+
+	// Secrets without a rotation are not handled
+	_, ok, err := rotator.pick("OTHER", now)
+	c.Require().NoError(err)
+	c.Require().False(ok)
+
+	// Keys are handed out round-robin until each quota is used up
+	var picked []string
+	for i := 0; i < 4; i++ {
+		key, ok, err := rotator.pick("API_KEY", now)
+		c.Require().NoError(err)
+		c.Require().True(ok)
+		picked = append(picked, key.secret)
+	}
+	assert.Equal(c.T(), []string{"KEY_A", "KEY_B", "KEY_A", "KEY_B"}, picked)
+
+	_, _, err = rotator.pick("API_KEY", now)
+	assert.ErrorContains(c.T(), err, "all 2 keys of API_KEY are exhausted")
+
+	// A new window resets the quotas, but a rate limited key stays skipped for the window
+	later := now.Add(time.Minute)
+	rotator.rateLimited(rotatedKey{name: "API_KEY", index: 0, secret: "KEY_A"}, later, 0)
+	for i := 0; i < 2; i++ {
+		key, _, err := rotator.pick("API_KEY", later)
+		c.Require().NoError(err)
+		assert.Equal(c.T(), "KEY_B", key.secret)
+	}
+	_, _, err = rotator.pick("API_KEY", later)
+	assert.Error(c.T(), err)
+}
+
+func (c *ClientTestSuite) TestParseBody_FormEncoded() {
+	c.T().Log("testing parse body - form encoded")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+		w.Write([]byte("pair=USD%2FKRW&rate=1388.95&source=a&source=b\n"))
+	}))
+	defer server.Close()
+
+	body, contentType, err := c.client.fetchRawData(server.URL)
+	c.Require().NoError(err)
+
+	data, err := c.client.parseBody(body, contentType)
+	c.Require().NoError(err)
+
+	rate, err := c.client.extractDataByPath(data, "rate")
+	c.Require().NoError(err)
+	assert.Equal(c.T(), "1388.95", rate)
+
+	pair, err := c.client.extractDataByPath(data, "pair")
+	c.Require().NoError(err)
+	assert.Equal(c.T(), "USD/KRW", pair)
+
+	// Repeated keys are addressable by index
+	source, err := c.client.extractDataByPath(data, "source.1")
+	c.Require().NoError(err)
+	assert.Equal(c.T(), "b", source)
+}
+
+func (c *ClientTestSuite) TestParseMessage() {
+	c.T().Log("testing parse message - registered protobuf type")
+
+	body, err := (&oracletypes.DataSet{
+		RequestId:       7,
+		Nonce:           3,
+		RawData:         "1388.95",
+		AggregationRule: oracletypes.AggregationRule_AGGREGATION_RULE_MEDIAN,
+	}).Marshal()
+	c.Require().NoError(err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	fetched, _, err := c.client.fetchRawData(server.URL)
+	c.Require().NoError(err)
+
+	data, err := c.client.parseMessage(fetched, "guru.oracle.v1.DataSet")
+	c.Require().NoError(err)
+
+	// Fields are keyed by their proto names
+	rawData, err := c.client.extractDataByPath(data, "raw_data")
+	c.Require().NoError(err)
+	assert.Equal(c.T(), "1388.95", rawData)
+
+	nonce, err := c.client.extractDataByPath(data, "nonce")
+	c.Require().NoError(err)
+	assert.Equal(c.T(), "3", nonce)
+
+	// Unset fields are still addressable
+	blockTime, err := c.client.extractDataByPath(data, "block_time")
+	c.Require().NoError(err)
+	assert.Equal(c.T(), "0", blockTime)
+
+	_, err = c.client.parseMessage(fetched, "example.feed.v1.Quote")
+	assert.ErrorContains(c.T(), err, "not registered")
+
+	_, err = c.client.parseMessage([]byte{0xff}, "guru.oracle.v1.DataSet")
+	assert.ErrorContains(c.T(), err, "failed to decode")
+}
+
+func (c *ClientTestSuite) TestParseBody_PlainText() {
+	c.T().Log("testing parse body - plain text")
+
+	// key=value lines with URL-encoded values
+	{
+		data, err := c.client.parseBody([]byte("symbol = BTC%20USD\n\nprice=64000.5\n"), "text/plain")
+		c.Require().NoError(err)
+		assert.Equal(c.T(), map[string]any{"symbol": "BTC USD", "price": "64000.5"}, data)
+	}
+
+	// JSON served as text/plain is still parsed as JSON
+	{
+		data, err := c.client.parseBody([]byte(`{"price": 1.5}`), "text/plain")
+		c.Require().NoError(err)
+		price, err := c.client.extractDataByPath(data, "price")
+		c.Require().NoError(err)
+		assert.Equal(c.T(), "1.5", price)
+	}
+
+	// A line without a separator is rejected
+	{
+		_, err := c.client.parseBody([]byte("price=1\ngarbage"), "text/plain")
+		assert.ErrorContains(c.T(), err, "line 2 is not key=value")
+	}
+
+	// Other content types keep the JSON parser
+	{
+		_, err := c.client.parseBody([]byte("price=1"), "application/json")
+		assert.Error(c.T(), err)
+	}
+}
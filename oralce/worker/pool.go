@@ -1,16 +1,23 @@
 package worker
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
 	"runtime"
 	"slices"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"cosmossdk.io/log"
 	"github.com/gurufinglobal/guru/v2/oralce/config"
 	"github.com/gurufinglobal/guru/v2/oralce/types"
 	oracletypes "github.com/gurufinglobal/guru/v2/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/creachadair/taskgroup"
 	cmap "github.com/orcaman/concurrent-map/v2"
 )
@@ -22,35 +29,154 @@ type WorkerPool struct {
 	workerFunc  taskgroup.StartFunc
 	workerGroup *taskgroup.Group
 	client      *httpClient
+	queryClient oracletypes.QueryClient
+
+	// done is closed once every task returned and resultCh is closed
+	done chan struct{}
+
+	// stopMu guards stopped so no task is started once shutdown began waiting for the running ones
+	stopMu  sync.RWMutex
+	stopped bool
+
+	// nonceMu serializes every read-modify-write of a stored job nonce so it never decreases.
+	// It also guards which job is stored for a request and the failure budget fields of a job.
+	nonceMu sync.Mutex
+
+	// coverageRetry is how long a failed round of a full coverage request waits before it is retried
+	coverageRetry time.Duration
+
+	// wrappedAssignments counts request documents whose endpoint assignment wrapped around
+	wrappedAssignments atomic.Uint64
 }
 
-func New(ctx context.Context, logger log.Logger) *WorkerPool {
+// sweepInterval is how often idle jobs are checked against the chain and removed from the job store
+const sweepInterval = time.Minute
+
+func New(ctx context.Context, logger log.Logger, queryClient oracletypes.QueryClient) *WorkerPool {
 	wp := new(WorkerPool)
 	wp.logger = logger
+	wp.queryClient = queryClient
 
 	wp.jobStore = cmap.New[*types.OracleJob]()
 	wp.resultCh = make(chan *types.OracleJobResult, config.ChannelSize())
+	wp.done = make(chan struct{})
+	wp.coverageRetry = config.CoverageRetry()
 
 	wp.workerGroup, wp.workerFunc = taskgroup.New(nil).Limit(2 * runtime.NumCPU())
 	go func() {
 		<-ctx.Done()
 		wp.logger.Info("worker pool shutting down, waiting for active tasks to complete")
 
+		wp.stopMu.Lock()
+		wp.stopped = true
+		wp.stopMu.Unlock()
+
 		if err := wp.workerGroup.Wait(); err != nil {
 			wp.logger.Error("error during worker pool shutdown", "error", err)
 		} else {
 			wp.logger.Info("worker pool shutdown completed successfully")
 		}
 
+		// Tasks are the only senders, so the channel is closed only after all of them returned
 		close(wp.resultCh)
 		wp.logger.Debug("result channel closed")
+		close(wp.done)
 	}()
 
 	wp.client = newHTTPClient(wp.logger)
 
+	go wp.runSweep(ctx)
+
 	return wp
 }
 
+// runSweep periodically removes jobs the chain no longer has live until ctx is canceled
+func (wp *WorkerPool) runSweep(ctx context.Context) {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if swept := wp.SweepJobs(ctx, now); 0 < swept {
+				wp.logger.Info("swept stale jobs", "swept", swept, "remaining", wp.JobCount())
+			}
+		}
+	}
+}
+
+// SweepJobs removes idle jobs whose request the chain reports as no longer enabled or no longer assigned to
+// this instance, and returns how many it removed. A job is idle once it neither ran nor was updated within
+// worker.job_ttl_sec, and at least two periods, so active jobs cost no query. A job whose request is still live
+// is kept, e.g. while its round is slow to reach quorum, and is not checked again for another TTL. A job whose
+// request cannot be queried is kept as well. A later enabled request document schedules a swept job again.
+func (wp *WorkerPool) SweepJobs(ctx context.Context, now time.Time) int {
+	swept := 0
+	for _, job := range wp.idleJobs(now) {
+		res, err := wp.queryClient.OracleRequestDoc(ctx, &oracletypes.QueryOracleRequestDocRequest{RequestId: job.ID})
+		if err != nil {
+			wp.logger.Debug("query request doc for sweep failed, keeping job", "request_id", job.ID, "error", err)
+			continue
+		}
+
+		doc := res.RequestDoc
+		live := doc.Status == oracletypes.RequestStatus_REQUEST_STATUS_ENABLED && slices.Contains(doc.AccountList, config.Address().String())
+		if wp.sweepJob(job, live, now) {
+			swept++
+		}
+	}
+	return swept
+}
+
+// idleJobs returns the jobs that neither ran nor were updated within worker.job_ttl_sec and two periods
+func (wp *WorkerPool) idleJobs(now time.Time) []*types.OracleJob {
+	wp.nonceMu.Lock()
+	defer wp.nonceMu.Unlock()
+
+	var idle []*types.OracleJob
+	for _, job := range wp.jobStore.Items() {
+		if isIdle(job, now) {
+			idle = append(idle, job)
+		}
+	}
+	return idle
+}
+
+// sweepJob removes job unless its request is live, in which case the job counts as updated now.
+// A job stored again since it was found idle is left alone.
+func (wp *WorkerPool) sweepJob(job *types.OracleJob, live bool, now time.Time) bool {
+	wp.nonceMu.Lock()
+	defer wp.nonceMu.Unlock()
+
+	reqID := strconv.FormatUint(job.ID, 10)
+	if stored, ok := wp.jobStore.Get(reqID); !ok || stored != job || !isIdle(job, now) {
+		return false
+	}
+
+	if live {
+		wp.logger.Debug("idle job still live on chain, keeping", "request_id", reqID, "updated_at", job.UpdatedAt)
+		job.UpdatedAt = now
+		return false
+	}
+
+	wp.logger.Debug("sweeping job", "request_id", reqID, "updated_at", job.UpdatedAt)
+	wp.jobStore.Remove(reqID)
+	return true
+}
+
+// isIdle reports whether job neither ran nor was updated within worker.job_ttl_sec and two of its periods
+func isIdle(job *types.OracleJob, now time.Time) bool {
+	ttl := config.JobTTL()
+	return 0 < ttl && max(ttl, 2*job.Period) < now.Sub(job.UpdatedAt)
+}
+
+// JobCount returns the number of jobs in the job store
+func (wp *WorkerPool) JobCount() int {
+	return wp.jobStore.Count()
+}
+
 // ProcessRequestDoc maps an Oracle request document to a scheduled job.
 // It selects the endpoint for this instance and computes initial delay.
 func (wp *WorkerPool) ProcessRequestDoc(ctx context.Context, requestDoc oracletypes.OracleRequestDoc, timestamp uint64) {
@@ -62,19 +188,34 @@ func (wp *WorkerPool) ProcessRequestDoc(ctx context.Context, requestDoc oraclety
 		return
 	}
 
+	// The chain rejects such documents, but one stored before that check would otherwise sit idle without any error
+	if !slices.ContainsFunc(requestDoc.AccountList, isValidAccount) {
+		wp.logger.Warn("request document has no valid provider account; no oracle will execute it",
+			"request_id", requestDoc.RequestId,
+			"accounts", len(requestDoc.AccountList))
+		wp.jobStore.Remove(requestIDStr)
+		return
+	}
+
 	index := slices.Index(requestDoc.AccountList, config.Address().String())
 	if index == -1 {
 		wp.logger.Info("request document not assigned to this oracle instance")
+		wp.jobStore.Remove(requestIDStr)
 		return
 	} else {
 		index = (index + 1) % len(requestDoc.AccountList)
 	}
 
-	var currentNonce uint64
-	if job, ok := wp.jobStore.Get(requestIDStr); ok {
-		currentNonce = job.Nonce
-	} else {
-		currentNonce = requestDoc.Nonce
+	// With fewer endpoints than accounts the assignment wraps and providers share endpoints
+	endpointIndex := index % len(requestDoc.Endpoints)
+	if endpointIndex != index {
+		wp.wrappedAssignments.Add(1)
+		wp.logger.Info("endpoint assignment wrapped around",
+			"request_id", requestDoc.RequestId,
+			"assigned_index", index,
+			"endpoint_index", endpointIndex,
+			"accounts", len(requestDoc.AccountList),
+			"endpoints", len(requestDoc.Endpoints))
 	}
 
 	periodSec := uint64(requestDoc.Period)
@@ -84,21 +225,31 @@ func (wp *WorkerPool) ProcessRequestDoc(ctx context.Context, requestDoc oraclety
 
 	job := &types.OracleJob{
 		ID:     requestDoc.RequestId,
-		URL:    requestDoc.Endpoints[index].Url,
-		Path:   requestDoc.Endpoints[index].ParseRule,
-		Nonce:  max(currentNonce, requestDoc.Nonce),
+		URL:    requestDoc.Endpoints[endpointIndex].Url,
+		Path:   requestDoc.Endpoints[endpointIndex].ParseRule,
+		Nonce:  requestDoc.Nonce,
 		Delay:  time.Duration(max(int64(0), dsec)) * time.Second,
 		Period: time.Duration(requestDoc.Period) * time.Second,
 		Status: requestDoc.Status,
+
+		OracleType:  requestDoc.OracleType,
+		Transform:   requestDoc.Endpoints[endpointIndex].Transform,
+		MessageType: requestDoc.Endpoints[endpointIndex].MessageType,
+		Accounts:    requestDoc.AccountList,
+		Primaries:   primaryCount(requestDoc),
+		History:     types.NewExecutionHistory(),
+
+		FullCoverage: requestDoc.CoverageMode == oracletypes.CoverageMode_COVERAGE_MODE_FULL,
 	}
 
+	wp.replaceJob(job)
 	wp.executeJob(ctx, job)
 }
 
 // ProcessComplete updates a job state using on-chain completion event data.
 // It advances the nonce and reschedules the next execution based on block time.
 func (wp *WorkerPool) ProcessComplete(ctx context.Context, reqID string, nonce uint64, timestamp uint64) {
-	job, ok := wp.jobStore.Get(reqID)
+	job, ok := wp.advanceNonce(reqID, nonce)
 	if !ok {
 		wp.logger.Debug("job not found", "request_id", reqID)
 		return
@@ -111,14 +262,183 @@ func (wp *WorkerPool) ProcessComplete(ctx context.Context, reqID string, nonce u
 		return
 	}
 
-	job.Nonce = max(job.Nonce, nonce)
+	// A degraded job sits out rounds until its backoff expires instead of occupying a worker every round
+	if failures, retryAt := wp.failureState(job); isDegraded(failures) && time.Now().Before(retryAt) {
+		wp.logger.Debug("job degraded, skipping round", "request_id", reqID, "failures", failures, "retry_at", retryAt)
+		return
+	}
+
 	periodSec := uint64(job.Period / time.Second)
 	nowSec := uint64(time.Now().Unix())
 	tsSec := uint64(timestamp)
 	dsec := int64(tsSec+periodSec) - int64(nowSec)
-	job.Delay = time.Duration(max(int64(0), dsec)) * time.Second
+	wp.executeRound(ctx, job, 0, time.Duration(max(int64(0), dsec))*time.Second)
+}
 
-	wp.executeJob(ctx, job)
+// ProcessCancel handles a round the moderator cancelled on chain. The request nonce advanced to the cancelled
+// round without a result, so the job moves past it like after a completed round and the next round starts
+// a period after the cancellation. A result still being fetched for the cancelled round is dropped.
+func (wp *WorkerPool) ProcessCancel(ctx context.Context, reqID string, nonce uint64, timestamp uint64) {
+	wp.logger.Info("round cancelled, rescheduling", "request_id", reqID, "nonce", nonce)
+	wp.ProcessComplete(ctx, reqID, nonce, timestamp)
+}
+
+// primaryCount returns how many providers submit each round without waiting when leader election is enabled.
+// A stake weighted request does not say how many reports it needs and a full coverage request needs all of them,
+// so all of their providers are primaries.
+func primaryCount(doc oracletypes.OracleRequestDoc) int {
+	if doc.QuorumMode == oracletypes.QuorumMode_QUORUM_MODE_STAKE_WEIGHTED || doc.CoverageMode == oracletypes.CoverageMode_COVERAGE_MODE_FULL {
+		return len(doc.AccountList)
+	}
+	return max(1, int(doc.Quorum))
+}
+
+// submitRank returns the position of self in the submission order of a round, or -1 if self is not a provider.
+// Every daemon derives the same order by hashing the request id, the nonce and each account,
+// so the primaries rotate from round to round.
+func submitRank(reqID, nonce uint64, accounts []string, self string) int {
+	key := func(account string) []byte {
+		h := sha256.New()
+		h.Write(binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, reqID), nonce))
+		h.Write([]byte(account))
+		return h.Sum(nil)
+	}
+
+	order := slices.Clone(accounts)
+	slices.SortFunc(order, func(a, b string) int {
+		return bytes.Compare(key(a), key(b))
+	})
+	return slices.Index(order, self)
+}
+
+// backupDelay returns how long self waits before it executes round nonce of job.
+// Primaries start at once; each backup waits one step more than the rank before it.
+func backupDelay(job *types.OracleJob, nonce uint64, self string, step time.Duration) time.Duration {
+	rank := submitRank(job.ID, nonce, job.Accounts, self)
+	if rank < job.Primaries {
+		return 0
+	}
+	return time.Duration(rank-job.Primaries+1) * step
+}
+
+// isValidAccount reports whether account is a bech32 account address that can submit data
+func isValidAccount(account string) bool {
+	_, err := sdk.AccAddressFromBech32(account)
+	return err == nil
+}
+
+// advanceNonce raises the nonce of a stored job to at least nonce and returns the job.
+// A reconnect can replay Complete events out of order; taking the max under nonceMu means a replayed
+// event never moves a job back to a round it already submitted.
+func (wp *WorkerPool) advanceNonce(reqID string, nonce uint64) (*types.OracleJob, bool) {
+	wp.nonceMu.Lock()
+	defer wp.nonceMu.Unlock()
+
+	job, ok := wp.jobStore.Get(reqID)
+	if ok {
+		job.Nonce = max(job.Nonce, nonce)
+	}
+	return job, ok
+}
+
+// replaceJob makes job the stored job of its request, keeping the nonce and execution history of the job it replaces
+func (wp *WorkerPool) replaceJob(job *types.OracleJob) {
+	wp.nonceMu.Lock()
+	defer wp.nonceMu.Unlock()
+
+	reqID := strconv.FormatUint(job.ID, 10)
+	if stored, ok := wp.jobStore.Get(reqID); ok {
+		job.Nonce = max(job.Nonce, stored.Nonce)
+		if stored.History != nil {
+			job.History = stored.History
+		}
+	}
+	job.UpdatedAt = time.Now()
+	wp.jobStore.Set(reqID, job)
+}
+
+// storeJob raises the nonce of job to at least nonce, keeping the stored nonce if a Complete event for a later
+// round arrived while job was executing. It reports false and changes nothing if job is no longer the stored
+// job of its request, because a request document replaced it or the request was removed meanwhile.
+func (wp *WorkerPool) storeJob(job *types.OracleJob, nonce uint64) bool {
+	wp.nonceMu.Lock()
+	defer wp.nonceMu.Unlock()
+
+	if !wp.isStored(job) {
+		return false
+	}
+	job.Nonce = max(nonce, job.Nonce)
+	job.UpdatedAt = time.Now()
+	return true
+}
+
+// isStored reports whether job is still the stored job of its request. The caller must hold nonceMu.
+func (wp *WorkerPool) isStored(job *types.OracleJob) bool {
+	stored, ok := wp.jobStore.Get(strconv.FormatUint(job.ID, 10))
+	return ok && stored == job
+}
+
+// storedNonce returns the nonce of the stored copy of job, or its own nonce if it is not stored
+func (wp *WorkerPool) storedNonce(job *types.OracleJob) uint64 {
+	wp.nonceMu.Lock()
+	defer wp.nonceMu.Unlock()
+
+	if stored, ok := wp.jobStore.Get(strconv.FormatUint(job.ID, 10)); ok {
+		return stored.Nonce
+	}
+	return job.Nonce
+}
+
+// RemoveJob stops scheduling a request, e.g. after the chain rejected a submission because it was disabled.
+// A later enabled request document schedules it again.
+func (wp *WorkerPool) RemoveJob(reqID uint64) {
+	wp.jobStore.Remove(strconv.FormatUint(reqID, 10))
+}
+
+// JobState is a point-in-time view of a scheduled job for support bundles
+type JobState struct {
+	ID      uint64                  `json:"id"`
+	URL     string                  `json:"url"`
+	Path    string                  `json:"path"`
+	Nonce   uint64                  `json:"nonce"`
+	Period  string                  `json:"period"`
+	Status  string                  `json:"status"`
+	History []types.ExecutionRecord `json:"history"`
+
+	ConsecutiveFailures int  `json:"consecutive_failures"`
+	Degraded            bool `json:"degraded"`
+}
+
+// Jobs returns the state of every tracked job, ordered by request ID.
+// URLs are reported unresolved so secrets referenced as ${NAME} never appear.
+func (wp *WorkerPool) Jobs() []JobState {
+	wp.nonceMu.Lock()
+	defer wp.nonceMu.Unlock()
+
+	jobs := make([]JobState, 0, wp.jobStore.Count())
+	for _, job := range wp.jobStore.Items() {
+		jobs = append(jobs, JobState{
+			ID:      job.ID,
+			URL:     job.URL,
+			Path:    job.Path,
+			Nonce:   job.Nonce,
+			Period:  job.Period.String(),
+			Status:  job.Status.String(),
+			History: job.History.Records(),
+
+			ConsecutiveFailures: job.Failures,
+			Degraded:            isDegraded(job.Failures),
+		})
+	}
+
+	slices.SortFunc(jobs, func(a, b JobState) int { return cmp.Compare(a.ID, b.ID) })
+	return jobs
+}
+
+// Wait blocks until the pool shut down after its context was canceled: every task returned and
+// the results channel is closed.
+func (wp *WorkerPool) Wait() {
+	<-wp.done
 }
 
 // Results returns a read-only channel of completed job results.
@@ -132,12 +452,25 @@ func (wp *WorkerPool) Results() <-chan *types.OracleJobResult {
 // The nonce is only incremented and persisted after all external operations succeed,
 // ensuring on-chain nonce consistency.
 func (wp *WorkerPool) executeJob(ctx context.Context, job *types.OracleJob) {
+	wp.executeRound(ctx, job, 0, job.Delay)
+}
+
+// executeRound schedules an execution of job after delay. A non-zero round restricts it to that round,
+// so a retry is skipped once the round was completed or cancelled.
+func (wp *WorkerPool) executeRound(ctx context.Context, job *types.OracleJob, round uint64, delay time.Duration) {
 	task := job
 
+	wp.stopMu.RLock()
+	defer wp.stopMu.RUnlock()
+	if wp.stopped {
+		wp.logger.Debug("worker pool stopped, not scheduling job", "request_id", job.ID)
+		return
+	}
+
 	wp.workerFunc(func() error {
-		if 0 < task.Nonce && 0 < task.Delay {
+		if 0 < delay && (0 < round || 0 < wp.storedNonce(task)) {
 			select {
-			case <-time.After(task.Delay):
+			case <-time.After(delay):
 			case <-ctx.Done():
 				return nil
 			}
@@ -150,34 +483,60 @@ func (wp *WorkerPool) executeJob(ctx context.Context, job *types.OracleJob) {
 			return nil
 		}
 
-		reqID := strconv.FormatUint(task.ID, 10)
-
 		// Calculate next nonce but don't persist yet
-		var nextNonce uint64
-		if stored, ok := wp.jobStore.Get(reqID); ok {
-			nextNonce = stored.Nonce + 1
-		} else {
-			nextNonce = task.Nonce + 1
+		nextNonce := wp.storedNonce(task) + 1
+		if round != 0 && round != nextNonce {
+			wp.logger.Debug("round closed before retry", "request_id", task.ID, "round", round)
+			return nil
+		}
+
+		// With leader election the backups of this round only submit if it is still open after their delay
+		if config.LeaderElection() {
+			if wait := backupDelay(task, nextNonce, config.Address().String(), config.BackupDelay()); 0 < wait {
+				wp.logger.Debug("backup submitter, waiting for primaries",
+					"request_id", task.ID,
+					"nonce", nextNonce,
+					"wait", wait)
+				select {
+				case <-time.After(wait):
+				case <-ctx.Done():
+					return nil
+				}
+				if nextNonce <= wp.storedNonce(task) {
+					wp.logger.Debug("round completed without this backup", "request_id", task.ID, "nonce", nextNonce)
+					return nil
+				}
+			}
 		}
 
 		// Perform all external operations that may fail
-		rawData, err := wp.client.fetchRawData(task.URL)
+		start := time.Now()
+		rawData, contentType, err := wp.client.fetchRawData(task.URL)
 		if err != nil {
 			wp.logger.Error("failed to fetch raw data",
 				"error", err,
 				"request_id", task.ID,
 				"nonce", nextNonce)
-			wp.resultCh <- nil
+			wp.recordFailure(task, nextNonce, start, err)
+			wp.retryRound(ctx, task, nextNonce)
 			return err
 		}
 		wp.logger.Debug("fetched raw data", "id", task.ID, "url", task.URL)
 
-		jsonData, err := wp.client.parseRawData(rawData)
+		// Endpoints with a message type serve protobuf, whatever content type they report
+		var jsonData map[string]any
+		if task.MessageType != "" {
+			jsonData, err = wp.client.parseMessage(rawData, task.MessageType)
+		} else {
+			jsonData, err = wp.client.parseBody(rawData, contentType)
+		}
 		if err != nil {
 			wp.logger.Error("failed to parse raw data",
 				"error", err,
 				"request_id", task.ID,
 				"nonce", nextNonce)
+			wp.recordFailure(task, nextNonce, start, err)
+			wp.retryRound(ctx, task, nextNonce)
 			return err
 		}
 
@@ -187,23 +546,179 @@ func (wp *WorkerPool) executeJob(ctx context.Context, job *types.OracleJob) {
 				"error", err,
 				"request_id", task.ID,
 				"nonce", nextNonce)
+			wp.recordFailure(task, nextNonce, start, err)
+			wp.retryRound(ctx, task, nextNonce)
 			return err
 		}
 
-		// All operations succeeded - now persist the nonce increment
-		task.Nonce = nextNonce
-		wp.jobStore.Set(reqID, task)
+		result, err = applyTransform(result, task.Transform)
+		if err != nil {
+			wp.logger.Error("failed to transform extracted data",
+				"error", err,
+				"request_id", task.ID,
+				"nonce", nextNonce)
+			wp.recordFailure(task, nextNonce, start, err)
+			wp.retryRound(ctx, task, nextNonce)
+			return err
+		}
 
-		wp.resultCh <- &types.OracleJobResult{
-			ID:    task.ID,
-			Data:  result,
-			Nonce: task.Nonce,
+		result, err = normalizeValue(result, task.OracleType)
+		if err != nil {
+			wp.logger.Error("failed to normalize extracted data",
+				"error", err,
+				"request_id", task.ID,
+				"nonce", nextNonce)
+			wp.recordFailure(task, nextNonce, start, err)
+			wp.retryRound(ctx, task, nextNonce)
+			return err
+		}
+
+		recordExecution(task, nextNonce, start, result, nil)
+		wp.clearFailures(task)
+
+		// A round completed or cancelled while fetching is closed, the chain would reject the result
+		if nextNonce <= wp.storedNonce(task) {
+			wp.logger.Info("round closed while fetching, dropping result", "request_id", task.ID, "nonce", nextNonce)
+			return nil
 		}
+
+		// All operations succeeded - now persist the nonce increment, unless the job was replaced or removed
+		if !wp.storeJob(task, nextNonce) {
+			wp.logger.Info("job replaced or removed while fetching, dropping result", "request_id", task.ID, "nonce", nextNonce)
+			return nil
+		}
+
+		wp.sendResult(ctx, &types.OracleJobResult{
+			ID:       task.ID,
+			Data:     result,
+			Nonce:    nextNonce,
+			Deadline: submitDeadline(start, task.Period),
+		})
 		wp.logger.Debug("sent result to channel",
 			"id", task.ID,
 			"data", result,
-			"nonce", task.Nonce)
+			"nonce", nextNonce)
 
 		return nil
 	})
 }
+
+// sendResult hands a result to the consumer unless the pool is shutting down.
+// Without the ctx case a task could block forever once the consumer stopped reading, and the pool would never finish shutting down.
+func (wp *WorkerPool) sendResult(ctx context.Context, result *types.OracleJobResult) {
+	select {
+	case wp.resultCh <- result:
+	case <-ctx.Done():
+	}
+}
+
+// submitDeadline returns when a result whose fetch started at start is too late to submit.
+// The zero time disables the check.
+func submitDeadline(start time.Time, period time.Duration) time.Time {
+	fraction := config.SubmitDeadlineFraction()
+	if fraction <= 0 || period <= 0 {
+		return time.Time{}
+	}
+
+	return start.Add(time.Duration(fraction * float64(period)))
+}
+
+// isDegraded reports whether a job with failures consecutive failures exhausted its failure budget
+func isDegraded(failures int) bool {
+	budget := config.MaxConsecutiveFailures()
+	return budget > 0 && failures >= budget
+}
+
+// recordFailure records a failed execution and degrades the job once it exhausted its failure budget.
+// Every further failure of a degraded job pushes its next run out by worker.degraded_backoff_sec.
+// A successful run or an updated request document clears the failures.
+func (wp *WorkerPool) recordFailure(job *types.OracleJob, nonce uint64, start time.Time, err error) {
+	recordExecution(job, nonce, start, "", err)
+
+	wp.nonceMu.Lock()
+	defer wp.nonceMu.Unlock()
+
+	// A replaced or removed job is no longer scheduled, so its failures count for nothing
+	if !wp.isStored(job) {
+		return
+	}
+	job.Failures++
+	job.UpdatedAt = time.Now()
+	if !isDegraded(job.Failures) {
+		return
+	}
+
+	job.RetryAt = time.Now().Add(config.DegradedBackoff())
+	if job.Failures == config.MaxConsecutiveFailures() {
+		wp.logger.Info("job degraded after consecutive failures",
+			"request_id", job.ID,
+			"failures", job.Failures,
+			"retry_at", job.RetryAt)
+	}
+}
+
+// retryRound schedules another attempt at a failed round of a full coverage request after worker.coverage_retry_sec.
+// Such a round only finalizes once every provider reported, so waiting for the next complete event would stall it.
+// A degraded job is not retried; it runs again once its backoff expired.
+func (wp *WorkerPool) retryRound(ctx context.Context, job *types.OracleJob, round uint64) {
+	if failures, _ := wp.failureState(job); !job.FullCoverage || isDegraded(failures) {
+		return
+	}
+
+	wp.logger.Info("full coverage round failed, retrying", "request_id", job.ID, "nonce", round, "retry_in", wp.coverageRetry)
+
+	// Called from a running task: scheduling waits for a free worker slot, which this task only frees once it returned
+	go wp.executeRound(ctx, job, round, wp.coverageRetry)
+}
+
+// failureState returns the consecutive failures of job and when a degraded job may run again
+func (wp *WorkerPool) failureState(job *types.OracleJob) (int, time.Time) {
+	wp.nonceMu.Lock()
+	defer wp.nonceMu.Unlock()
+
+	return job.Failures, job.RetryAt
+}
+
+// clearFailures resets the failure budget of job after a successful execution
+func (wp *WorkerPool) clearFailures(job *types.OracleJob) {
+	wp.nonceMu.Lock()
+	defer wp.nonceMu.Unlock()
+
+	if isDegraded(job.Failures) {
+		wp.logger.Info("job recovered", "request_id", job.ID, "failures", job.Failures)
+	}
+	job.Failures = 0
+	job.RetryAt = time.Time{}
+}
+
+// DegradedJobs returns the number of jobs that exhausted their failure budget
+func (wp *WorkerPool) DegradedJobs() int {
+	wp.nonceMu.Lock()
+	defer wp.nonceMu.Unlock()
+
+	count := 0
+	for _, job := range wp.jobStore.Items() {
+		if isDegraded(job.Failures) {
+			count++
+		}
+	}
+	return count
+}
+
+// WrappedAssignments returns how many request documents assigned this instance a wrapped-around endpoint
+func (wp *WorkerPool) WrappedAssignments() uint64 { return wp.wrappedAssignments.Load() }
+
+// recordExecution appends the outcome of a job execution to its history
+func recordExecution(job *types.OracleJob, nonce uint64, start time.Time, value string, err error) {
+	record := types.ExecutionRecord{
+		Time:     start,
+		Nonce:    nonce,
+		Value:    value,
+		Duration: time.Since(start),
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	job.History.Add(record)
+}
@@ -2,15 +2,21 @@ package worker
 
 import (
 	"context"
+	"errors"
+	"math/rand"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"cosmossdk.io/log"
 	"github.com/gurufinglobal/guru/v2/crypto/hd"
 	"github.com/gurufinglobal/guru/v2/oralce/config"
+	ctypes "github.com/gurufinglobal/guru/v2/oralce/types"
 	"github.com/gurufinglobal/guru/v2/testutil"
 	"github.com/gurufinglobal/guru/v2/types"
 	oracletypes "github.com/gurufinglobal/guru/v2/x/oracle/types"
@@ -18,6 +24,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
 )
 
 type PoolTestSuite struct {
@@ -57,13 +64,15 @@ func (p *PoolTestSuite) SetupSuite() {
 	p.ctx, p.cancelFunc = context.WithCancel(context.Background())
 
 	// Create worker pool
-	p.pool = New(p.ctx, log.NewTestLogger(p.T()))
+	p.pool = New(p.ctx, log.NewTestLogger(p.T()), nil)
 }
 
 func (p *PoolTestSuite) TearDownSuite() {
 	p.T().Log("tearing down pool test suite")
 	if p.cancelFunc != nil {
 		p.cancelFunc()
+		// The pool logs while shutting down, which must happen before the suite completes
+		p.pool.Wait()
 	}
 
 	// Clean up temporary directory
@@ -94,6 +103,16 @@ func TestPoolTestSuite(t *testing.T) {
 	suite.Run(t, new(PoolTestSuite))
 }
 
+// historyOf returns the recent executions of a job as reported by Jobs, or nil if the job is not tracked
+func historyOf(pool *WorkerPool, id uint64) []ctypes.ExecutionRecord {
+	for _, job := range pool.Jobs() {
+		if job.ID == id {
+			return job.History
+		}
+	}
+	return nil
+}
+
 func (p *PoolTestSuite) TestNew() {
 	p.T().Log("testing new worker pool")
 
@@ -103,7 +122,7 @@ func (p *PoolTestSuite) TestNew() {
 		defer cancel()
 
 		logger := log.NewTestLogger(p.T())
-		pool := New(ctx, logger)
+		pool := New(ctx, logger, nil)
 
 		assert.NotNil(p.T(), pool)
 		assert.NotNil(p.T(), pool.logger)
@@ -190,8 +209,33 @@ func (p *PoolTestSuite) TestProcessRequestDoc_AccountNotAssigned() {
 			},
 		}
 
-		// Should not panic or error, just return early
+		// A job left from an earlier assignment is removed
+		p.pool.jobStore.Set("4", &ctypes.OracleJob{ID: 4, Status: oracletypes.RequestStatus_REQUEST_STATUS_ENABLED})
+		p.pool.ProcessRequestDoc(p.ctx, requestDoc, uint64(time.Now().Unix()))
+		p.Require().False(p.pool.jobStore.Has("4"))
+	}
+}
+
+func (p *PoolTestSuite) TestProcessRequestDoc_NoValidAccount() {
+	p.T().Log("testing process request doc - no valid account")
+
+	// Empty or unparseable account lists -> nothing is scheduled and the job is not tracked
+	for _, accounts := range [][]string{nil, {"not-an-address", ""}} {
+		requestDoc := oracletypes.OracleRequestDoc{
+			RequestId:   47,
+			Status:      oracletypes.RequestStatus_REQUEST_STATUS_ENABLED,
+			AccountList: accounts,
+			Period:      3,
+			Endpoints: []*oracletypes.OracleEndpoint{
+				{
+					Url:       "https://api.example.com/data",
+					ParseRule: "rates.KRW",
+				},
+			},
+		}
+
 		p.pool.ProcessRequestDoc(p.ctx, requestDoc, uint64(time.Now().Unix()))
+		p.Require().False(p.pool.jobStore.Has("47"))
 	}
 }
 
@@ -272,6 +316,52 @@ func (p *PoolTestSuite) TestProcessRequestDoc_ValidRequest() {
 	}
 }
 
+func (p *PoolTestSuite) TestProcessRequestDoc_WrappedAssignment() {
+	p.T().Log("testing wrapped endpoint assignments are counted")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pool := New(ctx, log.NewTestLogger(p.T()), nil)
+	defer pool.Wait()
+	defer cancel()
+
+	oracleAddress := config.Address().String()
+	endpoints := []*oracletypes.OracleEndpoint{
+		{Url: server.URL + "/a", ParseRule: "price"},
+		{Url: server.URL + "/b", ParseRule: "price"},
+	}
+
+	// The first account is assigned the second endpoint, which exists
+	pool.ProcessRequestDoc(ctx, oracletypes.OracleRequestDoc{
+		RequestId:   70,
+		Status:      oracletypes.RequestStatus_REQUEST_STATUS_ENABLED,
+		AccountList: []string{oracleAddress, p.testAddresses[1].String(), p.testAddresses[2].String()},
+		Period:      60,
+		Nonce:       1,
+		Endpoints:   endpoints,
+	}, uint64(time.Now().Unix()))
+	p.Require().Zero(pool.WrappedAssignments())
+
+	// The second account would be assigned a third endpoint and wraps around to the first
+	pool.ProcessRequestDoc(ctx, oracletypes.OracleRequestDoc{
+		RequestId:   71,
+		Status:      oracletypes.RequestStatus_REQUEST_STATUS_ENABLED,
+		AccountList: []string{p.testAddresses[1].String(), oracleAddress, p.testAddresses[2].String()},
+		Period:      60,
+		Nonce:       1,
+		Endpoints:   endpoints,
+	}, uint64(time.Now().Unix()))
+	p.Require().Equal(uint64(1), pool.WrappedAssignments())
+
+	job, ok := pool.jobStore.Get("71")
+	p.Require().True(ok)
+	p.Require().Equal(server.URL+"/a", job.URL)
+}
+
 func (p *PoolTestSuite) TestProcessComplete_JobNotFound() {
 	p.T().Log("testing process complete - job not found")
 
@@ -385,6 +475,13 @@ func (p *PoolTestSuite) TestIntegration_FullJobExecution() {
 				assert.Equal(p.T(), uint64(8), result.ID)
 				assert.Equal(p.T(), "1388.95", result.Data)
 				assert.Greater(p.T(), result.Nonce, uint64(0))
+
+				history := historyOf(p.pool, 8)
+				if assert.NotEmpty(p.T(), history) {
+					last := history[len(history)-1]
+					assert.Equal(p.T(), "1388.95", last.Value)
+					assert.Empty(p.T(), last.Error)
+				}
 			}
 		case <-time.After(5 * time.Second):
 			p.T().Log("timeout waiting for result")
@@ -431,13 +528,12 @@ func (p *PoolTestSuite) TestIntegration_JobExecutionError() {
 		// Process the request
 		p.pool.ProcessRequestDoc(p.ctx, requestDoc, uint64(time.Now().Unix()))
 
-		// Wait for job execution result (should be nil due to error)
-		select {
-		case result := <-p.pool.Results():
-			assert.Nil(p.T(), result) // Should be nil due to fetch error
-		case <-time.After(5 * time.Second):
-			p.T().Log("timeout waiting for error result")
-		}
+		// The failed fetch is recorded on the job instead of being reported as a result
+		p.Require().Eventually(func() bool {
+			history := historyOf(p.pool, 9)
+			return len(history) == 1 && history[0].Error != ""
+		}, 15*time.Second, 50*time.Millisecond)
+		p.Require().True(p.pool.jobStore.Has("9"))
 	}
 }
 
@@ -497,3 +593,521 @@ func (p *PoolTestSuite) TestIntegration_InvalidParseRule() {
 		}
 	}
 }
+
+func (p *PoolTestSuite) TestRemoveJob() {
+	p.T().Log("testing remove job")
+
+	p.pool.jobStore.Set("42", &ctypes.OracleJob{ID: 42, Status: oracletypes.RequestStatus_REQUEST_STATUS_ENABLED})
+	p.Require().True(p.pool.jobStore.Has("42"))
+
+	p.pool.RemoveJob(42)
+	p.Require().False(p.pool.jobStore.Has("42"))
+
+	// Removing an unknown job is a no-op
+	p.pool.RemoveJob(42)
+}
+
+func (p *PoolTestSuite) TestSweepJobs() {
+	p.T().Log("testing sweep of jobs no longer live on chain")
+
+	self := config.Address().String()
+	other := p.testAddresses[1].String()
+	enabled := oracletypes.RequestStatus_REQUEST_STATUS_ENABLED
+	queryClient := mockQueryClient{docs: map[uint64]oracletypes.OracleRequestDoc{
+		48: {RequestId: 48, Status: enabled, AccountList: []string{self}},
+		49: {RequestId: 49, Status: oracletypes.RequestStatus_REQUEST_STATUS_DISABLED, AccountList: []string{self}},
+		50: {RequestId: 50, Status: enabled, AccountList: []string{other}},
+		51: {RequestId: 51, Status: enabled, AccountList: []string{self}},
+		53: {RequestId: 53, Status: oracletypes.RequestStatus_REQUEST_STATUS_DISABLED, AccountList: []string{self}},
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pool := New(ctx, log.NewTestLogger(p.T()), queryClient)
+	defer pool.Wait()
+	defer cancel()
+
+	now := time.Now()
+	idle := now.Add(-2 * config.JobTTL())
+	job := func(id uint64, updatedAt time.Time) *ctypes.OracleJob {
+		return &ctypes.OracleJob{ID: id, Period: 3 * time.Second, Status: enabled, UpdatedAt: updatedAt}
+	}
+	pool.jobStore.Set("48", job(48, idle))
+	pool.jobStore.Set("49", job(49, idle))
+	pool.jobStore.Set("50", job(50, idle))
+	pool.jobStore.Set("51", job(51, now))
+	pool.jobStore.Set("52", job(52, idle))
+	pool.jobStore.Set("53", job(53, now))
+
+	// Idle jobs of a disabled or reassigned request are swept. An idle job whose round is slow is still live
+	// and kept, and so are a job whose request cannot be queried and jobs that are not idle, whatever their request.
+	p.Require().Equal(2, pool.SweepJobs(ctx, now))
+	p.Require().True(pool.jobStore.Has("48"))
+	p.Require().False(pool.jobStore.Has("49"))
+	p.Require().False(pool.jobStore.Has("50"))
+	p.Require().True(pool.jobStore.Has("51"))
+	p.Require().True(pool.jobStore.Has("52"))
+	p.Require().True(pool.jobStore.Has("53"))
+
+	// The live job counts as updated, so it is not checked again within the TTL
+	kept, _ := pool.jobStore.Get("48")
+	p.Require().Equal(now, kept.UpdatedAt)
+
+	// A job whose period exceeds the TTL is not idle for two periods
+	pool.jobStore.Set("54", &ctypes.OracleJob{ID: 54, Period: 2 * config.JobTTL(), Status: enabled, UpdatedAt: idle})
+	p.Require().Zero(pool.SweepJobs(ctx, now))
+	p.Require().True(pool.jobStore.Has("54"))
+}
+
+// mockQueryClient answers OracleRequestDoc from docs and fails for unknown requests
+type mockQueryClient struct {
+	oracletypes.QueryClient
+	docs map[uint64]oracletypes.OracleRequestDoc
+}
+
+func (m mockQueryClient) OracleRequestDoc(_ context.Context, req *oracletypes.QueryOracleRequestDocRequest, _ ...grpc.CallOption) (*oracletypes.QueryOracleRequestDocResponse, error) {
+	doc, ok := m.docs[req.RequestId]
+	if !ok {
+		return nil, errors.New("node unreachable")
+	}
+	return &oracletypes.QueryOracleRequestDocResponse{RequestDoc: doc}, nil
+}
+
+func (p *PoolTestSuite) TestProcessCancel() {
+	p.T().Log("testing process cancel - in-flight round dropped and job rescheduled")
+
+	// The first fetch blocks until the round was cancelled
+	var calls atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			<-release
+		}
+		w.Write([]byte(`{"rates": {"KRW": 1388.95}}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pool := New(ctx, log.NewTestLogger(p.T()), nil)
+	defer pool.Wait()
+	defer cancel()
+
+	job := &ctypes.OracleJob{
+		ID:      62,
+		URL:     server.URL,
+		Path:    "rates.KRW",
+		Nonce:   4,
+		Period:  time.Second,
+		Status:  oracletypes.RequestStatus_REQUEST_STATUS_ENABLED,
+		History: ctypes.NewExecutionHistory(),
+	}
+	pool.jobStore.Set("62", job)
+
+	// Round 5 starts and its fetch hangs, then the moderator cancels it
+	pool.ProcessComplete(ctx, "62", 4, uint64(time.Now().Add(-time.Second).Unix()))
+	p.Require().Eventually(func() bool { return calls.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+	pool.ProcessCancel(ctx, "62", 5, uint64(time.Now().Unix()))
+	close(release)
+
+	// The result for the cancelled round is dropped and the job runs the next round at the new nonce
+	for {
+		select {
+		case result := <-pool.Results():
+			if result == nil {
+				continue
+			}
+			p.Require().Equal(uint64(6), result.Nonce)
+			return
+		case <-time.After(5 * time.Second):
+			p.T().Fatal("timeout waiting for the rescheduled round")
+		}
+	}
+}
+
+func (p *PoolTestSuite) TestFullCoverageRetry() {
+	p.T().Log("testing full coverage - failed round is retried instead of waiting for the next round")
+
+	// The endpoint returns no usable value on the first call
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			w.Write([]byte(`{"rates": {}}`))
+			return
+		}
+		w.Write([]byte(`{"rates": {"KRW": 1388.95}}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pool := New(ctx, log.NewTestLogger(p.T()), nil)
+	pool.coverageRetry = 100 * time.Millisecond
+	defer pool.Wait()
+	defer cancel()
+
+	job := &ctypes.OracleJob{
+		ID:           63,
+		URL:          server.URL,
+		Path:         "rates.KRW",
+		Nonce:        4,
+		Period:       time.Hour,
+		Status:       oracletypes.RequestStatus_REQUEST_STATUS_ENABLED,
+		History:      ctypes.NewExecutionHistory(),
+		FullCoverage: true,
+	}
+	pool.jobStore.Set("63", job)
+	pool.ProcessComplete(ctx, "63", 4, uint64(time.Now().Add(-time.Hour).Unix()))
+
+	// The failed attempt submits nothing; the retry submits the same round
+	select {
+	case result := <-pool.Results():
+		p.Require().NotNil(result)
+		p.Require().Equal(uint64(5), result.Nonce)
+		p.Require().Equal("1388.95", result.Data)
+	case <-time.After(5 * time.Second):
+		p.FailNow("timeout waiting for the retried round")
+	}
+	p.Require().Equal(int32(2), hits.Load())
+	p.Require().Zero(job.Failures)
+}
+
+func (p *PoolTestSuite) TestFullCoverageRetry_FetchFailure() {
+	p.T().Log("testing full coverage - round with a failed fetch is retried")
+
+	// The endpoint rejects the first request without a retryable status
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"rates": {"KRW": 1388.95}}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pool := New(ctx, log.NewTestLogger(p.T()), nil)
+	pool.coverageRetry = 100 * time.Millisecond
+	defer pool.Wait()
+	defer cancel()
+
+	job := &ctypes.OracleJob{
+		ID:           64,
+		URL:          server.URL,
+		Path:         "rates.KRW",
+		Nonce:        4,
+		Period:       time.Hour,
+		Status:       oracletypes.RequestStatus_REQUEST_STATUS_ENABLED,
+		History:      ctypes.NewExecutionHistory(),
+		FullCoverage: true,
+	}
+	pool.jobStore.Set("64", job)
+	pool.ProcessComplete(ctx, "64", 4, uint64(time.Now().Add(-time.Hour).Unix()))
+
+	// The failed fetch sends nothing; the retry submits the same round
+	select {
+	case result := <-pool.Results():
+		p.Require().NotNil(result)
+		p.Require().Equal(uint64(5), result.Nonce)
+		p.Require().Equal("1388.95", result.Data)
+	case <-time.After(5 * time.Second):
+		p.FailNow("timeout waiting for the retried round")
+	}
+	p.Require().Equal(int32(2), hits.Load())
+	p.Require().Zero(job.Failures)
+}
+
+func (p *PoolTestSuite) TestJobs() {
+	p.T().Log("testing jobs snapshot")
+
+	p.pool.jobStore.Set("44", &ctypes.OracleJob{ID: 44, URL: "https://api.example.com/?key=${API_KEY}", Period: 3 * time.Second, Status: oracletypes.RequestStatus_REQUEST_STATUS_ENABLED})
+	p.pool.jobStore.Set("43", &ctypes.OracleJob{ID: 43, Status: oracletypes.RequestStatus_REQUEST_STATUS_ENABLED})
+	defer p.pool.RemoveJob(43)
+	defer p.pool.RemoveJob(44)
+
+	var ids []uint64
+	for _, job := range p.pool.Jobs() {
+		ids = append(ids, job.ID)
+		if job.ID == 44 {
+			p.Require().Equal("https://api.example.com/?key=${API_KEY}", job.URL)
+			p.Require().Equal("3s", job.Period)
+			p.Require().Equal("REQUEST_STATUS_ENABLED", job.Status)
+		}
+	}
+	p.Require().Subset(ids, []uint64{43, 44})
+	p.Require().IsIncreasing(ids)
+}
+
+func (p *PoolTestSuite) TestProcessComplete_OutOfOrder() {
+	p.T().Log("testing process complete - out of order events")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pool := New(ctx, log.NewTestLogger(p.T()), nil)
+	defer pool.Wait()
+	defer cancel()
+
+	// A degraded job only tracks the nonce, so no round is scheduled while events arrive
+	job := &ctypes.OracleJob{
+		ID:       46,
+		Nonce:    4,
+		Status:   oracletypes.RequestStatus_REQUEST_STATUS_ENABLED,
+		History:  ctypes.NewExecutionHistory(),
+		Failures: config.MaxConsecutiveFailures(),
+		RetryAt:  time.Now().Add(time.Hour),
+	}
+	pool.jobStore.Set("46", job)
+
+	// Replayed events arrive out of order; the nonce only ever increases
+	highest := job.Nonce
+	for _, nonce := range []uint64{5, 3, 7, 6, 2, 7, 1} {
+		pool.ProcessComplete(ctx, "46", nonce, uint64(time.Now().Unix()))
+		highest = max(highest, nonce)
+		p.Require().Equal(highest, pool.storedNonce(job), "after complete %d", nonce)
+	}
+
+	// Concurrent events settle on the highest nonce
+	var wg sync.WaitGroup
+	for _, nonce := range rand.Perm(50) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pool.ProcessComplete(ctx, "46", uint64(nonce+8), uint64(time.Now().Unix()))
+		}()
+	}
+	wg.Wait()
+	p.Require().Equal(uint64(57), pool.storedNonce(job))
+
+	// A task of a job that is no longer stored does not move the stored nonce back
+	stale := &ctypes.OracleJob{ID: 46, Status: oracletypes.RequestStatus_REQUEST_STATUS_ENABLED, History: ctypes.NewExecutionHistory()}
+	p.Require().False(pool.storeJob(stale, 8))
+	p.Require().Equal(uint64(57), pool.storedNonce(stale))
+}
+
+func (p *PoolTestSuite) TestFailureBudget() {
+	p.T().Log("testing consecutive failure budget")
+
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Write([]byte(`{"price": "42"}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pool := New(ctx, log.NewTestLogger(p.T()), nil)
+	defer pool.Wait()
+	defer cancel()
+
+	job := &ctypes.OracleJob{ID: 45, URL: server.URL, Path: "price", Nonce: 1, Status: oracletypes.RequestStatus_REQUEST_STATUS_ENABLED, History: ctypes.NewExecutionHistory()}
+	pool.jobStore.Set("45", job)
+	for i := 0; i < config.MaxConsecutiveFailures(); i++ {
+		p.Require().Zero(pool.DegradedJobs())
+		pool.recordFailure(job, 2, time.Now(), errors.New("fetch failed"))
+	}
+	p.Require().Equal(1, pool.DegradedJobs())
+	p.Require().True(pool.Jobs()[0].Degraded)
+	p.Require().WithinDuration(time.Now().Add(config.DegradedBackoff()), job.RetryAt, time.Minute)
+
+	// Rounds completing during the backoff do not run the job
+	pool.ProcessComplete(ctx, "45", 2, uint64(time.Now().Unix()))
+	time.Sleep(200 * time.Millisecond)
+	p.Require().Zero(hits.Load())
+
+	// After the backoff the job runs again and a success clears the failures
+	job.RetryAt = time.Now().Add(-time.Second)
+	pool.ProcessComplete(ctx, "45", 2, uint64(time.Now().Unix()))
+	select {
+	case result := <-pool.Results():
+		p.Require().NotNil(result)
+		p.Require().Equal("42", result.Data)
+	case <-time.After(5 * time.Second):
+		p.FailNow("timeout waiting for result")
+	}
+	p.Require().Equal(int32(1), hits.Load())
+	p.Require().Zero(pool.DegradedJobs())
+	p.Require().Zero(job.Failures)
+}
+
+func (p *PoolTestSuite) TestFailureBudget_FetchFailure() {
+	p.T().Log("testing consecutive fetch failures degrade the job")
+
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pool := New(ctx, log.NewTestLogger(p.T()), nil)
+	defer pool.Wait()
+	defer cancel()
+
+	job := &ctypes.OracleJob{ID: 47, URL: server.URL, Path: "price", Nonce: 1, Status: oracletypes.RequestStatus_REQUEST_STATUS_ENABLED, History: ctypes.NewExecutionHistory()}
+	pool.jobStore.Set("47", job)
+
+	// Every round fails to fetch; each failure is counted on the same tracked job
+	for i := 1; i <= config.MaxConsecutiveFailures(); i++ {
+		p.Require().Zero(pool.DegradedJobs())
+		pool.ProcessComplete(ctx, "47", 1, uint64(time.Now().Unix()))
+		p.Require().Eventually(func() bool { return len(historyOf(pool, 47)) == i }, 5*time.Second, 10*time.Millisecond)
+	}
+	p.Require().Eventually(func() bool { return pool.DegradedJobs() == 1 }, 5*time.Second, 10*time.Millisecond)
+	p.Require().Equal(int32(config.MaxConsecutiveFailures()), hits.Load())
+
+	// No failure reached the result consumer, which would have restarted the daemon
+	select {
+	case result := <-pool.Results():
+		p.FailNow("unexpected result", "result: %v", result)
+	default:
+	}
+
+	// The degraded job sits out the next round
+	pool.ProcessComplete(ctx, "47", 1, uint64(time.Now().Unix()))
+	time.Sleep(200 * time.Millisecond)
+	p.Require().Equal(int32(config.MaxConsecutiveFailures()), hits.Load())
+}
+
+func (p *PoolTestSuite) TestProcessRequestDoc_ReplacedWhileFetchFailing() {
+	p.T().Log("testing a job replaced or removed while its fetch is failing")
+
+	// blocking serves a 404 once release is closed, signalling each request it holds
+	blocking := func() (*httptest.Server, chan struct{}, chan struct{}) {
+		fetching, release := make(chan struct{}, 1), make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fetching <- struct{}{}
+			<-release
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		return server, fetching, release
+	}
+	fixed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"price": "42"}`))
+	}))
+	defer fixed.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pool := New(ctx, log.NewTestLogger(p.T()), nil)
+	defer pool.Wait()
+	defer cancel()
+
+	doc := func(id uint64, url string, status oracletypes.RequestStatus) oracletypes.OracleRequestDoc {
+		endpoint := &oracletypes.OracleEndpoint{Url: url, ParseRule: "price"}
+		return oracletypes.OracleRequestDoc{
+			RequestId:   id,
+			Status:      status,
+			AccountList: []string{config.Address().String(), p.testAddresses[1].String()},
+			Nonce:       1,
+			Endpoints:   []*oracletypes.OracleEndpoint{endpoint, endpoint},
+		}
+	}
+	enabled := oracletypes.RequestStatus_REQUEST_STATUS_ENABLED
+
+	// An update fixing the endpoint replaces the job while the old one is still fetching
+	broken, fetching, release := blocking()
+	defer broken.Close()
+	pool.ProcessRequestDoc(ctx, doc(65, broken.URL, enabled), uint64(time.Now().Unix()))
+	<-fetching
+	pool.ProcessRequestDoc(ctx, doc(65, fixed.URL, enabled), uint64(time.Now().Unix()))
+	select {
+	case result := <-pool.Results():
+		p.Require().Equal("42", result.Data)
+	case <-time.After(5 * time.Second):
+		p.FailNow("timeout waiting for result")
+	}
+
+	// The old task's failure neither counts against nor overwrites the new job
+	close(release)
+	p.Require().Eventually(func() bool { return len(pool.Jobs()[0].History) == 2 }, 5*time.Second, 10*time.Millisecond)
+	p.Require().Never(func() bool {
+		state := pool.Jobs()[0]
+		return state.URL != fixed.URL || state.ConsecutiveFailures != 0 || state.Nonce != 2
+	}, 200*time.Millisecond, 10*time.Millisecond)
+
+	// A request disabled while its fetch is failing is not scheduled again by the old task
+	broken, fetching, release = blocking()
+	defer broken.Close()
+	pool.ProcessRequestDoc(ctx, doc(66, broken.URL, enabled), uint64(time.Now().Unix()))
+	<-fetching
+	pool.ProcessRequestDoc(ctx, doc(66, broken.URL, oracletypes.RequestStatus_REQUEST_STATUS_DISABLED), uint64(time.Now().Unix()))
+	close(release)
+	p.Require().Never(func() bool { return pool.JobCount() != 1 }, 300*time.Millisecond, 10*time.Millisecond)
+}
+
+func (p *PoolTestSuite) TestBackupDelay() {
+	p.T().Log("testing leader election order")
+
+	accounts := make([]string, 5)
+	for i := range accounts {
+		accounts[i] = sdk.AccAddress([]byte{byte(i + 1), 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}).String()
+	}
+	job := &ctypes.OracleJob{ID: 47, Accounts: accounts, Primaries: 2}
+	step := 10 * time.Second
+
+	// Every round has two primaries and backups that step in one after another
+	primaries := map[string]bool{}
+	for nonce := uint64(1); nonce <= 20; nonce++ {
+		var delays []time.Duration
+		for _, account := range accounts {
+			delay := backupDelay(job, nonce, account, step)
+			p.Require().Equal(delay, backupDelay(job, nonce, account, step))
+			if delay == 0 {
+				primaries[account] = true
+			}
+			delays = append(delays, delay)
+		}
+		slices.Sort(delays)
+		p.Require().Equal([]time.Duration{0, 0, step, 2 * step, 3 * step}, delays)
+	}
+	// The primaries rotate between rounds
+	p.Require().Greater(len(primaries), 2)
+
+	// An account outside the list is never held back
+	p.Require().Zero(backupDelay(job, 1, "guru1unknown", step))
+
+	doc := oracletypes.OracleRequestDoc{AccountList: accounts, Quorum: 3}
+	p.Require().Equal(3, primaryCount(doc))
+	doc.QuorumMode = oracletypes.QuorumMode_QUORUM_MODE_STAKE_WEIGHTED
+	p.Require().Equal(5, primaryCount(doc))
+	doc = oracletypes.OracleRequestDoc{AccountList: accounts, Quorum: 3, CoverageMode: oracletypes.CoverageMode_COVERAGE_MODE_FULL}
+	p.Require().Equal(5, primaryCount(doc))
+}
+
+func (p *PoolTestSuite) TestShutdownUnderLoad() {
+	p.T().Log("testing repeated shutdown under load")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"price": "42"}`))
+	}))
+	defer server.Close()
+
+	for i := 0; i < 10; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		pool := New(ctx, log.NewNopLogger(), nil)
+
+		// Schedule more jobs than the results buffer holds and read only a few results
+		go func() {
+			for id := uint64(1); id <= uint64(config.ChannelSize()+64); id++ {
+				job := &ctypes.OracleJob{ID: id, URL: server.URL, Path: "price", Status: oracletypes.RequestStatus_REQUEST_STATUS_ENABLED, History: ctypes.NewExecutionHistory()}
+				pool.replaceJob(job)
+				pool.executeJob(ctx, job)
+			}
+		}()
+		<-pool.Results()
+		cancel()
+
+		done := make(chan struct{})
+		go func() {
+			pool.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			p.FailNow("worker pool did not shut down")
+		}
+
+		// The results channel is closed once the pool stopped
+		for range pool.Results() {
+		}
+	}
+}
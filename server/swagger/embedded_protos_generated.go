@@ -2,12 +2,99 @@
 
 package swagger
 
+// feepolicyTxProto contains the content of ../../proto/guru/feepolicy/v1/tx.proto
+const feepolicyTxProto = `syntax = "proto3";
+
+package guru.feepolicy.v1;
+
+option go_package = "github.com/gurufinglobal/guru/v2/x/feepolicy/types";
+
+import "guru/feepolicy/v1/feepolicy.proto";
+import "gogoproto/gogo.proto";
+import "google/api/annotations.proto";
+import "cosmos/base/v1beta1/coin.proto";
+import "cosmos/msg/v1/msg.proto";
+import "cosmos_proto/cosmos.proto";
+
+// Msg defines the feepolicy Msg service.
+service Msg {
+  option (cosmos.msg.v1.service) = true;
+  rpc RegisterDiscounts(MsgRegisterDiscounts) returns (MsgRegisterDiscountsResponse) {
+    option (google.api.http) = {
+      post: "/guru/feepolicy/v1/register_discounts"
+      body: "*"
+    };
+  }
+  rpc RemoveDiscounts(MsgRemoveDiscounts) returns (MsgRemoveDiscountsResponse) {
+    option (google.api.http) = {
+      post: "/guru/feepolicy/v1/remove_discounts"
+      body: "*"
+    };
+  }
+  rpc ChangeModerator(MsgChangeModerator) returns (MsgChangeModeratorResponse) {
+    option (google.api.http) = {
+      post: "/guru/feepolicy/v1/change_moderator"
+      body: "*"
+    };
+  }
+}
+
+message MsgRegisterDiscounts {
+  option (cosmos.msg.v1.signer) = "moderator_address";
+  option (gogoproto.equal)           = false;
+  option (gogoproto.goproto_getters) = false;
+
+  string   moderator_address                 = 1 
+      [(cosmos_proto.scalar) = "cosmos.AddressString"];
+  repeated AccountDiscount discounts = 2 [
+    (gogoproto.nullable) = false
+  ];
+}
+
+message MsgRegisterDiscountsResponse{
+}
+
+message MsgRemoveDiscounts {
+  option (cosmos.msg.v1.signer) = "moderator_address";
+  option (gogoproto.equal)           = false;
+  option (gogoproto.goproto_getters) = false;
+
+  string   moderator_address                 = 1 
+      [(cosmos_proto.scalar) = "cosmos.AddressString"];
+  
+  string address = 2;
+  string module = 3;
+  string msg_type = 4;
+}
+
+message MsgRemoveDiscountsResponse{
+}
+
+
+// msg declaration for changing the moderator.
+message MsgChangeModerator {
+  option (cosmos.msg.v1.signer) = "moderator_address";
+
+  option (gogoproto.equal)           = false;
+  option (gogoproto.goproto_getters) = false;
+
+  string   moderator_address                 = 1 
+      [(cosmos_proto.scalar) = "cosmos.AddressString"];
+  string   new_moderator_address     = 2 
+      [(cosmos_proto.scalar) = "cosmos.AddressString"];
+}
+
+// Response type for the Msg/ChangeModerator.
+message MsgChangeModeratorResponse {
+}`
+
 // oracleQueryProto contains the content of ../../proto/guru/oracle/v1/query.proto
 const oracleQueryProto = `syntax = "proto3";
 package guru.oracle.v1;
 
 import "gogoproto/gogo.proto";
 import "google/api/annotations.proto";
+import "cosmos/base/query/v1beta1/pagination.proto";
 import "guru/oracle/v1/oracle.proto";
 import "guru/oracle/v1/genesis.proto";
 
@@ -22,7 +109,10 @@ service Query {
   
   // OracleSubmitData queries oracle data by ID
   rpc OracleSubmitData(QueryOracleSubmitDataRequest) returns (QueryOracleSubmitDataResponse) {
-    option (google.api.http).get = "/guru/oracle/v1/submit_data/{request_id}/{nonce}/{provider}";
+    option (google.api.http) = {
+      get: "/guru/oracle/v1/submit_data/{request_id}/{nonce}/{provider}"
+      additional_bindings {get: "/guru/oracle/v1/submit_data/{request_id}/{nonce}"}
+    };
   }
 
   // OracleData queries oracle data by ID
@@ -44,6 +134,34 @@ service Query {
   rpc ModeratorAddress(QueryModeratorAddressRequest) returns (QueryModeratorAddressResponse) {
     option (google.api.http).get = "/guru/oracle/v1/moderator";
   }
+
+  // RequestsByAccount queries the oracle request documents that list an account as provider
+  rpc RequestsByAccount(QueryRequestsByAccountRequest) returns (QueryRequestsByAccountResponse) {
+    option (google.api.http).get = "/guru/oracle/v1/requests_by_account/{account}";
+  }
+
+  // PendingRound queries the reports received so far for a round that has not finalized
+  rpc PendingRound(QueryPendingRoundRequest) returns (QueryPendingRoundResponse) {
+    option (google.api.http) = {
+      get: "/guru/oracle/v1/pending_round/{request_id}/{nonce}"
+      additional_bindings {get: "/guru/oracle/v1/pending_round/{request_id}"}
+    };
+  }
+
+  // LatestResults queries the latest finalized result of several requests in one call
+  rpc LatestResults(QueryLatestResultsRequest) returns (QueryLatestResultsResponse) {
+    option (google.api.http).get = "/guru/oracle/v1/latest_results";
+  }
+
+  // ModuleState queries the params, the moderator address and the request counts in one call
+  rpc ModuleState(QueryModuleStateRequest) returns (QueryModuleStateResponse) {
+    option (google.api.http).get = "/guru/oracle/v1/module_state";
+  }
+
+  // RoundTiming queries the moving averages of how long the rounds of a request take to finalize
+  rpc RoundTiming(QueryRoundTimingRequest) returns (QueryRoundTimingResponse) {
+    option (google.api.http).get = "/guru/oracle/v1/round_timing/{request_id}";
+  }
 }
 
 // QueryParamsRequest is request type for the Query/Params RPC method
@@ -61,12 +179,16 @@ message QueryOracleSubmitDataRequest {
   uint64 request_id = 1;
   uint64 nonce = 2;
   string provider = 3;
+  // pagination defines an optional pagination for the request; ignored when provider is set
+  cosmos.base.query.v1beta1.PageRequest pagination = 4;
 }
 
 // QueryOracleSubmitDataResponse is response type for the Query/OracleSubmitData RPC method
 message QueryOracleSubmitDataResponse {
   // submit_datas is the list of oracle submit data for the requested ID, nonce and provider
   repeated SubmitDataSet submit_datas = 1;
+  // pagination defines the pagination in the response
+  cosmos.base.query.v1beta1.PageResponse pagination = 2;
 }
 
 // QueryOracleDataRequest is request type for the Query/OracleData RPC method
@@ -111,6 +233,87 @@ message QueryModeratorAddressResponse {
   // moderator_address is the address of the moderator
   string moderator_address = 1;
 }
+
+// QueryRequestsByAccountRequest is request type for the Query/RequestsByAccount RPC method
+message QueryRequestsByAccountRequest {
+  // account is the provider address to look up
+  string account = 1;
+  // pagination defines an optional pagination for the request
+  cosmos.base.query.v1beta1.PageRequest pagination = 2;
+}
+
+// QueryRequestsByAccountResponse is response type for the Query/RequestsByAccount RPC method
+message QueryRequestsByAccountResponse {
+  // request_docs are the request documents whose account list contains the account, ordered by request id
+  repeated OracleRequestDoc request_docs = 1 [(gogoproto.nullable) = false];
+  // pagination defines the pagination in the response
+  cosmos.base.query.v1beta1.PageResponse pagination = 2;
+}
+
+// QueryPendingRoundRequest is request type for the Query/PendingRound RPC method
+message QueryPendingRoundRequest {
+  // request_id is the unique identifier of the oracle request
+  uint64 request_id = 1;
+  // nonce is the round to inspect; zero selects the round currently collecting reports
+  uint64 nonce = 2;
+}
+
+// QueryPendingRoundResponse is response type for the Query/PendingRound RPC method
+message QueryPendingRoundResponse {
+  uint64 request_id = 1;
+  uint64 nonce = 2;
+  // reports is the number of submissions received for the round
+  uint32 reports = 3;
+  // accepted is the number of reports left after excluding magnitude outliers
+  uint32 accepted = 4;
+  // quorum is the number of accepted reports the round needs to finalize
+  uint32 quorum = 5;
+  // provisional_value is the aggregate of the accepted reports so far; empty without reports
+  string provisional_value = 6;
+  // quorum_met reports whether the accepted reports already satisfy the quorum, including
+  // stake-weighted quorums
+  bool quorum_met = 7;
+}
+
+// QueryLatestResultsRequest is request type for the Query/LatestResults RPC method
+message QueryLatestResultsRequest {
+  // request_ids are the requests to look up, at most MaxLatestResultsRequestIds per query
+  repeated uint64 request_ids = 1;
+}
+
+// QueryLatestResultsResponse is response type for the Query/LatestResults RPC method
+message QueryLatestResultsResponse {
+  // results maps each request id to its latest data set; requests without a finalized round are omitted
+  map<uint64, DataSet> results = 1;
+}
+
+// QueryModuleStateRequest is request type for the Query/ModuleState RPC method
+message QueryModuleStateRequest {}
+
+// QueryModuleStateResponse is response type for the Query/ModuleState RPC method
+message QueryModuleStateResponse {
+  // params holds all the parameters of this module
+  Params params = 1 [(gogoproto.nullable) = false];
+  // moderator_address is the address of the moderator
+  string moderator_address = 2;
+  // request_count is the number of registered request documents
+  uint64 request_count = 3;
+  // enabled_request_count is the number of request documents that are enabled
+  uint64 enabled_request_count = 4;
+}
+
+// QueryRoundTimingRequest is request type for the Query/RoundTiming RPC method
+message QueryRoundTimingRequest {
+  // request_id is the unique identifier of the oracle request
+  uint64 request_id = 1;
+}
+
+// QueryRoundTimingResponse is response type for the Query/RoundTiming RPC method
+message QueryRoundTimingResponse {
+  // round_timing holds the statistics; samples is zero until a round finalizes after the
+  // request was registered or last updated
+  RoundTiming round_timing = 1 [(gogoproto.nullable) = false];
+}
 `
 
 // oracleTxProto contains the content of ../../proto/guru/oracle/v1/tx.proto
@@ -163,6 +366,14 @@ service Msg {
     };
   }
 
+  // CancelRound defines a method for the moderator to abort the open round of a request
+  rpc CancelRound(MsgCancelRound) returns (MsgCancelRoundResponse) {
+    option (google.api.http) = {
+      post: "/guru/oracle/v1/cancel_round"
+      body: "*"
+    };
+  }
+
   // UpdateParams defines a governance operation for updating the oracle module parameters
   rpc UpdateParams(MsgUpdateParams) returns (MsgUpdateParamsResponse) {
     option (google.api.http) = {
@@ -247,6 +458,29 @@ message MsgUpdateModeratorAddress {
 // MsgUpdateModeratorAddressResponse defines the Msg/UpdateModeratorAddress response type
 message MsgUpdateModeratorAddressResponse {}
 
+// MsgCancelRound represents a message to abort the open round of a request.
+// The round's reports are deleted and the nonce advances without a result.
+message MsgCancelRound {
+  option (cosmos.msg.v1.signer) = "moderator_address";
+
+  option (gogoproto.equal)           = false;
+  option (gogoproto.goproto_getters) = false;
+
+  string moderator_address = 1
+      [(cosmos_proto.scalar) = "cosmos.AddressString"];
+
+  // ID of the request whose round is cancelled
+  uint64 request_id = 2;
+  // Nonce of the open round, one above the request's current nonce
+  uint64 nonce = 3;
+}
+
+// MsgCancelRoundResponse defines the Msg/CancelRound response type
+message MsgCancelRoundResponse {
+  // Number of reports deleted with the round
+  uint64 cleared_reports = 1;
+}
+
 // MsgUpdateParams defines a Msg for updating the oracle module parameters
 message MsgUpdateParams {
   option (cosmos.msg.v1.signer) = "authority";
@@ -331,89 +565,3 @@ message QueryDiscountResponse {
 }
 `
 
-// feepolicyTxProto contains the content of ../../proto/guru/feepolicy/v1/tx.proto
-const feepolicyTxProto = `syntax = "proto3";
-
-package guru.feepolicy.v1;
-
-option go_package = "github.com/gurufinglobal/guru/v2/x/feepolicy/types";
-
-import "guru/feepolicy/v1/feepolicy.proto";
-import "gogoproto/gogo.proto";
-import "google/api/annotations.proto";
-import "cosmos/base/v1beta1/coin.proto";
-import "cosmos/msg/v1/msg.proto";
-import "cosmos_proto/cosmos.proto";
-
-// Msg defines the feepolicy Msg service.
-service Msg {
-  option (cosmos.msg.v1.service) = true;
-  rpc RegisterDiscounts(MsgRegisterDiscounts) returns (MsgRegisterDiscountsResponse) {
-    option (google.api.http) = {
-      post: "/guru/feepolicy/v1/register_discounts"
-      body: "*"
-    };
-  }
-  rpc RemoveDiscounts(MsgRemoveDiscounts) returns (MsgRemoveDiscountsResponse) {
-    option (google.api.http) = {
-      post: "/guru/feepolicy/v1/remove_discounts"
-      body: "*"
-    };
-  }
-  rpc ChangeModerator(MsgChangeModerator) returns (MsgChangeModeratorResponse) {
-    option (google.api.http) = {
-      post: "/guru/feepolicy/v1/change_moderator"
-      body: "*"
-    };
-  }
-}
-
-message MsgRegisterDiscounts {
-  option (cosmos.msg.v1.signer) = "moderator_address";
-  option (gogoproto.equal)           = false;
-  option (gogoproto.goproto_getters) = false;
-
-  string   moderator_address                 = 1 
-      [(cosmos_proto.scalar) = "cosmos.AddressString"];
-  repeated AccountDiscount discounts = 2 [
-    (gogoproto.nullable) = false
-  ];
-}
-
-message MsgRegisterDiscountsResponse{
-}
-
-message MsgRemoveDiscounts {
-  option (cosmos.msg.v1.signer) = "moderator_address";
-  option (gogoproto.equal)           = false;
-  option (gogoproto.goproto_getters) = false;
-
-  string   moderator_address                 = 1 
-      [(cosmos_proto.scalar) = "cosmos.AddressString"];
-  
-  string address = 2;
-  string module = 3;
-  string msg_type = 4;
-}
-
-message MsgRemoveDiscountsResponse{
-}
-
-
-// msg declaration for changing the moderator.
-message MsgChangeModerator {
-  option (cosmos.msg.v1.signer) = "moderator_address";
-
-  option (gogoproto.equal)           = false;
-  option (gogoproto.goproto_getters) = false;
-
-  string   moderator_address                 = 1 
-      [(cosmos_proto.scalar) = "cosmos.AddressString"];
-  string   new_moderator_address     = 2 
-      [(cosmos_proto.scalar) = "cosmos.AddressString"];
-}
-
-// Response type for the Msg/ChangeModerator.
-message MsgChangeModeratorResponse {
-}`
-
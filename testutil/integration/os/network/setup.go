@@ -14,6 +14,7 @@ import (
 	cosmosevmtypes "github.com/gurufinglobal/guru/v2/types"
 	erc20types "github.com/gurufinglobal/guru/v2/x/erc20/types"
 	feemarkettypes "github.com/gurufinglobal/guru/v2/x/feemarket/types"
+	oracletypes "github.com/gurufinglobal/guru/v2/x/oracle/types"
 	evmtypes "github.com/gurufinglobal/guru/v2/x/vm/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
@@ -63,6 +64,7 @@ var genesisSetupFunctions = map[string]genSetupFn{
 	feemarkettypes.ModuleName: genStateSetter[*feemarkettypes.GenesisState](feemarkettypes.ModuleName),
 	distrtypes.ModuleName:     genStateSetter[*distrtypes.GenesisState](distrtypes.ModuleName),
 	minttypes.ModuleName:      genStateSetter[*minttypes.GenesisState](minttypes.ModuleName),
+	oracletypes.ModuleName:    genStateSetter[*oracletypes.GenesisState](oracletypes.ModuleName),
 	banktypes.ModuleName:      setBankGenesisState,
 	authtypes.ModuleName:      setAuthGenesisState,
 	consensustypes.ModuleName: func(_ *exampleapp.EVMD, genesisState cosmosevmtypes.GenesisState, _ interface{}) (cosmosevmtypes.GenesisState, error) {
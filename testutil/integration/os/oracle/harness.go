@@ -0,0 +1,134 @@
+package oracle
+
+import (
+	"fmt"
+
+	commonfactory "github.com/gurufinglobal/guru/v2/testutil/integration/common/factory"
+	"github.com/gurufinglobal/guru/v2/testutil/integration/os/factory"
+	"github.com/gurufinglobal/guru/v2/testutil/integration/os/keyring"
+	"github.com/gurufinglobal/guru/v2/testutil/integration/os/network"
+	"github.com/gurufinglobal/guru/v2/x/oracle/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Harness drives oracle rounds on a unit test network the way oracle daemons would.
+// It registers request documents as the moderator, signs and submits reports for
+// keyring accounts and finalizes rounds by producing blocks, so tests can assert how
+// other modules consume the results.
+//
+// Every request and report is committed in its own block through the tx factory,
+// so it passes the ante handler and message validation like a real transaction.
+type Harness struct {
+	network   *network.UnitTestNetwork
+	factory   factory.TxFactory
+	keyring   keyring.Keyring
+	moderator int
+}
+
+// NewHarness returns a harness that registers requests as the keyring account at index moderator.
+// The network must have been started with that account as moderator, see Genesis.
+func NewHarness(nw *network.UnitTestNetwork, tf factory.TxFactory, kr keyring.Keyring, moderator int) *Harness {
+	return &Harness{
+		network:   nw,
+		factory:   tf,
+		keyring:   kr,
+		moderator: moderator,
+	}
+}
+
+// Genesis returns the oracle genesis state making the keyring account at index moderator
+// the moderator. Pass it as the oracle entry of network.WithCustomGenesis.
+func Genesis(kr keyring.Keyring, moderator int) *types.GenesisState {
+	genesis := types.DefaultGenesisState()
+	genesis.ModeratorAddress = kr.GetAccAddr(moderator).String()
+	return genesis
+}
+
+// Provider returns the bech32 address of the keyring account at index, for use in account lists
+func (h *Harness) Provider(index int) string {
+	return h.keyring.GetAccAddr(index).String()
+}
+
+// Register registers doc and returns the id of the new request
+func (h *Harness) Register(doc types.OracleRequestDoc) (uint64, error) {
+	msg := &types.MsgRegisterOracleRequestDoc{
+		ModeratorAddress: h.Provider(h.moderator),
+		RequestDoc:       doc,
+	}
+	if err := h.commit(h.moderator, msg); err != nil {
+		return 0, err
+	}
+
+	return h.network.App.OracleKeeper.GetOracleRequestDocCount(h.network.GetContext()), nil
+}
+
+// Report signs rawData for the current round of the request as the keyring account at index provider
+// and submits it
+func (h *Harness) Report(requestID uint64, provider int, rawData string) error {
+	ctx := h.network.GetContext()
+	doc, err := h.network.App.OracleKeeper.GetOracleRequestDoc(ctx, requestID)
+	if err != nil {
+		return err
+	}
+
+	dataSet := &types.SubmitDataSet{
+		RequestId: requestID,
+		Nonce:     doc.Nonce + 1,
+		RawData:   rawData,
+		Provider:  h.Provider(provider),
+	}
+	signBytes, err := dataSet.Bytes(ctx.ChainID())
+	if err != nil {
+		return err
+	}
+	if dataSet.Signature, err = h.keyring.Sign(provider, signBytes); err != nil {
+		return err
+	}
+
+	return h.commit(provider, &types.MsgSubmitOracleData{
+		AuthorityAddress: dataSet.Provider,
+		DataSet:          dataSet,
+	})
+}
+
+// Finalize produces a block so the current round of the request is aggregated and returns its result.
+// It fails if the round did not complete, e.g. because the quorum was not met.
+func (h *Harness) Finalize(requestID uint64) (*types.DataSet, error) {
+	doc, err := h.network.App.OracleKeeper.GetOracleRequestDoc(h.network.GetContext(), requestID)
+	if err != nil {
+		return nil, err
+	}
+	nonce := doc.Nonce + 1
+
+	if err := h.network.NextBlock(); err != nil {
+		return nil, err
+	}
+
+	dataSet, err := h.network.App.OracleKeeper.GetDataSet(h.network.GetContext(), requestID, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("round %d of request %d did not finalize: %w", nonce, requestID, err)
+	}
+	return dataSet, nil
+}
+
+// commit signs msg as the keyring account at index signer and includes it in a new block
+func (h *Harness) commit(signer int, msg sdktypes.Msg) error {
+	res, err := h.factory.CommitCosmosTx(h.keyring.GetPrivKey(signer), commonfactory.CosmosTxArgs{
+		Msgs: []sdktypes.Msg{msg},
+	})
+	if err != nil {
+		return err
+	}
+	return checkTxResult(res)
+}
+
+// checkTxResult turns a failed transaction result into an error
+func checkTxResult(res abcitypes.ExecTxResult) error {
+	if res.IsOK() {
+		return nil
+	}
+	return fmt.Errorf("tx failed with code %d (%s): %s", res.Code, res.Codespace, res.Log)
+}
@@ -23,11 +23,12 @@ func (k Keeper) AfterOracleEnd(ctx sdk.Context, dataSet oracletypes.DataSet) {
 	}
 
 	// newMinGasPrice = gasPriceAdjustmentFactor / dataSet.RawData
-	rawDataDec, err := math.LegacyNewDecFromStr(dataSet.RawData)
+	rawDataValue, err := oracletypes.ParseOracleValue(dataSet.RawData)
 	if err != nil {
 		logger.Error("Failed to parse oracle raw data as decimal", "rawData", dataSet.RawData, "error", err)
 		return
 	}
+	rawDataDec := rawDataValue.Dec()
 
 	if rawDataDec.IsZero() {
 		logger.Error("Oracle raw data is zero, cannot divide", "rawData", dataSet.RawData)
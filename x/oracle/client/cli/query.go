@@ -31,6 +31,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryOracleSubmitData(),
 		GetCmdQueryOracleRequestDocs(),
 		GetCmdQueryModeratorAddress(),
+		GetCmdQueryRequestsByAccount(),
+		GetCmdQueryPendingRound(),
+		GetCmdQueryLatestResults(),
+		GetCmdQueryModuleState(),
+		GetCmdQueryRoundTiming(),
 	)
 
 	return cmd
@@ -143,7 +148,8 @@ $ %s query oracle submit-data 1 1 provider-account
 
 Description:
 - By default, shows all submissions for the given [request-id] and [nonce]
-- Optional [provider-account] parameter filters results to show only submissions from that account`,
+- Optional [provider-account] parameter filters results to show only submissions from that account
+- Without a provider, results are paginated (see --limit, --page-key)`,
 			version.AppName, version.AppName)),
 		Args: cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -169,10 +175,16 @@ Description:
 				provider = args[2]
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.OracleSubmitData(cmd.Context(), &types.QueryOracleSubmitDataRequest{
-				RequestId: requestId,
-				Nonce:     nonce,
-				Provider:  provider,
+				RequestId:  requestId,
+				Nonce:      nonce,
+				Provider:   provider,
+				Pagination: pageReq,
 			})
 			if err != nil {
 				return err
@@ -183,6 +195,7 @@ Description:
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "submit-data")
 	return cmd
 }
 
@@ -247,3 +260,194 @@ func GetCmdQueryModeratorAddress() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRequestsByAccount implements the query requests-by-account command
+func GetCmdQueryRequestsByAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "requests-by-account [account]",
+		Short: "Query the oracle requests an account provides data for",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the oracle request documents whose account list contains [account].
+
+Example:
+$ %s query oracle requests-by-account guru1...`,
+			version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RequestsByAccount(cmd.Context(), &types.QueryRequestsByAccountRequest{
+				Account:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "requests-by-account")
+	return cmd
+}
+
+// GetCmdQueryPendingRound implements the query pending-round command
+func GetCmdQueryPendingRound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-round [request-id] [nonce]",
+		Short: "Query the reports received so far for a round that has not finalized",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the report count, quorum and provisional value of the round currently collecting reports.
+
+Example:
+$ %s query oracle pending-round 1
+$ %s query oracle pending-round 1 5`,
+			version.AppName, version.AppName)),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			requestId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrapf(types.ErrInvalidRequestId, "args[0] parse error: %s", args[0])
+			}
+
+			var nonce uint64
+			if len(args) > 1 {
+				nonce, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid nonce: %w", err)
+				}
+			}
+
+			res, err := queryClient.PendingRound(cmd.Context(), &types.QueryPendingRoundRequest{
+				RequestId: requestId,
+				Nonce:     nonce,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryLatestResults implements the query latest-results command
+func GetCmdQueryLatestResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "latest-results [request-id]...",
+		Short: "Query the latest result of several oracle requests",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the latest finalized data set of up to %d requests in one call.
+
+Example:
+$ %s query oracle latest-results 1 2 3`,
+			types.MaxLatestResultsRequestIds, version.AppName)),
+		Args: cobra.RangeArgs(1, types.MaxLatestResultsRequestIds),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			requestIds := make([]uint64, len(args))
+			for i, arg := range args {
+				requestIds[i], err = strconv.ParseUint(arg, 10, 64)
+				if err != nil {
+					return errorsmod.Wrapf(types.ErrInvalidRequestId, "args[%d] parse error: %s", i, arg)
+				}
+			}
+
+			res, err := queryClient.LatestResults(cmd.Context(), &types.QueryLatestResultsRequest{
+				RequestIds: requestIds,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryModuleState implements the query module-state command
+func GetCmdQueryModuleState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-state",
+		Short: "Query the params, the moderator address and the request counts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ModuleState(cmd.Context(), &types.QueryModuleStateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRoundTiming implements the round timing query command
+func GetCmdQueryRoundTiming() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "round-timing [request-id]",
+		Short: "Query the moving averages of how long the rounds of a request take to finalize",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			requestId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrapf(types.ErrInvalidRequestId, "args[0] parse error: %s", args[0])
+			}
+
+			res, err := queryClient.RoundTiming(cmd.Context(), &types.QueryRoundTimingRequest{
+				RequestId: requestId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -33,25 +34,67 @@ func GetTxCmd() *cobra.Command {
 		NewUpdateOracleRequestDocCmd(),
 		NewSubmitOracleDataCmd(),
 		NewUpdateModeratorAddressCmd(),
+		NewCancelRoundCmd(),
 		NewUpdateParamsCmd(),
 	)
 
 	return cmd
 }
 
+const (
+	FlagFromFile         = "from-file"
+	FlagGenerateTemplate = "generate-template"
+)
+
 // NewRegisterOracleRequestDocCmd implements the register oracle request document command
 func NewRegisterOracleRequestDocCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "register-request [path/to/request-doc.json]",
-		Short: "Register a new oracle request document",
-		Args:  cobra.ExactArgs(1),
+		Use:     "register-request [path/to/request-doc.json]",
+		Aliases: []string{"register-doc"},
+		Short:   "Register a new oracle request document",
+		Long: `Register a new oracle request document from a JSON file.
+The document is validated before the transaction is built.
+
+Example:
+  # Write a skeleton request document and edit it
+  gurud tx oracle register-request --generate-template > request.json
+
+  # Register the request
+  gurud tx oracle register-request --from-file request.json --from mykey`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientTxContext(cmd)
+			generateTemplate, err := cmd.Flags().GetBool(FlagGenerateTemplate)
 			if err != nil {
 				return err
 			}
+			if generateTemplate {
+				template, err := json.MarshalIndent(requestDocTemplate(), "", "  ")
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(template))
+				return err
+			}
 
-			requestDoc, err := parseRequestDocJson(args[0])
+			path, err := cmd.Flags().GetString(FlagFromFile)
+			if err != nil {
+				return err
+			}
+			switch {
+			case len(args) == 1 && path != "":
+				return fmt.Errorf("request document path given both as argument and --%s", FlagFromFile)
+			case len(args) == 1:
+				path = args[0]
+			case path == "":
+				return fmt.Errorf("request document path is required, pass it as argument or with --%s", FlagFromFile)
+			}
+
+			requestDoc, err := parseRequestDocJson(path)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
@@ -60,11 +103,16 @@ func NewRegisterOracleRequestDocCmd() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				*requestDoc,
 			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagFromFile, "", "Path to the request document JSON file")
+	cmd.Flags().Bool(FlagGenerateTemplate, false, "Print a skeleton request document JSON and exit")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -137,7 +185,7 @@ func NewSubmitOracleDataCmd() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 			)
 
-			dataset, err := msg.DataSet.Bytes()
+			dataset, err := msg.DataSet.Bytes(clientCtx.ChainID)
 			if err != nil {
 				return err
 			}
@@ -185,6 +233,48 @@ func NewUpdateModeratorAddressCmd() *cobra.Command {
 	return cmd
 }
 
+// NewCancelRoundCmd implements the cancel round command
+func NewCancelRoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-round [request-id] [nonce]",
+		Short: "Cancel the open round of a request without a result",
+		Long: `Cancel the open round of a request. The round's reports are deleted and the
+request nonce advances without a result, so providers start the next round fresh.
+The nonce must be the open round, one above the request's current nonce. Only the
+moderator can cancel a round.
+
+Example:
+  gurud tx oracle cancel-round 1 42 --from moderator`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			requestId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(errortypes.ErrInvalidRequest, "request id is not a valid uint64")
+			}
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(errortypes.ErrInvalidRequest, "nonce is not a valid uint64")
+			}
+
+			msg := types.NewMsgCancelRound(clientCtx.GetFromAddress().String(), requestId, nonce)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func parseRequestDocJson(path string) (*types.OracleRequestDoc, error) {
 	var doc types.OracleRequestDoc
 
@@ -193,14 +283,34 @@ func parseRequestDocJson(path string) (*types.OracleRequestDoc, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(contents, &doc)
-	if err != nil {
-		return nil, err
+	decoder := json.NewDecoder(bytes.NewReader(contents))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid request document %s: %w", path, err)
 	}
 
 	return &doc, nil
 }
 
+// requestDocTemplate returns a skeleton request document for --generate-template.
+// request_id is assigned by the chain and therefore omitted.
+func requestDocTemplate() types.OracleRequestDoc {
+	return types.OracleRequestDoc{
+		OracleType:  types.OracleType_ORACLE_TYPE_CRYPTO,
+		Name:        "BTC/USD Price Oracle",
+		Description: "Provides BTC/USD price data from multiple sources",
+		Period:      60,
+		AccountList: []string{"guru1...", "guru1...", "guru1..."},
+		Quorum:      2,
+		Endpoints: []*types.OracleEndpoint{
+			{Url: "https://api.example.com/v1/prices/BTC-USD", ParseRule: "data.amount"},
+			{Url: "https://api.example.org/v1/ticker/BTCUSD", ParseRule: "price"},
+		},
+		AggregationRule: types.AggregationRule_AGGREGATION_RULE_MEDIAN,
+		Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+	}
+}
+
 // NewUpdateParamsCmd implements the update oracle parameters command for governance proposals
 func NewUpdateParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/log"
 
 	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmdb "github.com/cosmos/cosmos-db"
@@ -26,7 +27,7 @@ func setupTest(t *testing.T) (sdk.Context, *keeper.Keeper) {
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
 	db := tmdb.NewMemDB()
-	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), nil)
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
 	err := stateStore.LoadLatestVersion()
@@ -35,7 +36,7 @@ func setupTest(t *testing.T) (sdk.Context, *keeper.Keeper) {
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
 
-	k := keeper.NewKeeper(cdc, storeKey, "cosmos1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft", nil)
+	k := keeper.NewKeeper(cdc, storeKey, "cosmos1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft", nil, nil)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{ChainID: "test-chain"}, false, log.NewNopLogger())
 
@@ -27,6 +27,9 @@ func NewHandler(msgServer types.MsgServer) func(ctx sdk.Context, msg sdk.Msg) (*
 		case *types.MsgUpdateModeratorAddress:
 			res, err := msgServer.UpdateModeratorAddress(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelRound:
+			res, err := msgServer.CancelRound(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			err := errorsmod.Wrapf(errortypes.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, err
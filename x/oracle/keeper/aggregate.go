@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"fmt"
-	"math"
 	"math/big"
+	"slices"
 	"sort"
+	"strings"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/gurufinglobal/guru/v2/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -43,28 +45,44 @@ func (k Keeper) ProcessOracleDataSetAggregation(ctx sdk.Context) {
 			continue
 		}
 
-		// Check if we have enough submissions (quorum)
-		if uint32(len(submitDatas)) < doc.Quorum {
-			k.Logger(ctx).Info(fmt.Sprintf("insufficient submissions for request_id %d, nonce %d: got %d, need %d",
-				doc.RequestId, nextNonce, len(submitDatas), doc.Quorum))
+		// Drop submissions that look like unit mismatches before aggregating. The filter only runs, and
+		// only logs exclusions, once enough providers reported; until then the round is not ready anyway.
+		reports := submitDatas
+		if met, _ := k.quorumMet(ctx, *doc, reports); met {
+			submitDatas = k.acceptedReports(ctx, *doc, nextNonce, reports)
+		}
+		if ready, progress := k.roundReady(ctx, *doc, reports, submitDatas); !ready {
+			k.Logger(ctx).Info(fmt.Sprintf("round not ready for request_id %d, nonce %d: %s",
+				doc.RequestId, nextNonce, progress))
 			continue
 		}
 
-		// Aggregate data based on AggregationRule
-		aggregatedValue, err := k.AggregateData(ctx, doc.AggregationRule, submitDatas)
+		// Aggregate data based on AggregationRule, falling back to FallbackRules
+		result, err := k.aggregateWithFallback(ctx, *doc, submitDatas)
 		if err != nil {
 			k.Logger(ctx).Error(fmt.Sprintf("failed to aggregate data for request_id %d: %v",
 				doc.RequestId, err))
 			continue
 		}
 
+		// The round started when the request was registered or updated, or the previous round finalized or was cancelled
+		timing := k.GetRoundTiming(ctx, doc.RequestId)
+		started := timing.RoundStartHeight != 0
+		var roundDuration int64
+		if started {
+			roundDuration = ctx.BlockTime().Unix() - int64(timing.RoundStartTime)
+		}
+
 		// Create and store DataSet
 		dataSet := types.DataSet{
 			RequestId:   doc.RequestId,
 			Nonce:       nextNonce,
 			BlockHeight: uint64(ctx.BlockHeight()),
 			BlockTime:   uint64(ctx.BlockTime().Unix()),
-			RawData:     aggregatedValue,
+			RawData:     result.value,
+
+			AggregationRule: result.rule,
+			RawAggregate:    result.raw,
 		}
 
 		// Store the data set
@@ -75,6 +93,13 @@ func (k Keeper) ProcessOracleDataSetAggregation(ctx sdk.Context) {
 			k.hooks.AfterOracleEnd(ctx, dataSet)
 		}
 
+		// Fold the round into the timing statistics unless no start was recorded, and start the next round
+		if started {
+			blocks := uint64(ctx.BlockHeight()) - timing.RoundStartHeight
+			timing = timing.Observe(uint64(roundDuration), blocks, uint64(ctx.BlockHeight()))
+		}
+		k.SetRoundTiming(ctx, timing.StartRound(uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())))
+
 		// Increment nonce
 		doc.Nonce = nextNonce
 		k.SetOracleRequestDoc(ctx, *doc)
@@ -82,14 +107,8 @@ func (k Keeper) ProcessOracleDataSetAggregation(ctx sdk.Context) {
 		// Emit event
 		ctx.EventManager().EmitEvents(
 			sdk.Events{
-				sdk.NewEvent(
-					types.EventTypeCompleteOracleDataSet,
-					sdk.NewAttribute(types.AttributeKeyRequestId, fmt.Sprintf("%d", doc.RequestId)),
-					sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprintf("%d", nextNonce)),
-					sdk.NewAttribute(types.AttributeKeyRawData, aggregatedValue),
-					sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
-					sdk.NewAttribute(types.AttributeKeyBlockTime, fmt.Sprintf("%d", ctx.BlockTime().Unix())),
-				),
+				newCompleteEvent(*doc, dataSet, result),
+				newRoundCompletedEvent(*doc, nextNonce, reports, submitDatas, result.value, roundDuration),
 			},
 		)
 
@@ -98,116 +117,329 @@ func (k Keeper) ProcessOracleDataSetAggregation(ctx sdk.Context) {
 	}
 }
 
-// aggregateData aggregates the submitted data based on the aggregation rule
-func (k Keeper) AggregateData(ctx sdk.Context, rule types.AggregationRule, submitDatas []*types.SubmitDataSet) (string, error) {
-	switch rule {
-	case types.AggregationRule_AGGREGATION_RULE_AVG:
-		return k.calculateAverage(submitDatas)
-	case types.AggregationRule_AGGREGATION_RULE_MIN:
-		return k.calculateMin(submitDatas)
-	case types.AggregationRule_AGGREGATION_RULE_MAX:
-		return k.calculateMax(submitDatas)
-	case types.AggregationRule_AGGREGATION_RULE_MEDIAN:
-		return k.calculateMedian(ctx, submitDatas)
-	default:
-		return "", fmt.Errorf("unsupported aggregation rule: %s", rule)
+// quorumMet reports whether the submissions satisfy the quorum of the request and describes the progress for logs.
+// A stake-weighted quorum compares the bonded tokens of the validators operated by the submitting providers
+// with those of every provider in the account list; providers that do not operate a bonded validator weigh nothing.
+func (k Keeper) quorumMet(ctx sdk.Context, doc types.OracleRequestDoc, submitDatas []*types.SubmitDataSet) (bool, string) {
+	if doc.QuorumMode == types.QuorumMode_QUORUM_MODE_TRUSTED_PROVIDER {
+		return len(submitDatas) > 0, fmt.Sprintf("got %d, need the trusted provider", len(submitDatas))
+	}
+	if doc.QuorumMode != types.QuorumMode_QUORUM_MODE_STAKE_WEIGHTED {
+		return uint32(len(submitDatas)) >= doc.Quorum, fmt.Sprintf("got %d, need %d", len(submitDatas), doc.Quorum)
 	}
-}
 
-func (k Keeper) calculateMax(submitDatas []*types.SubmitDataSet) (string, error) {
-	if len(submitDatas) == 0 {
-		return "", fmt.Errorf("no data to calculate max")
+	if k.stakingKeeper == nil {
+		return false, "stake-weighted quorum unavailable: no staking keeper"
+	}
+	fraction, err := doc.StakeQuorumFraction()
+	if err != nil {
+		return false, fmt.Sprintf("stake-weighted quorum unavailable: %v", err)
 	}
 
-	max := new(big.Float)
+	submitted := make(map[string]bool, len(submitDatas))
 	for _, data := range submitDatas {
-		value := new(big.Float)
-		if _, ok := value.SetString(data.RawData); !ok {
-			return "", fmt.Errorf("invalid decimal number in raw data: %q", data.RawData)
-		}
-		if value.Cmp(max) > 0 {
-			max = value
+		submitted[data.Provider] = true
+	}
+
+	total, reported := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for _, account := range doc.AccountList {
+		stake := k.providerStake(ctx, account)
+		total = total.Add(stake)
+		if submitted[account] {
+			reported = reported.Add(stake)
 		}
 	}
-	return max.Text('f', -1), nil
+
+	progress := fmt.Sprintf("reported stake %s of %s, need fraction %s", reported, total, fraction)
+	if !total.IsPositive() || reported.IsZero() {
+		return false, progress
+	}
+
+	return sdkmath.LegacyNewDecFromInt(reported).GTE(fraction.MulInt(total)), progress
 }
 
-func (k Keeper) calculateMin(submitDatas []*types.SubmitDataSet) (string, error) {
-	if len(submitDatas) == 0 {
-		return "", fmt.Errorf("no data to calculate min")
+// fullCoverage reports whether a round satisfies the coverage mode of the request and describes the progress for logs.
+// With COVERAGE_MODE_FULL every account in the account list must have reported and no report may have been excluded as an outlier.
+func fullCoverage(doc types.OracleRequestDoc, reports, accepted []*types.SubmitDataSet) (bool, string) {
+	if doc.CoverageMode != types.CoverageMode_COVERAGE_MODE_FULL {
+		return true, ""
 	}
 
-	min := new(big.Float).SetFloat64(math.MaxFloat64)
-	for _, data := range submitDatas {
-		value := new(big.Float)
-		if _, ok := value.SetString(data.RawData); !ok {
-			return "", fmt.Errorf("invalid decimal number in raw data: %q", data.RawData)
-		}
-		if value.Cmp(min) < 0 {
-			min = value
+	submitted := make(map[string]bool, len(reports))
+	for _, data := range reports {
+		submitted[data.Provider] = true
+	}
+
+	missing := 0
+	for _, account := range doc.AccountList {
+		if !submitted[account] {
+			missing++
 		}
 	}
-	return min.Text('f', -1), nil
+
+	excluded := len(reports) - len(accepted)
+	return missing == 0 && excluded == 0, fmt.Sprintf("%d of %d providers missing, %d excluded", missing, len(doc.AccountList), excluded)
 }
 
-// calculateAverage calculates the average of all submitted values
-func (k Keeper) calculateAverage(submitDatas []*types.SubmitDataSet) (string, error) {
-	if len(submitDatas) == 0 {
-		return "", fmt.Errorf("no data to average")
+// roundReady reports whether a round can finalize: the reports and the reports accepted by the
+// magnitude check both meet the quorum, and a full coverage request has a usable value from every
+// provider. The BeginBlocker and the PendingRound query share it so they never disagree.
+func (k Keeper) roundReady(ctx sdk.Context, doc types.OracleRequestDoc, reports, accepted []*types.SubmitDataSet) (bool, string) {
+	if met, progress := k.quorumMet(ctx, doc, reports); !met {
+		return false, "insufficient submissions: " + progress
 	}
+	if met, progress := k.quorumMet(ctx, doc, accepted); !met {
+		return false, "insufficient submissions after magnitude check: " + progress
+	}
+	if covered, progress := fullCoverage(doc, reports, accepted); !covered {
+		return false, "incomplete coverage: " + progress
+	}
+	return true, ""
+}
 
-	sum := new(big.Float)
-	for _, data := range submitDatas {
-		value := new(big.Float)
-		if _, ok := value.SetString(data.RawData); !ok {
-			return "", fmt.Errorf("invalid decimal number in raw data: %q", data.RawData)
+// providerStake returns the bonded tokens of the validator operated by a provider account, or zero
+func (k Keeper) providerStake(ctx sdk.Context, account string) sdkmath.Int {
+	addr, err := sdk.AccAddressFromBech32(account)
+	if err != nil {
+		return sdkmath.ZeroInt()
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(addr))
+	if err != nil {
+		return sdkmath.ZeroInt()
+	}
+
+	return validator.GetBondedTokens()
+}
+
+// newCompleteEvent announces the result of a round. The raw_data attribute carries the value in the
+// request's result format; RESULT_FORMAT_BOTH adds the unrounded aggregate as raw_aggregate.
+func newCompleteEvent(doc types.OracleRequestDoc, dataSet types.DataSet, result aggregation) sdk.Event {
+	rawData := result.value
+	if doc.ResultFormat == types.ResultFormat_RESULT_FORMAT_RAW {
+		rawData = result.raw
+	}
+
+	event := sdk.NewEvent(
+		types.EventTypeCompleteOracleDataSet,
+		sdk.NewAttribute(types.AttributeKeyRequestId, fmt.Sprintf("%d", dataSet.RequestId)),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprintf("%d", dataSet.Nonce)),
+		sdk.NewAttribute(types.AttributeKeyRawData, rawData),
+		sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", dataSet.BlockHeight)),
+		sdk.NewAttribute(types.AttributeKeyBlockTime, fmt.Sprintf("%d", dataSet.BlockTime)),
+	)
+	if doc.ResultFormat == types.ResultFormat_RESULT_FORMAT_BOTH {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyRawAggregate, result.raw))
+	}
+	return event
+}
+
+// newRoundCompletedEvent summarizes a finalized round: how many providers reported, which
+// reports were excluded as outliers, which assigned providers did not report, and how long
+// the round took in seconds (0 for the first round).
+func newRoundCompletedEvent(doc types.OracleRequestDoc, nonce uint64, reports, accepted []*types.SubmitDataSet, aggregated string, duration int64) sdk.Event {
+	kept := make(map[string]bool, len(accepted))
+	for _, report := range accepted {
+		kept[report.Provider] = true
+	}
+
+	var excluded, missed []string
+	submitted := make(map[string]bool, len(reports))
+	for _, report := range reports {
+		submitted[report.Provider] = true
+		if !kept[report.Provider] {
+			excluded = append(excluded, report.Provider)
+		}
+	}
+	for _, account := range doc.AccountList {
+		if !submitted[account] {
+			missed = append(missed, account)
 		}
-		sum.Add(sum, value)
 	}
 
-	avg := new(big.Float).Quo(sum, new(big.Float).SetInt64(int64(len(submitDatas))))
-	return avg.Text('f', -1), nil
+	return sdk.NewEvent(
+		types.EventTypeRoundCompleted,
+		sdk.NewAttribute(types.AttributeKeyRequestId, fmt.Sprintf("%d", doc.RequestId)),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprintf("%d", nonce)),
+		sdk.NewAttribute(types.AttributeKeyReports, fmt.Sprintf("%d", len(reports))),
+		sdk.NewAttribute(types.AttributeKeyQuorum, fmt.Sprintf("%d", doc.Quorum)),
+		sdk.NewAttribute(types.AttributeKeyRawData, aggregated),
+		sdk.NewAttribute(types.AttributeKeyExcluded, strings.Join(excluded, ",")),
+		sdk.NewAttribute(types.AttributeKeyMissed, strings.Join(missed, ",")),
+		sdk.NewAttribute(types.AttributeKeyRoundDuration, fmt.Sprintf("%d", duration)),
+	)
 }
 
-// calculateMedian calculates the median of all submitted values
-func (k Keeper) calculateMedian(ctx sdk.Context, submitDatas []*types.SubmitDataSet) (string, error) {
-	if len(submitDatas) == 0 {
-		return "", fmt.Errorf("no data to calculate median")
+// AggregateData aggregates the submitted data using the implementation registered for the rule
+// and returns the result as a canonical OracleValue string
+func (k Keeper) AggregateData(ctx sdk.Context, rule types.AggregationRule, submitDatas []*types.SubmitDataSet) (string, error) {
+	result, err := k.aggregateValues(ctx, rule, submitDatas)
+	if err != nil {
+		return "", err
 	}
+	value, err := types.OracleValueFromBigFloat(result)
+	if err != nil {
+		return "", err
+	}
+	return value.String(), nil
+}
 
-	// Get oracle parameters for safety limits
-	params := k.GetParams(ctx)
+// aggregateValues applies the rule to the submitted values and returns the result before rounding
+func (k Keeper) aggregateValues(ctx sdk.Context, rule types.AggregationRule, submitDatas []*types.SubmitDataSet) (*big.Float, error) {
+	aggregate, ok := types.GetAggregationFunc(rule)
+	weighted, isWeighted := types.GetWeightedAggregationFunc(rule)
+	if !ok && !isWeighted {
+		return nil, fmt.Errorf("unsupported aggregation rule: %s", rule)
+	}
+
+	if len(submitDatas) == 0 {
+		return nil, fmt.Errorf("no data to aggregate")
+	}
 
 	// Safety check: prevent DoS attacks with too many submissions
 	// Since each account can only submit once, max submissions = max account list size
+	params := k.GetParams(ctx)
 	if uint64(len(submitDatas)) > params.MaxAccountListSize {
-		k.Logger(ctx).Error("too many submissions for median calculation",
+		k.Logger(ctx).Error("too many submissions for aggregation",
 			"count", len(submitDatas),
 			"max_allowed", params.MaxAccountListSize)
-		return "", fmt.Errorf("too many submissions: %d, maximum allowed: %d", len(submitDatas), params.MaxAccountListSize)
+		return nil, fmt.Errorf("too many submissions: %d, maximum allowed: %d", len(submitDatas), params.MaxAccountListSize)
 	}
 
 	values := make([]*big.Float, len(submitDatas))
 	for i, data := range submitDatas {
-		values[i] = new(big.Float)
-		if _, ok := values[i].SetString(data.RawData); !ok {
-			return "", fmt.Errorf("invalid decimal number in raw data: %q", data.RawData)
+		value, err := types.ParseOracleValue(data.RawData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid decimal number in raw data: %q", data.RawData)
+		}
+		values[i] = value.BigFloat()
+	}
+
+	if isWeighted {
+		weights, err := k.submissionStakes(ctx, submitDatas)
+		if err != nil {
+			return nil, err
+		}
+		return weighted(values, weights)
+	}
+	return aggregate(values)
+}
+
+// submissionStakes returns the bonded stake of the provider of each submission
+func (k Keeper) submissionStakes(ctx sdk.Context, submitDatas []*types.SubmitDataSet) ([]sdkmath.Int, error) {
+	if k.stakingKeeper == nil {
+		return nil, fmt.Errorf("stake unavailable: no staking keeper")
+	}
+
+	weights := make([]sdkmath.Int, len(submitDatas))
+	for i, data := range submitDatas {
+		weights[i] = k.providerStake(ctx, data.Provider)
+	}
+	return weights, nil
+}
+
+// aggregation is the outcome of aggregating a round
+type aggregation struct {
+	// value is the canonical OracleValue stored as the round's raw data
+	value string
+	// raw is the aggregate before rounding to value
+	raw string
+	// rule is the aggregation rule that produced the result
+	rule types.AggregationRule
+}
+
+// aggregateWithFallback aggregates the submitted data with the first rule of the request that succeeds
+// and reports the rule that produced the value. The error of the last rule is returned when all fail.
+func (k Keeper) aggregateWithFallback(ctx sdk.Context, doc types.OracleRequestDoc, submitDatas []*types.SubmitDataSet) (aggregation, error) {
+	var lastErr error
+	for i, rule := range doc.AggregationRules() {
+		result, err := k.aggregateValues(ctx, rule, submitDatas)
+		var value types.OracleValue
+		if err == nil {
+			value, err = types.OracleValueFromBigFloat(result)
+		}
+		if err == nil {
+			if i > 0 {
+				k.Logger(ctx).Info("aggregated with fallback rule",
+					"request_id", doc.RequestId,
+					"rule", rule,
+					"primary_rule", doc.AggregationRule,
+					"error", lastErr)
+			}
+			return aggregation{value: value.String(), raw: result.Text('f', -1), rule: rule}, nil
 		}
+		lastErr = err
 	}
 
-	// Use efficient O(n log n) sorting instead of O(n²) bubble sort
-	sort.Slice(values, func(i, j int) bool {
-		return values[i].Cmp(values[j]) < 0
+	return aggregation{}, lastErr
+}
+
+// acceptedReports returns the reports of a round that are aggregated. The report of a trusted provider
+// is taken as is; other rounds drop suspected unit mismatches.
+func (k Keeper) acceptedReports(ctx sdk.Context, doc types.OracleRequestDoc, nonce uint64, reports []*types.SubmitDataSet) []*types.SubmitDataSet {
+	if doc.QuorumMode == types.QuorumMode_QUORUM_MODE_TRUSTED_PROVIDER {
+		return reports
+	}
+	return k.filterMagnitudeOutliers(ctx, doc.RequestId, nonce, reports)
+}
+
+// filterMagnitudeOutliers excludes submissions whose ratio to the median exceeds the
+// MaxMagnitudeRatio param. Such gaps usually come from a provider reporting in different
+// units (e.g. cents vs dollars, or an inverted pair) rather than from market movement.
+// Non-positive or unparsable values are kept and left to the aggregation rule.
+func (k Keeper) filterMagnitudeOutliers(ctx sdk.Context, requestId uint64, nonce uint64, submitDatas []*types.SubmitDataSet) []*types.SubmitDataSet {
+	ratio := k.GetParams(ctx).MaxMagnitudeRatio
+	if ratio.IsNil() || !ratio.IsPositive() || len(submitDatas) < 3 {
+		return submitDatas
+	}
+
+	maxRatio, ok := new(big.Float).SetString(ratio.String())
+	if !ok {
+		return submitDatas
+	}
+
+	values := make([]*big.Float, len(submitDatas))
+	var positives []*big.Float
+	for i, data := range submitDatas {
+		if value, ok := new(big.Float).SetString(data.RawData); ok && value.Sign() > 0 {
+			values[i] = value
+			positives = append(positives, value)
+		}
+	}
+	if len(positives) < 3 {
+		return submitDatas
+	}
+
+	sorted := slices.Clone(positives)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) < 0
 	})
+	median := sorted[len(sorted)/2]
+
+	filtered := make([]*types.SubmitDataSet, 0, len(submitDatas))
+	for i, data := range submitDatas {
+		if values[i] == nil {
+			filtered = append(filtered, data)
+			continue
+		}
 
-	// Calculate median
-	mid := len(values) / 2
-	if len(values)%2 == 0 {
-		// Even number of values, average the middle two
-		median := new(big.Float).Add(values[mid-1], values[mid])
-		median.Quo(median, new(big.Float).SetInt64(2))
-		return median.Text('f', -1), nil
+		// Compare the larger over the smaller so both directions are caught
+		hi, lo := values[i], median
+		if hi.Cmp(lo) < 0 {
+			hi, lo = lo, hi
+		}
+		if new(big.Float).Quo(hi, lo).Cmp(maxRatio) <= 0 {
+			filtered = append(filtered, data)
+			continue
+		}
+
+		k.Logger(ctx).Warn("suspected unit mismatch, excluding submission",
+			"request_id", requestId,
+			"nonce", nonce,
+			"provider", data.Provider,
+			"value", data.RawData,
+			"median", median.Text('f', -1))
 	}
-	// Odd number of values, return the middle one
-	return values[mid].Text('f', -1), nil
+
+	return filtered
 }
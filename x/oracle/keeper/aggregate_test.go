@@ -1,10 +1,16 @@
 package keeper
 
 import (
+	"bytes"
+	"context"
 	"testing"
+	"time"
+
+	"cosmossdk.io/math"
 
 	"github.com/gurufinglobal/guru/v2/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -153,6 +159,362 @@ func TestAggregateData(t *testing.T) {
 	}
 }
 
+func TestFilterMagnitudeOutliers(t *testing.T) {
+	tests := []struct {
+		name       string
+		submitData []*types.SubmitDataSet
+		want       []string
+	}{
+		{
+			name: "no_outliers",
+			submitData: []*types.SubmitDataSet{
+				{RawData: "1388.95"},
+				{RawData: "1390.10"},
+				{RawData: "1387.40"},
+			},
+			want: []string{"1388.95", "1390.10", "1387.40"},
+		},
+		{
+			name: "cents_instead_of_dollars",
+			submitData: []*types.SubmitDataSet{
+				{RawData: "1388.95"},
+				{RawData: "138895"},
+				{RawData: "1387.40"},
+			},
+			want: []string{"1388.95", "1387.40"},
+		},
+		{
+			name: "inverted_pair",
+			submitData: []*types.SubmitDataSet{
+				{RawData: "1388.95"},
+				{RawData: "0.00072"},
+				{RawData: "1387.40"},
+				{RawData: "1390.10"},
+			},
+			want: []string{"1388.95", "1387.40", "1390.10"},
+		},
+		{
+			name: "too_few_submissions",
+			submitData: []*types.SubmitDataSet{
+				{RawData: "1388.95"},
+				{RawData: "138895"},
+			},
+			want: []string{"1388.95", "138895"},
+		},
+		{
+			name: "invalid_values_kept",
+			submitData: []*types.SubmitDataSet{
+				{RawData: "1388.95"},
+				{RawData: "NaN"},
+				{RawData: "1387.40"},
+				{RawData: "1390.10"},
+			},
+			want: []string{"1388.95", "NaN", "1387.40", "1390.10"},
+		},
+	}
+
+	ctx, k := setupTest(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := k.filterMagnitudeOutliers(ctx, 1, 1, tt.submitData)
+			values := make([]string, len(got))
+			for i, data := range got {
+				values[i] = data.RawData
+			}
+			require.Equal(t, tt.want, values)
+		})
+	}
+
+	// Zero ratio disables the check
+	params := k.GetParams(ctx)
+	params.MaxMagnitudeRatio = math.LegacyZeroDec()
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Len(t, k.filterMagnitudeOutliers(ctx, 1, 1, tests[1].submitData), 3)
+}
+
+func TestAggregateWithFallback(t *testing.T) {
+	ctx, k := setupTest(t)
+	submitData := []*types.SubmitDataSet{{RawData: "3"}, {RawData: "1"}, {RawData: "2"}}
+
+	// The primary rule succeeds -> fallbacks are not tried
+	doc := types.OracleRequestDoc{
+		RequestId:       1,
+		AggregationRule: types.AggregationRule_AGGREGATION_RULE_MAX,
+		FallbackRules:   []types.AggregationRule{types.AggregationRule_AGGREGATION_RULE_MIN},
+	}
+	result, err := k.aggregateWithFallback(ctx, doc, submitData)
+	require.NoError(t, err)
+	require.Equal(t, "3", result.value)
+	require.Equal(t, types.AggregationRule_AGGREGATION_RULE_MAX, result.rule)
+
+	// The stake-weighted median fails without a staking keeper and falls through to the next rule, which is reported
+	doc.AggregationRule = types.AggregationRule_AGGREGATION_RULE_STAKE_WEIGHTED_MEDIAN
+	doc.FallbackRules = []types.AggregationRule{types.AggregationRule_AGGREGATION_RULE_MEDIAN}
+	result, err = k.aggregateWithFallback(ctx, doc, submitData)
+	require.NoError(t, err)
+	require.Equal(t, "2", result.value)
+	require.Equal(t, types.AggregationRule_AGGREGATION_RULE_MEDIAN, result.rule)
+
+	// It also fails when no submitting provider has bonded stake
+	whale := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	small := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	nonValidator := sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String()
+	stakedData := []*types.SubmitDataSet{
+		{Provider: whale, RawData: "3"},
+		{Provider: small, RawData: "1"},
+		{Provider: nonValidator, RawData: "2"},
+	}
+	k.stakingKeeper = mockStakingKeeper{}
+	result, err = k.aggregateWithFallback(ctx, doc, stakedData)
+	require.NoError(t, err)
+	require.Equal(t, types.AggregationRule_AGGREGATION_RULE_MEDIAN, result.rule)
+
+	// With bonded stake the weighted rule succeeds and follows the stake
+	k.stakingKeeper = mockStakingKeeper{whale: 700, small: 300}
+	result, err = k.aggregateWithFallback(ctx, doc, stakedData)
+	require.NoError(t, err)
+	require.Equal(t, "3", result.value)
+	require.Equal(t, types.AggregationRule_AGGREGATION_RULE_STAKE_WEIGHTED_MEDIAN, result.rule)
+
+	// Every rule fails -> the last error is returned
+	doc.AggregationRule = types.AggregationRule(99)
+	doc.FallbackRules = []types.AggregationRule{types.AggregationRule(98)}
+	_, err = k.aggregateWithFallback(ctx, doc, submitData)
+	require.ErrorContains(t, err, "unsupported aggregation rule")
+}
+
+func TestNewRoundCompletedEvent(t *testing.T) {
+	doc := types.OracleRequestDoc{
+		RequestId:   7,
+		Quorum:      2,
+		AccountList: []string{"alice", "bob", "carol", "dave"},
+	}
+	reports := []*types.SubmitDataSet{
+		{Provider: "alice", RawData: "1388.95"},
+		{Provider: "bob", RawData: "138895"},
+		{Provider: "carol", RawData: "1387.40"},
+	}
+
+	event := newRoundCompletedEvent(doc, 3, reports, []*types.SubmitDataSet{reports[0], reports[2]}, "1388.175", 30)
+	require.Equal(t, types.EventTypeRoundCompleted, event.Type)
+
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	require.Equal(t, map[string]string{
+		types.AttributeKeyRequestId:     "7",
+		types.AttributeKeyNonce:         "3",
+		types.AttributeKeyReports:       "3",
+		types.AttributeKeyQuorum:        "2",
+		types.AttributeKeyRawData:       "1388.175",
+		types.AttributeKeyExcluded:      "bob",
+		types.AttributeKeyMissed:        "dave",
+		types.AttributeKeyRoundDuration: "30",
+	}, attrs)
+}
+
+// mockStakingKeeper returns bonded validators with the configured tokens
+type mockStakingKeeper map[string]int64
+
+func (m mockStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	tokens, ok := m[sdk.AccAddress(addr).String()]
+	if !ok {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return stakingtypes.Validator{Status: stakingtypes.Bonded, Tokens: math.NewInt(tokens)}, nil
+}
+
+func TestQuorumMet_StakeWeighted(t *testing.T) {
+	ctx, k := setupTest(t)
+
+	whale := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	small := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	tiny := sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String()
+	nonValidator := sdk.AccAddress(bytes.Repeat([]byte{4}, 20)).String()
+	k.stakingKeeper = mockStakingKeeper{whale: 700, small: 200, tiny: 100}
+
+	doc := types.OracleRequestDoc{
+		AccountList:         []string{whale, small, tiny, nonValidator},
+		Quorum:              3,
+		QuorumMode:          types.QuorumMode_QUORUM_MODE_STAKE_WEIGHTED,
+		QuorumStakeFraction: "0.67",
+	}
+	reports := func(providers ...string) []*types.SubmitDataSet {
+		datas := make([]*types.SubmitDataSet, len(providers))
+		for i, provider := range providers {
+			datas[i] = &types.SubmitDataSet{Provider: provider, RawData: "1"}
+		}
+		return datas
+	}
+
+	// A high-stake provider alone meets the quorum although the count quorum is 3
+	met, _ := k.quorumMet(ctx, doc, reports(whale))
+	require.True(t, met)
+
+	// Providers without a bonded validator add no stake
+	met, progress := k.quorumMet(ctx, doc, reports(small, tiny, nonValidator))
+	require.False(t, met)
+	require.Equal(t, "reported stake 300 of 1000, need fraction 0.670000000000000000", progress)
+
+	// Count mode ignores stake
+	doc.QuorumMode = types.QuorumMode_QUORUM_MODE_COUNT
+	met, _ = k.quorumMet(ctx, doc, reports(whale))
+	require.False(t, met)
+	met, _ = k.quorumMet(ctx, doc, reports(small, tiny, nonValidator))
+	require.True(t, met)
+
+	// Without a staking keeper the stake-weighted quorum is never met
+	doc.QuorumMode = types.QuorumMode_QUORUM_MODE_STAKE_WEIGHTED
+	k.stakingKeeper = nil
+	met, progress = k.quorumMet(ctx, doc, reports(whale))
+	require.False(t, met)
+	require.Equal(t, "stake-weighted quorum unavailable: no staking keeper", progress)
+}
+
+// recordingHooks keeps the data sets passed to AfterOracleEnd
+type recordingHooks struct {
+	ended *[]types.DataSet
+}
+
+func (h recordingHooks) AfterOracleEnd(_ sdk.Context, dataSet types.DataSet) {
+	*h.ended = append(*h.ended, dataSet)
+}
+
+func (h recordingHooks) BeforeOracleStart(sdk.Context, types.DataSet) {}
+
+func TestProcessOracleDataSetAggregation_TrustedProvider(t *testing.T) {
+	ctx, k := setupTest(t)
+	var ended []types.DataSet
+	k.SetHooks(recordingHooks{ended: &ended})
+	provider := "guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft"
+
+	params := k.GetParams(ctx)
+	params.TrustedProviderTypes = []types.OracleType{types.OracleType_ORACLE_TYPE_MIN_GAS_PRICE}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	doc := types.OracleRequestDoc{
+		RequestId:       1,
+		OracleType:      types.OracleType_ORACLE_TYPE_MIN_GAS_PRICE,
+		Name:            "gas price",
+		Period:          60,
+		AccountList:     []string{provider},
+		Quorum:          1,
+		Endpoints:       []*types.OracleEndpoint{{Url: "https://example.com", ParseRule: "price"}},
+		AggregationRule: types.AggregationRule_AGGREGATION_RULE_MEDIAN,
+		Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+		QuorumMode:      types.QuorumMode_QUORUM_MODE_TRUSTED_PROVIDER,
+	}
+	require.NoError(t, doc.ValidateWithParams(k.GetParams(ctx)))
+	k.SetOracleRequestDoc(ctx, doc)
+
+	// The single trusted submission finalizes the round
+	k.SetSubmitData(ctx, types.SubmitDataSet{RequestId: 1, Nonce: 1, RawData: "0.25", Provider: provider})
+	k.ProcessOracleDataSetAggregation(ctx)
+
+	dataSet, err := k.GetDataSet(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, "0.25", dataSet.RawData)
+	require.Equal(t, []types.DataSet{*dataSet}, ended)
+	updatedDoc, err := k.GetOracleRequestDoc(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), updatedDoc.Nonce)
+
+	// Price types are never trusted to a single provider
+	doc.OracleType = types.OracleType_ORACLE_TYPE_CRYPTO
+	require.ErrorContains(t, doc.ValidateWithParams(k.GetParams(ctx)), "trusted provider mode is not allowed")
+	params.TrustedProviderTypes = []types.OracleType{types.OracleType_ORACLE_TYPE_CRYPTO}
+	require.ErrorContains(t, params.Validate(), "price oracle type")
+}
+
+func TestProcessOracleDataSetAggregation_ResultFormat(t *testing.T) {
+	providers := []string{
+		sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String(),
+		sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String(),
+		sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String(),
+	}
+
+	for _, tc := range []struct {
+		format       types.ResultFormat
+		eventRawData string
+		rawAggregate bool
+	}{
+		{types.ResultFormat_RESULT_FORMAT_UNSPECIFIED, "normalized", false},
+		{types.ResultFormat_RESULT_FORMAT_NORMALIZED, "normalized", false},
+		{types.ResultFormat_RESULT_FORMAT_RAW, "raw", false},
+		{types.ResultFormat_RESULT_FORMAT_BOTH, "normalized", true},
+	} {
+		ctx, k := setupTest(t)
+		k.SetOracleRequestDoc(ctx, types.OracleRequestDoc{
+			RequestId:       1,
+			OracleType:      types.OracleType_ORACLE_TYPE_CRYPTO,
+			AccountList:     providers,
+			Quorum:          3,
+			AggregationRule: types.AggregationRule_AGGREGATION_RULE_AVG,
+			Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+			ResultFormat:    tc.format,
+		})
+		for i, rawData := range []string{"1", "1", "2"} {
+			k.SetSubmitData(ctx, types.SubmitDataSet{RequestId: 1, Nonce: 1, RawData: rawData, Provider: providers[i]})
+		}
+
+		k.ProcessOracleDataSetAggregation(ctx)
+
+		// The stored raw data is always the canonical value consumers parse
+		dataSet, err := k.GetDataSet(ctx, 1, 1)
+		require.NoError(t, err, tc.format)
+		require.Equal(t, "1.333333333333333333", dataSet.RawData, tc.format)
+		// The unrounded aggregate is stored whatever the format; the format only picks the event attributes
+		require.True(t, len(dataSet.RawAggregate) > len(dataSet.RawData), tc.format)
+		require.Contains(t, dataSet.RawAggregate, "1.3333333333333333333333", tc.format)
+
+		attributes := map[string]string{}
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeCompleteOracleDataSet {
+				continue
+			}
+			for _, attribute := range event.Attributes {
+				attributes[attribute.Key] = attribute.Value
+			}
+		}
+		expected := dataSet.RawData
+		if tc.eventRawData == "raw" {
+			expected = dataSet.RawAggregate
+		}
+		require.Equal(t, expected, attributes[types.AttributeKeyRawData], tc.format)
+		rawAggregate, ok := attributes[types.AttributeKeyRawAggregate]
+		require.Equal(t, tc.rawAggregate, ok, tc.format)
+		if ok {
+			require.Equal(t, dataSet.RawAggregate, rawAggregate, tc.format)
+		}
+	}
+
+	// Unknown formats are rejected at registration
+	doc := types.OracleRequestDoc{
+		OracleType:      types.OracleType_ORACLE_TYPE_CRYPTO,
+		Name:            "btc",
+		Period:          60,
+		AccountList:     providers,
+		Quorum:          3,
+		Endpoints:       []*types.OracleEndpoint{{Url: "https://example.com", ParseRule: "price"}},
+		AggregationRule: types.AggregationRule_AGGREGATION_RULE_AVG,
+		Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+		ResultFormat:    types.ResultFormat_RESULT_FORMAT_BOTH,
+	}
+	require.NoError(t, doc.ValidateWithParams(types.DefaultParams()))
+	doc.ResultFormat = types.ResultFormat(9)
+	require.ErrorContains(t, doc.ValidateWithParams(types.DefaultParams()), "unsupported result format")
+
+	// So are unknown quorum modes and endpoint assignments
+	doc.ResultFormat = types.ResultFormat_RESULT_FORMAT_BOTH
+	doc.QuorumMode = types.QuorumMode(9)
+	require.ErrorContains(t, doc.ValidateWithParams(types.DefaultParams()), "unsupported quorum mode")
+	doc.QuorumMode = types.QuorumMode_QUORUM_MODE_COUNT
+	doc.EndpointAssignment = types.EndpointAssignment(9)
+	require.ErrorContains(t, doc.ValidateWithParams(types.DefaultParams()), "unsupported endpoint assignment")
+}
+
 // TestProcessOracleDataSetAggregation disabled temporarily due to store setup issues
 func testProcessOracleDataSetAggregation(t *testing.T) {
 	ctx, k := setupTest(t)
@@ -292,3 +654,129 @@ func testProcessOracleDataSetAggregationWithDisabledStatus(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, uint64(0), updatedDoc.Nonce)
 }
+
+func TestProcessOracleDataSetAggregation_FullCoverage(t *testing.T) {
+	ctx, k := setupTest(t)
+	providers := []string{
+		sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String(),
+		sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String(),
+		sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String(),
+	}
+
+	k.SetOracleRequestDoc(ctx, types.OracleRequestDoc{
+		RequestId:       1,
+		OracleType:      types.OracleType_ORACLE_TYPE_CRYPTO,
+		AccountList:     providers,
+		Quorum:          2,
+		AggregationRule: types.AggregationRule_AGGREGATION_RULE_AVG,
+		Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+		CoverageMode:    types.CoverageMode_COVERAGE_MODE_FULL,
+	})
+
+	// The quorum is met, but one provider's endpoint failed: the round stays open
+	k.SetSubmitData(ctx, types.SubmitDataSet{RequestId: 1, Nonce: 1, RawData: "100", Provider: providers[0]})
+	k.SetSubmitData(ctx, types.SubmitDataSet{RequestId: 1, Nonce: 1, RawData: "102", Provider: providers[1]})
+	k.ProcessOracleDataSetAggregation(ctx)
+
+	_, err := k.GetDataSet(ctx, 1, 1)
+	require.Error(t, err)
+	doc, err := k.GetOracleRequestDoc(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), doc.Nonce)
+
+	// The retrying provider completes the coverage
+	k.SetSubmitData(ctx, types.SubmitDataSet{RequestId: 1, Nonce: 1, RawData: "104", Provider: providers[2]})
+	k.ProcessOracleDataSetAggregation(ctx)
+
+	dataSet, err := k.GetDataSet(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, "102", dataSet.RawData)
+
+	// A report excluded as an outlier also leaves the coverage incomplete
+	reports := []*types.SubmitDataSet{{Provider: providers[0]}, {Provider: providers[1]}, {Provider: providers[2]}}
+	covered, progress := fullCoverage(*doc, reports, reports[:2])
+	require.False(t, covered)
+	require.Equal(t, "0 of 3 providers missing, 1 excluded", progress)
+}
+
+func TestProcessOracleDataSetAggregation_RoundTiming(t *testing.T) {
+	ctx, k := setupTest(t)
+	provider := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+
+	k.SetOracleRequestDoc(ctx, types.OracleRequestDoc{
+		RequestId:       1,
+		Name:            "BTC/USD",
+		OracleType:      types.OracleType_ORACLE_TYPE_CRYPTO,
+		AccountList:     []string{provider},
+		Quorum:          1,
+		Period:          60,
+		Endpoints:       []*types.OracleEndpoint{{Url: "https://api.coinbase.com/v2/prices/BTC-USD/spot", ParseRule: "data.amount"}},
+		AggregationRule: types.AggregationRule_AGGREGATION_RULE_AVG,
+		Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+	})
+
+	start := time.Unix(1_700_000_000, 0)
+	finalize := func(nonce uint64, height int64, elapsed time.Duration) {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(start.Add(elapsed))
+		k.SetSubmitData(ctx, types.SubmitDataSet{RequestId: 1, Nonce: nonce, RawData: "100", Provider: provider})
+		k.ProcessOracleDataSetAggregation(ctx)
+		_, err := k.GetDataSet(ctx, 1, nonce)
+		require.NoError(t, err)
+	}
+
+	// The document was stored without a recorded round start, so the first round is not measured
+	finalize(1, 10, 0)
+	require.Equal(t, uint64(0), k.GetRoundTiming(ctx, 1).Samples)
+
+	finalize(2, 16, 60*time.Second)
+	timing := k.GetRoundTiming(ctx, 1)
+	require.Equal(t, uint64(1), timing.Samples)
+	require.Equal(t, "60.000000000000000000", timing.EmaDurationSeconds)
+	require.Equal(t, "6.000000000000000000", timing.EmaDurationBlocks)
+
+	// A slower round pulls the averages up by the smoothing weight
+	finalize(3, 28, 180*time.Second)
+	res, err := k.RoundTiming(ctx, &types.QueryRoundTimingRequest{RequestId: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.RoundTiming{
+		RequestId:           1,
+		Samples:             2,
+		EmaDurationSeconds:  "72.000000000000000000",
+		EmaDurationBlocks:   "7.200000000000000000",
+		LastDurationSeconds: 120,
+		LastDurationBlocks:  12,
+		LastHeight:          28,
+		RoundStartHeight:    28,
+		RoundStartTime:      uint64(start.Unix()) + 180,
+	}, res.RoundTiming)
+
+	// A cancelled round is not counted; the next one is measured from the cancellation
+	moderator := sdk.AccAddress(bytes.Repeat([]byte{9}, 20)).String()
+	require.NoError(t, k.SetModeratorAddress(ctx, moderator))
+	ctx = ctx.WithBlockHeight(40).WithBlockTime(start.Add(300 * time.Second))
+	_, err = k.CancelRound(ctx, types.NewMsgCancelRound(moderator, 1, 4))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), k.GetRoundTiming(ctx, 1).Samples)
+
+	finalize(5, 46, 330*time.Second)
+	timing = k.GetRoundTiming(ctx, 1)
+	require.Equal(t, uint64(3), timing.Samples)
+	require.Equal(t, uint64(30), timing.LastDurationSeconds)
+	require.Equal(t, uint64(6), timing.LastDurationBlocks)
+
+	// Updating the request resets the statistics and starts the open round over
+	ctx = ctx.WithBlockHeight(50).WithBlockTime(start.Add(400 * time.Second))
+	require.NoError(t, k.updateOracleRequestDoc(ctx, types.OracleRequestDoc{RequestId: 1, Period: 30}))
+	res, err = k.RoundTiming(ctx, &types.QueryRoundTimingRequest{RequestId: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.RoundTiming{RequestId: 1, RoundStartHeight: 50, RoundStartTime: uint64(start.Unix()) + 400}, res.RoundTiming)
+
+	finalize(6, 53, 420*time.Second)
+	timing = k.GetRoundTiming(ctx, 1)
+	require.Equal(t, uint64(1), timing.Samples)
+	require.Equal(t, uint64(20), timing.LastDurationSeconds)
+	require.Equal(t, uint64(3), timing.LastDurationBlocks)
+
+	_, err = k.RoundTiming(ctx, &types.QueryRoundTimingRequest{RequestId: 2})
+	require.Error(t, err)
+}
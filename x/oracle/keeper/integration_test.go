@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gurufinglobal/guru/v2/testutil/integration/os/factory"
+	"github.com/gurufinglobal/guru/v2/testutil/integration/os/grpc"
+	"github.com/gurufinglobal/guru/v2/testutil/integration/os/keyring"
+	"github.com/gurufinglobal/guru/v2/testutil/integration/os/network"
+	oracleharness "github.com/gurufinglobal/guru/v2/testutil/integration/os/oracle"
+	feemarkettypes "github.com/gurufinglobal/guru/v2/x/feemarket/types"
+	"github.com/gurufinglobal/guru/v2/x/oracle/types"
+
+	"cosmossdk.io/math"
+)
+
+func TestOracleRoundUpdatesMinGasPrice(t *testing.T) {
+	keys := keyring.New(3)
+
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.GasPriceAdjustmentFactor = math.LegacyNewDec(1000)
+	customGenesis := network.CustomGenesisState{
+		types.ModuleName:          oracleharness.Genesis(keys, 0),
+		feemarkettypes.ModuleName: feemarketGenesis,
+	}
+
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keys.GetAllAccAddrs()...),
+		network.WithCustomGenesis(customGenesis),
+	)
+	tf := factory.New(nw, grpc.NewIntegrationHandler(nw))
+	harness := oracleharness.NewHarness(nw, tf, keys, 0)
+
+	requestID, err := harness.Register(types.OracleRequestDoc{
+		OracleType:      types.OracleType_ORACLE_TYPE_MIN_GAS_PRICE,
+		Name:            "guru/usd",
+		Period:          60,
+		AccountList:     []string{harness.Provider(1), harness.Provider(2)},
+		Quorum:          2,
+		Endpoints:       []*types.OracleEndpoint{{Url: "https://example.com", ParseRule: "price"}},
+		AggregationRule: types.AggregationRule_AGGREGATION_RULE_MEDIAN,
+		Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+	})
+	require.NoError(t, err)
+
+	// One report is below the quorum, so the round stays open
+	require.NoError(t, harness.Report(requestID, 1, "0.4"))
+	_, err = harness.Finalize(requestID)
+	require.Error(t, err)
+
+	// The second report completes the round and the fee market consumes the result
+	require.NoError(t, harness.Report(requestID, 2, "0.6"))
+	dataSet, err := harness.Finalize(requestID)
+	require.NoError(t, err)
+	require.Equal(t, "0.5", dataSet.RawData)
+	require.Equal(t, uint64(1), dataSet.Nonce)
+
+	require.Equal(t, math.LegacyNewDec(2000), nw.App.FeeMarketKeeper.GetParams(nw.GetContext()).MinGasPrice)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,6 +21,7 @@ type Keeper struct {
 	hooks         types.OracleHooks
 	authority     string
 	accountKeeper types.AccountKeeper
+	stakingKeeper types.StakingKeeper
 }
 
 func NewKeeper(
@@ -27,12 +29,14 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	authority string,
 	accountKeeper types.AccountKeeper,
+	stakingKeeper types.StakingKeeper,
 ) *Keeper {
 	return &Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
 		authority:     authority,
 		accountKeeper: accountKeeper,
+		stakingKeeper: stakingKeeper,
 	}
 }
 
@@ -121,8 +125,56 @@ func (k Keeper) GetOracleRequestDocCount(ctx sdk.Context) uint64 {
 func (k Keeper) SetOracleRequestDoc(ctx sdk.Context, doc types.OracleRequestDoc) {
 	store := ctx.KVStore(k.storeKey)
 
+	// Replace the account index entries of the previous version of the document
+	if existing, err := k.GetOracleRequestDoc(ctx, doc.RequestId); err == nil {
+		k.deleteAccountRequestIndex(ctx, *existing)
+	}
+
 	bz := k.cdc.MustMarshal(&doc)
 	store.Set(types.GetOracleRequestDocKey(doc.RequestId), bz)
+
+	k.setAccountRequestIndex(ctx, doc)
+}
+
+// setAccountRequestIndex indexes the document under every account in its account list
+func (k Keeper) setAccountRequestIndex(ctx sdk.Context, doc types.OracleRequestDoc) {
+	store := ctx.KVStore(k.storeKey)
+	for _, account := range doc.AccountList {
+		store.Set(types.GetAccountRequestIndexKey(account, doc.RequestId), []byte{})
+	}
+}
+
+// deleteAccountRequestIndex removes the index entries of every account in the document's account list
+func (k Keeper) deleteAccountRequestIndex(ctx sdk.Context, doc types.OracleRequestDoc) {
+	store := ctx.KVStore(k.storeKey)
+	for _, account := range doc.AccountList {
+		store.Delete(types.GetAccountRequestIndexKey(account, doc.RequestId))
+	}
+}
+
+// IterateRequestsByAccount calls cb, in request id order, for every request document whose account list contains account.
+// The iteration stops when cb returns true.
+func (k Keeper) IterateRequestsByAccount(ctx sdk.Context, account string, cb func(doc types.OracleRequestDoc) (stop bool)) {
+	// Account lists are stored in canonical form; an invalid address is listed by no document
+	address, err := sdk.AccAddressFromBech32(account)
+	if err != nil {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetAccountRequestIndexPrefix(address.String()))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		doc, err := k.GetOracleRequestDoc(ctx, sdk.BigEndianToUint64(iterator.Key()))
+		if err != nil {
+			k.Logger(ctx).Error("account index references missing request document", "account", account, "error", err)
+			continue
+		}
+		if cb(*doc) {
+			break
+		}
+	}
 }
 
 func (k Keeper) updateOracleRequestDoc(ctx sdk.Context, doc types.OracleRequestDoc) error {
@@ -135,7 +187,7 @@ func (k Keeper) updateOracleRequestDoc(ctx sdk.Context, doc types.OracleRequestD
 	// Check if the existing document status is disabled
 	if existingDoc.Status == types.RequestStatus_REQUEST_STATUS_DISABLED &&
 		doc.Status == types.RequestStatus_REQUEST_STATUS_UNSPECIFIED {
-		return fmt.Errorf("cannot modify disabled Request Doc except status")
+		return errorsmod.Wrap(types.ErrRequestNotEnabled, "cannot modify disabled Request Doc except status")
 	}
 
 	// Update the period if it is not empty
@@ -148,9 +200,12 @@ func (k Keeper) updateOracleRequestDoc(ctx sdk.Context, doc types.OracleRequestD
 		existingDoc.Status = doc.Status
 	}
 
-	// Update the account list if it is not empty
+	// Update the account list if it is not empty, in canonical form
 	if len(doc.AccountList) != 0 {
-		existingDoc.AccountList = doc.AccountList
+		existingDoc.AccountList, err = types.NormalizeAccountList(doc.AccountList)
+		if err != nil {
+			return errorsmod.Wrap(types.ErrInvalidRequestDoc, err.Error())
+		}
 	}
 
 	// Update the quorum if it is not empty
@@ -168,15 +223,47 @@ func (k Keeper) updateOracleRequestDoc(ctx sdk.Context, doc types.OracleRequestD
 		existingDoc.AggregationRule = doc.AggregationRule
 	}
 
+	// Update the fallback rules if they are not empty
+	if len(doc.FallbackRules) != 0 {
+		existingDoc.FallbackRules = doc.FallbackRules
+	}
+
+	// Update the result format if it is not empty
+	if doc.ResultFormat != types.ResultFormat_RESULT_FORMAT_UNSPECIFIED {
+		existingDoc.ResultFormat = doc.ResultFormat
+	}
+
+	// Update the coverage mode if it is not empty
+	if doc.CoverageMode != types.CoverageMode_COVERAGE_MODE_UNSPECIFIED {
+		existingDoc.CoverageMode = doc.CoverageMode
+	}
+
+	// Update the endpoint assignment if it is not empty
+	if doc.EndpointAssignment != types.EndpointAssignment_ENDPOINT_ASSIGNMENT_UNSPECIFIED {
+		existingDoc.EndpointAssignment = doc.EndpointAssignment
+	}
+
+	// Update the quorum mode and stake fraction if they are not empty
+	if doc.QuorumMode != types.QuorumMode_QUORUM_MODE_UNSPECIFIED {
+		existingDoc.QuorumMode = doc.QuorumMode
+	}
+	if doc.QuorumStakeFraction != "" {
+		existingDoc.QuorumStakeFraction = doc.QuorumStakeFraction
+	}
+
 	// Validate the updated oracle request document with current parameters
 	params := k.GetParams(ctx)
 	err = existingDoc.ValidateWithParams(params)
 	if err != nil {
-		return fmt.Errorf("validation failed for updated document: %v", err)
+		return errorsmod.Wrapf(types.ErrInvalidRequestDoc, "validation failed for updated document: %v", err)
 	}
 
 	// Store the updated oracle request document
 	k.SetOracleRequestDoc(ctx, *existingDoc)
+
+	// Rounds under the old settings no longer describe the request; the open round starts over
+	k.DeleteRoundTiming(ctx, existingDoc.RequestId)
+	k.startRound(ctx, existingDoc.RequestId)
 	return nil
 }
 
@@ -187,7 +274,7 @@ func (k Keeper) GetOracleRequestDoc(ctx sdk.Context, id uint64) (*types.OracleRe
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.GetOracleRequestDocKey(id))
 	if len(bz) == 0 {
-		return nil, fmt.Errorf("not exist RequestDoc(req_id: %d)", id)
+		return nil, errorsmod.Wrapf(types.ErrRequestNotFound, "not exist RequestDoc(req_id: %d)", id)
 	}
 
 	var doc types.OracleRequestDoc
@@ -271,6 +358,23 @@ func (k Keeper) GetSubmitDatas(ctx sdk.Context, requestId uint64, nonce uint64)
 	return datas, nil
 }
 
+// DeleteSubmitDatas removes the reports of a round and returns how many were removed
+func (k Keeper) DeleteSubmitDatas(ctx sdk.Context, requestId uint64, nonce uint64) int {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetSubmitDataKey(requestId, nonce))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return len(keys)
+}
+
 // SetDataSet stores the aggregated oracle data
 func (k Keeper) SetDataSet(ctx sdk.Context, dataSet types.DataSet) {
 	store := ctx.KVStore(k.storeKey)
@@ -290,21 +394,60 @@ func (k Keeper) GetDataSet(ctx sdk.Context, requestId uint64, nonce uint64) (*ty
 	return &dataSet, nil
 }
 
+// SetRoundTiming stores the round timing statistics of a request
+func (k Keeper) SetRoundTiming(ctx sdk.Context, timing types.RoundTiming) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&timing)
+	store.Set(types.GetRoundTimingKey(timing.RequestId), bz)
+}
+
+// GetRoundTiming returns the round timing statistics of a request.
+// A request without finalized rounds since it was registered or last updated has zero samples.
+func (k Keeper) GetRoundTiming(ctx sdk.Context, requestId uint64) types.RoundTiming {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRoundTimingKey(requestId))
+	if len(bz) == 0 {
+		return types.RoundTiming{RequestId: requestId}
+	}
+
+	var timing types.RoundTiming
+	k.cdc.MustUnmarshal(bz, &timing)
+	return timing
+}
+
+// DeleteRoundTiming resets the round timing statistics of a request
+func (k Keeper) DeleteRoundTiming(ctx sdk.Context, requestId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRoundTimingKey(requestId))
+}
+
+// startRound records the current block as the start of the open round of a request
+func (k Keeper) startRound(ctx sdk.Context, requestId uint64) {
+	timing := k.GetRoundTiming(ctx, requestId)
+	k.SetRoundTiming(ctx, timing.StartRound(uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())))
+}
+
 // Logger returns a logger instance with the module name prefixed
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// checkAccountAuthorized reports whether fromAddress is in accountList.
+// Addresses are compared as account bytes, so an address written in another case still matches.
 func (k Keeper) checkAccountAuthorized(accountList []string, fromAddress string) bool {
+	from, err := sdk.AccAddressFromBech32(fromAddress)
+	if err != nil {
+		return false
+	}
 	for _, account := range accountList {
-		if account == fromAddress {
+		if acc, err := sdk.AccAddressFromBech32(account); err == nil && acc.Equals(from) {
 			return true
 		}
 	}
 	return false
 }
 
-func (k Keeper) validateSubmitData(data types.SubmitDataSet) error {
+func (k Keeper) validateSubmitData(ctx sdk.Context, data types.SubmitDataSet) error {
 	if data.RequestId == 0 {
 		return errorsmod.Wrapf(types.ErrInvalidRequestId, "request id is 0")
 	}
@@ -317,6 +460,9 @@ func (k Keeper) validateSubmitData(data types.SubmitDataSet) error {
 	if data.RawData == "" {
 		return errorsmod.Wrapf(types.ErrInvalidRawData, "raw data is empty")
 	}
+	if limit := k.GetParams(ctx).MaxRawDataBytes; uint64(len(data.RawData)) > limit {
+		return errorsmod.Wrapf(types.ErrRawDataTooLarge, "raw data is %d bytes, limit is %d", len(data.RawData), limit)
+	}
 	return nil
 }
 
@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
@@ -13,8 +17,10 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	"github.com/gurufinglobal/guru/v2/x/oracle/types"
 )
@@ -35,7 +41,7 @@ func setupKeeper(t *testing.T) (*Keeper, sdk.Context) {
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
 
-	keeper := NewKeeper(cdc, storeKey, "cosmos1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft", nil)
+	keeper := NewKeeper(cdc, storeKey, "cosmos1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft", nil, nil)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{ChainID: "test-chain"}, false, log.NewNopLogger()).
 		WithGasMeter(storetypes.NewInfiniteGasMeter())
@@ -169,3 +175,312 @@ func TestGetOracleData(t *testing.T) {
 	assert.Equal(t, dataSet.BlockHeight, response.DataSet.BlockHeight)
 	assert.Equal(t, dataSet.BlockTime, response.DataSet.BlockTime)
 }
+
+func TestOracleSubmitDataPagination(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	providers := []string{
+		"guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft",
+		"guru1provider2",
+		"guru1provider3",
+	}
+	for i, provider := range providers {
+		keeper.SetSubmitData(ctx, types.SubmitDataSet{
+			RequestId: 1,
+			Nonce:     2,
+			Provider:  provider,
+			RawData:   fmt.Sprintf("%d", 100+i),
+		})
+	}
+	// Another round must not leak into the results
+	keeper.SetSubmitData(ctx, types.SubmitDataSet{RequestId: 1, Nonce: 3, Provider: providers[0], RawData: "999"})
+
+	first, err := keeper.OracleSubmitData(ctx, &types.QueryOracleSubmitDataRequest{
+		RequestId:  1,
+		Nonce:      2,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, first.SubmitDatas, 2)
+	require.Equal(t, uint64(3), first.Pagination.Total)
+	require.NotEmpty(t, first.Pagination.NextKey)
+
+	second, err := keeper.OracleSubmitData(ctx, &types.QueryOracleSubmitDataRequest{
+		RequestId:  1,
+		Nonce:      2,
+		Pagination: &query.PageRequest{Key: first.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, second.SubmitDatas, 1)
+	require.Empty(t, second.Pagination.NextKey)
+
+	// A provider lookup returns just that submission
+	single, err := keeper.OracleSubmitData(ctx, &types.QueryOracleSubmitDataRequest{RequestId: 1, Nonce: 3, Provider: providers[0]})
+	require.NoError(t, err)
+	require.Len(t, single.SubmitDatas, 1)
+	require.Equal(t, "999", single.SubmitDatas[0].RawData)
+}
+
+func TestPendingRound(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	require.NoError(t, keeper.SetParams(ctx, types.DefaultParams()))
+
+	keeper.SetOracleRequestDoc(ctx, types.OracleRequestDoc{
+		RequestId:       1,
+		Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+		AccountList:     []string{"guru1provider1", "guru1provider2", "guru1provider3", "guru1provider4"},
+		Quorum:          3,
+		Nonce:           4,
+		AggregationRule: types.AggregationRule_AGGREGATION_RULE_AVG,
+	})
+
+	// No reports yet
+	res, err := keeper.PendingRound(ctx, &types.QueryPendingRoundRequest{RequestId: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), res.Nonce)
+	require.Zero(t, res.Reports)
+	require.Equal(t, uint32(3), res.Quorum)
+	require.Empty(t, res.ProvisionalValue)
+
+	for i, value := range []string{"100", "102", "10100"} {
+		keeper.SetSubmitData(ctx, types.SubmitDataSet{
+			RequestId: 1,
+			Nonce:     5,
+			Provider:  fmt.Sprintf("guru1provider%d", i+1),
+			RawData:   value,
+		})
+	}
+
+	// The outlier is counted as a report but left out of the provisional value
+	res, err = keeper.PendingRound(ctx, &types.QueryPendingRoundRequest{RequestId: 1, Nonce: 5})
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), res.Reports)
+	require.Equal(t, uint32(2), res.Accepted)
+	require.Equal(t, "101", res.ProvisionalValue)
+	require.False(t, res.QuorumMet)
+
+	// A fourth report brings the accepted reports to the quorum
+	keeper.SetSubmitData(ctx, types.SubmitDataSet{RequestId: 1, Nonce: 5, Provider: "guru1provider4", RawData: "101"})
+	res, err = keeper.PendingRound(ctx, &types.QueryPendingRoundRequest{RequestId: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), res.Accepted)
+	require.True(t, res.QuorumMet)
+
+	// Nothing is finalized by the query
+	doc, err := keeper.GetOracleRequestDoc(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), doc.Nonce)
+
+	// A full coverage request is not ready while a report is excluded, and the BeginBlocker agrees
+	doc.CoverageMode = types.CoverageMode_COVERAGE_MODE_FULL
+	keeper.SetOracleRequestDoc(ctx, *doc)
+	res, err = keeper.PendingRound(ctx, &types.QueryPendingRoundRequest{RequestId: 1})
+	require.NoError(t, err)
+	require.False(t, res.QuorumMet)
+	keeper.ProcessOracleDataSetAggregation(ctx)
+	doc, err = keeper.GetOracleRequestDoc(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), doc.Nonce)
+
+	_, err = keeper.PendingRound(ctx, &types.QueryPendingRoundRequest{RequestId: 1, Nonce: 4})
+	require.ErrorIs(t, err, types.ErrNonceMismatch)
+}
+
+func TestLatestResults(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	for id, nonce := range map[uint64]uint64{1: 3, 2: 7, 3: 0} {
+		keeper.SetOracleRequestDoc(ctx, types.OracleRequestDoc{RequestId: id, Nonce: nonce})
+		if nonce > 0 {
+			keeper.SetDataSet(ctx, types.DataSet{RequestId: id, Nonce: nonce, RawData: fmt.Sprintf("%d", id*100)})
+		}
+	}
+
+	// Request 3 has not finalized a round and is left out
+	res, err := keeper.LatestResults(ctx, &types.QueryLatestResultsRequest{RequestIds: []uint64{1, 2, 3}})
+	require.NoError(t, err)
+	require.Len(t, res.Results, 2)
+	require.Equal(t, uint64(3), res.Results[1].Nonce)
+	require.Equal(t, "100", res.Results[1].RawData)
+	require.Equal(t, uint64(7), res.Results[2].Nonce)
+	require.Equal(t, "200", res.Results[2].RawData)
+
+	_, err = keeper.LatestResults(ctx, &types.QueryLatestResultsRequest{RequestIds: []uint64{1, 4}})
+	require.ErrorIs(t, err, types.ErrRequestNotFound)
+
+	_, err = keeper.LatestResults(ctx, &types.QueryLatestResultsRequest{})
+	require.Error(t, err)
+
+	_, err = keeper.LatestResults(ctx, &types.QueryLatestResultsRequest{RequestIds: make([]uint64, types.MaxLatestResultsRequestIds+1)})
+	require.ErrorContains(t, err, "too many request ids")
+}
+
+func TestModuleState(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	moderator := sdk.AccAddress([]byte("moderator___________")).String()
+	require.NoError(t, keeper.SetModeratorAddress(ctx, moderator))
+	for id, status := range map[uint64]types.RequestStatus{
+		1: types.RequestStatus_REQUEST_STATUS_ENABLED,
+		2: types.RequestStatus_REQUEST_STATUS_PAUSED,
+		3: types.RequestStatus_REQUEST_STATUS_ENABLED,
+	} {
+		keeper.SetOracleRequestDoc(ctx, types.OracleRequestDoc{RequestId: id, Status: status})
+	}
+	keeper.SetOracleRequestDocCount(ctx, 3)
+
+	res, err := keeper.ModuleState(ctx, &types.QueryModuleStateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), res.Params)
+	require.Equal(t, moderator, res.ModeratorAddress)
+	require.Equal(t, uint64(3), res.RequestCount)
+	require.Equal(t, uint64(2), res.EnabledRequestCount)
+}
+
+func TestRequestsByAccount(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+	bob := sdk.AccAddress([]byte("bob_________________")).String()
+	carol := sdk.AccAddress([]byte("carol_______________")).String()
+
+	keeper.SetOracleRequestDoc(ctx, types.OracleRequestDoc{RequestId: 1, AccountList: []string{alice, bob}})
+	keeper.SetOracleRequestDoc(ctx, types.OracleRequestDoc{RequestId: 2, AccountList: []string{bob}})
+	keeper.SetOracleRequestDoc(ctx, types.OracleRequestDoc{RequestId: 3, AccountList: []string{alice, carol}})
+
+	requestIds := func(account string) []uint64 {
+		var ids []uint64
+		keeper.IterateRequestsByAccount(ctx, account, func(doc types.OracleRequestDoc) bool {
+			ids = append(ids, doc.RequestId)
+			return false
+		})
+		return ids
+	}
+
+	require.Equal(t, []uint64{1, 3}, requestIds(alice))
+	require.Equal(t, []uint64{1, 2}, requestIds(bob))
+	require.Equal(t, []uint64{3}, requestIds(carol))
+
+	// Replacing the account list moves the index entries
+	keeper.SetOracleRequestDoc(ctx, types.OracleRequestDoc{RequestId: 1, AccountList: []string{carol}})
+	require.Equal(t, []uint64{3}, requestIds(alice))
+	require.Equal(t, []uint64{2}, requestIds(bob))
+	require.Equal(t, []uint64{1, 3}, requestIds(carol))
+
+	res, err := keeper.RequestsByAccount(ctx, &types.QueryRequestsByAccountRequest{
+		Account:    carol,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.RequestDocs, 1)
+	require.Equal(t, uint64(1), res.RequestDocs[0].RequestId)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+
+	res, err = keeper.RequestsByAccount(ctx, &types.QueryRequestsByAccountRequest{
+		Account:    carol,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.RequestDocs, 1)
+	require.Equal(t, uint64(3), res.RequestDocs[0].RequestId)
+
+	// An upper-case address finds the documents listing its canonical form
+	upperCarol := strings.ToUpper(carol)
+	require.Equal(t, []uint64{1, 3}, requestIds(upperCarol))
+	res, err = keeper.RequestsByAccount(ctx, &types.QueryRequestsByAccountRequest{Account: upperCarol})
+	require.NoError(t, err)
+	require.Len(t, res.RequestDocs, 2)
+
+	_, err = keeper.RequestsByAccount(ctx, &types.QueryRequestsByAccountRequest{Account: "not-an-address"})
+	require.Error(t, err)
+	require.Empty(t, requestIds("not-an-address"))
+}
+
+func TestMigrate1to2BuildsAccountIndex(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+
+	// Documents written before the index existed
+	store := ctx.KVStore(keeper.storeKey)
+	for _, id := range []uint64{1, 2} {
+		doc := types.OracleRequestDoc{RequestId: id, AccountList: []string{alice}}
+		store.Set(types.GetOracleRequestDocKey(id), keeper.cdc.MustMarshal(&doc))
+	}
+
+	res, err := keeper.RequestsByAccount(ctx, &types.QueryRequestsByAccountRequest{Account: alice})
+	require.NoError(t, err)
+	require.Empty(t, res.RequestDocs)
+
+	require.NoError(t, NewMigrator(*keeper).Migrate1to2(ctx))
+
+	res, err = keeper.RequestsByAccount(ctx, &types.QueryRequestsByAccountRequest{Account: alice})
+	require.NoError(t, err)
+	require.Len(t, res.RequestDocs, 2)
+}
+
+func TestMigrate2to3NormalizesAccountLists(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+	upperAlice := strings.ToUpper(alice)
+
+	// A document stored before account lists were normalized
+	doc := types.OracleRequestDoc{RequestId: 1, AccountList: []string{upperAlice}}
+	keeper.SetOracleRequestDoc(ctx, doc)
+
+	require.NoError(t, NewMigrator(*keeper).Migrate2to3(ctx))
+
+	stored, err := keeper.GetOracleRequestDoc(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{alice}, stored.AccountList)
+
+	// The account index moved to the canonical address
+	res, err := keeper.RequestsByAccount(ctx, &types.QueryRequestsByAccountRequest{Account: alice})
+	require.NoError(t, err)
+	require.Len(t, res.RequestDocs, 1)
+	require.False(t, ctx.KVStore(keeper.storeKey).Has(types.GetAccountRequestIndexKey(upperAlice, 1)))
+}
+
+// withoutFields returns an encoded message without the given fields, as written before they existed
+func withoutFields(t *testing.T, bz []byte, fields ...protowire.Number) []byte {
+	var out []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.GreaterOrEqual(t, m, 0)
+		if !slices.Contains(fields, num) {
+			out = append(out, bz[:n+m]...)
+		}
+		bz = bz[n+m:]
+	}
+	return out
+}
+
+func TestMigrate3to4SetsParamDefaults(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	// Params stored before max_magnitude_ratio and max_raw_data_bytes existed
+	params := types.DefaultParams()
+	params.SubmitWindow = 600
+	bz := withoutFields(t, keeper.cdc.MustMarshal(&params), 6, 7)
+	ctx.KVStore(keeper.storeKey).Set(types.KeyParams, bz)
+	require.True(t, keeper.GetParams(ctx).MaxMagnitudeRatio.IsNil())
+	require.Zero(t, keeper.GetParams(ctx).MaxRawDataBytes)
+	require.ErrorContains(t, keeper.GetParams(ctx).Validate(), "max raw data bytes cannot be zero")
+
+	require.NoError(t, NewMigrator(*keeper).Migrate3to4(ctx))
+
+	migrated := keeper.GetParams(ctx)
+	require.Equal(t, types.DefaultParams().MaxMagnitudeRatio, migrated.MaxMagnitudeRatio)
+	require.Equal(t, types.DefaultParams().MaxRawDataBytes, migrated.MaxRawDataBytes)
+	require.Equal(t, uint64(600), migrated.SubmitWindow)
+	require.NoError(t, migrated.Validate())
+
+	// A ratio an operator set, including zero to disable the filter, is kept
+	params.MaxMagnitudeRatio = math.LegacyZeroDec()
+	require.NoError(t, keeper.SetParams(ctx, params))
+	require.NoError(t, NewMigrator(*keeper).Migrate3to4(ctx))
+	require.True(t, keeper.GetParams(ctx).MaxMagnitudeRatio.IsZero())
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gurufinglobal/guru/v2/x/oracle/types"
+)
+
+// Migrator handles in-place store migrations of the oracle module
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 builds the account index for the request documents stored before it existed
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	for _, doc := range m.keeper.GetOracleRequestDocs(ctx) {
+		m.keeper.setAccountRequestIndex(ctx, *doc)
+	}
+	return nil
+}
+
+// Migrate2to3 rewrites the account lists of stored request documents in canonical bech32 form.
+// Storing a document again moves its account index entries to the canonical addresses.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	for _, doc := range m.keeper.GetOracleRequestDocs(ctx) {
+		accounts, err := types.NormalizeAccountList(doc.AccountList)
+		if err != nil {
+			m.keeper.Logger(ctx).Error("request document keeps its account list", "request_id", doc.RequestId, "error", err)
+			continue
+		}
+		doc.AccountList = accounts
+		m.keeper.SetOracleRequestDoc(ctx, *doc)
+	}
+	return nil
+}
+
+// Migrate3to4 sets params added after the stored ones were written to their defaults
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	defaults := types.DefaultParams()
+
+	// Stored before the magnitude filter existed, the ratio decodes as nil and would leave the filter off
+	if params.MaxMagnitudeRatio.IsNil() {
+		params.MaxMagnitudeRatio = defaults.MaxMagnitudeRatio
+	}
+	// Stored before the raw data limit existed, the limit decodes as zero and would reject every submission
+	if params.MaxRawDataBytes == 0 {
+		params.MaxRawDataBytes = defaults.MaxRawDataBytes
+	}
+
+	return m.keeper.SetParams(ctx, params)
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"encoding/json"
 	"strings"
 
 	"fmt"
@@ -11,7 +10,6 @@ import (
 	"github.com/gurufinglobal/guru/v2/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/ethereum/go-ethereum/crypto"
 )
 
 // MsgServer implementation
@@ -24,10 +22,10 @@ func (k Keeper) RegisterOracleRequestDoc(c context.Context, doc *types.MsgRegist
 	moderatorAddress := k.GetModeratorAddress(ctx)
 
 	if moderatorAddress == "" {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "moderator address is not set")
+		return nil, errorsmod.Wrap(types.ErrModeratorNotSet, "moderator address is not set")
 	}
 	if moderatorAddress != doc.ModeratorAddress {
-		return nil, errorsmod.Wrap(errortypes.ErrUnauthorized, "moderator address is not authorized")
+		return nil, errorsmod.Wrap(types.ErrUnauthorizedModerator, "moderator address is not authorized")
 	}
 
 	// Get the current count of oracle request documents
@@ -45,23 +43,41 @@ func (k Keeper) RegisterOracleRequestDoc(c context.Context, doc *types.MsgRegist
 		Quorum:          doc.RequestDoc.Quorum,
 		Endpoints:       doc.RequestDoc.Endpoints,
 		AggregationRule: doc.RequestDoc.AggregationRule,
+
+		EndpointAssignment:  doc.RequestDoc.EndpointAssignment,
+		QuorumMode:          doc.RequestDoc.QuorumMode,
+		QuorumStakeFraction: doc.RequestDoc.QuorumStakeFraction,
+		FallbackRules:       doc.RequestDoc.FallbackRules,
+		ResultFormat:        doc.RequestDoc.ResultFormat,
+		CoverageMode:        doc.RequestDoc.CoverageMode,
+	}
+
+	// Store accounts in canonical form so they match the signer addresses of submissions
+	accountList, err := types.NormalizeAccountList(oracleRequestDoc.AccountList)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequestDoc, err.Error())
 	}
+	oracleRequestDoc.AccountList = accountList
 
 	// Validate the oracle request document with current parameters
 	params := k.GetParams(ctx)
-	err := oracleRequestDoc.ValidateWithParams(params)
+	err = oracleRequestDoc.ValidateWithParams(params)
 	if err != nil {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		return nil, errorsmod.Wrap(types.ErrInvalidRequestDoc, err.Error())
 	}
 
-	// Store the oracle request document
+	// Store the oracle request document and open its first round
 	k.SetOracleRequestDoc(ctx, oracleRequestDoc)
+	k.startRound(ctx, oracleRequestDoc.RequestId)
 
 	// Increment the count
 	k.SetOracleRequestDocCount(ctx, count+1)
 
-	// Marshal the endpoints to a JSON string
-	endpointsJson, _ := json.Marshal(oracleRequestDoc.Endpoints)
+	// Marshal the endpoints to a canonical JSON string
+	endpointsJson, err := types.EndpointsAttribute(oracleRequestDoc.Endpoints)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrJSONMarshal, err.Error())
+	}
 
 	// Emit event for registering oracle request document
 	ctx.EventManager().EmitEvent(
@@ -73,7 +89,7 @@ func (k Keeper) RegisterOracleRequestDoc(c context.Context, doc *types.MsgRegist
 			sdk.NewAttribute(types.AttributeKeyDescription, oracleRequestDoc.Description),
 			sdk.NewAttribute(types.AttributeKeyPeriod, fmt.Sprint(oracleRequestDoc.Period)),
 			sdk.NewAttribute(types.AttributeKeyAccountList, strings.Join(oracleRequestDoc.AccountList, ",")),
-			sdk.NewAttribute(types.AttributeKeyEndpoints, string(endpointsJson)),
+			sdk.NewAttribute(types.AttributeKeyEndpoints, endpointsJson),
 			sdk.NewAttribute(types.AttributeKeyAggregationRule, string(oracleRequestDoc.AggregationRule)),
 			sdk.NewAttribute(types.AttributeKeyStatus, string(oracleRequestDoc.Status)),
 		),
@@ -91,19 +107,22 @@ func (k Keeper) UpdateOracleRequestDoc(c context.Context, doc *types.MsgUpdateOr
 	moderatorAddress := k.GetModeratorAddress(ctx)
 
 	if moderatorAddress == "" {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "moderator address is not set")
+		return nil, errorsmod.Wrap(types.ErrModeratorNotSet, "moderator address is not set")
 	}
 	if moderatorAddress != doc.ModeratorAddress {
-		return nil, errorsmod.Wrap(errortypes.ErrUnauthorized, "moderator address is not authorized")
+		return nil, errorsmod.Wrap(types.ErrUnauthorizedModerator, "moderator address is not authorized")
 	}
 
 	err := k.updateOracleRequestDoc(ctx, doc.RequestDoc)
 	if err != nil {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		return nil, err
 	}
 
-	// Marshal the endpoints to a JSON string
-	endpointsJson, _ := json.Marshal(doc.RequestDoc.Endpoints)
+	// Marshal the endpoints to a canonical JSON string
+	endpointsJson, err := types.EndpointsAttribute(doc.RequestDoc.Endpoints)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrJSONMarshal, err.Error())
+	}
 
 	// Emit event for updating oracle request document
 	ctx.EventManager().EmitEvent(
@@ -115,7 +134,7 @@ func (k Keeper) UpdateOracleRequestDoc(c context.Context, doc *types.MsgUpdateOr
 			sdk.NewAttribute(types.AttributeKeyDescription, doc.RequestDoc.Description),
 			sdk.NewAttribute(types.AttributeKeyPeriod, fmt.Sprint(doc.RequestDoc.Period)),
 			sdk.NewAttribute(types.AttributeKeyAccountList, strings.Join(doc.RequestDoc.AccountList, ",")),
-			sdk.NewAttribute(types.AttributeKeyEndpoints, string(endpointsJson)),
+			sdk.NewAttribute(types.AttributeKeyEndpoints, endpointsJson),
 			sdk.NewAttribute(types.AttributeKeyAggregationRule, string(doc.RequestDoc.AggregationRule)),
 			sdk.NewAttribute(types.AttributeKeyStatus, string(doc.RequestDoc.Status)),
 			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(doc.RequestDoc.Nonce)),
@@ -136,25 +155,30 @@ func (k Keeper) SubmitOracleData(c context.Context, msg *types.MsgSubmitOracleDa
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "DataSet must be provided")
 	}
 
-	err := k.validateSubmitData(*msg.DataSet)
+	err := k.validateSubmitData(ctx, *msg.DataSet)
 	if err != nil {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		return nil, err
 	}
 
 	requestId := msg.DataSet.RequestId
 
 	requestDoc, err := k.GetOracleRequestDoc(ctx, requestId)
 	if err != nil {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "request document not found")
+		return nil, err
 	}
 
 	if requestDoc == nil {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "request document not found")
+		return nil, errorsmod.Wrapf(types.ErrRequestNotFound, "request %d", requestId)
 	}
 
 	// Check if RequestDoc status is ENABLED
 	if requestDoc.Status != types.RequestStatus_REQUEST_STATUS_ENABLED {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "request document is not enabled")
+		return nil, errorsmod.Wrapf(types.ErrRequestNotEnabled, "request %d status is %s", requestId, requestDoc.Status)
+	}
+
+	// Reject content that consumers of this oracle type cannot use
+	if err := types.ValidateRawData(requestDoc.OracleType, msg.DataSet.RawData, k.GetParams(ctx)); err != nil {
+		return nil, err
 	}
 
 	accountList := requestDoc.AccountList
@@ -162,18 +186,18 @@ func (k Keeper) SubmitOracleData(c context.Context, msg *types.MsgSubmitOracleDa
 
 	isAuthorized := k.checkAccountAuthorized(accountList, fromAddress)
 	if !isAuthorized {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "account is not authorized")
+		return nil, errorsmod.Wrapf(types.ErrProviderNotAuthorized, "account %s is not authorized", fromAddress)
 	}
 
 	nonce := requestDoc.GetNonce()
 
 	if msg.DataSet.Nonce != nonce+1 {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "nonce is not correct")
+		return nil, errorsmod.Wrapf(types.ErrNonceMismatch, "nonce is not correct: expected %d, got %d", nonce+1, msg.DataSet.Nonce)
 	}
 
 	err = k.verifySubmitData(ctx, msg)
 	if err != nil {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		return nil, err
 	}
 
 	k.SetSubmitData(ctx, *msg.DataSet)
@@ -232,10 +256,10 @@ func (k Keeper) UpdateModeratorAddress(c context.Context, msg *types.MsgUpdateMo
 	currentModeratorAddress := k.GetModeratorAddress(ctx)
 
 	if currentModeratorAddress != msg.ModeratorAddress {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "from address is different from current moderator address")
+		return nil, errorsmod.Wrap(types.ErrUnauthorizedModerator, "from address is different from current moderator address")
 	}
 	if currentModeratorAddress == "" {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "moderator address is not set")
+		return nil, errorsmod.Wrap(types.ErrModeratorNotSet, "moderator address is not set")
 	}
 	if currentModeratorAddress == msg.NewModeratorAddress {
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "new moderator address is same as current moderator address")
@@ -253,25 +277,58 @@ func (k Keeper) UpdateModeratorAddress(c context.Context, msg *types.MsgUpdateMo
 	return &types.MsgUpdateModeratorAddressResponse{}, nil
 }
 
-func (k Keeper) verifySubmitData(ctx context.Context, msg *types.MsgSubmitOracleData) error {
-	if msg == nil || msg.DataSet == nil {
-		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "missing dataset")
+// CancelRound defines a method for the moderator to abort the open round of a request.
+// The round's reports are deleted and the nonce advances without storing a data set, so the next round starts empty.
+func (k Keeper) CancelRound(c context.Context, msg *types.MsgCancelRound) (*types.MsgCancelRoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	moderatorAddress := k.GetModeratorAddress(ctx)
+
+	if moderatorAddress == "" {
+		return nil, errorsmod.Wrap(types.ErrModeratorNotSet, "moderator address is not set")
+	}
+	if moderatorAddress != msg.ModeratorAddress {
+		return nil, errorsmod.Wrap(types.ErrUnauthorizedModerator, "moderator address is not authorized")
 	}
 
-	signBytes, err := msg.DataSet.Bytes()
+	requestDoc, err := k.GetOracleRequestDoc(ctx, msg.RequestId)
 	if err != nil {
-		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		return nil, err
 	}
 
-	sig := msg.DataSet.Signature
-	if len(sig) != crypto.SignatureLength {
-		return errorsmod.Wrap(errortypes.ErrUnauthorized, "invalid signature length")
+	// Only the open round can be cancelled; finalized rounds are immutable
+	if msg.Nonce != requestDoc.Nonce+1 {
+		return nil, errorsmod.Wrapf(types.ErrNonceMismatch, "open round is %d, got %d", requestDoc.Nonce+1, msg.Nonce)
 	}
-	if sig[64] >= 27 {
-		sig[64] -= 27
+
+	cleared := k.DeleteSubmitDatas(ctx, msg.RequestId, msg.Nonce)
+
+	requestDoc.Nonce = msg.Nonce
+	k.SetOracleRequestDoc(ctx, *requestDoc)
+	k.startRound(ctx, msg.RequestId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRoundCancelled,
+			sdk.NewAttribute(types.AttributeKeyRequestId, fmt.Sprint(msg.RequestId)),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(msg.Nonce)),
+			sdk.NewAttribute(types.AttributeKeyReports, fmt.Sprint(cleared)),
+			sdk.NewAttribute(types.AttributeKeyBlockTime, fmt.Sprint(ctx.BlockTime().Unix())),
+			sdk.NewAttribute(types.AttributeKeyModeratorAddress, msg.ModeratorAddress),
+		),
+	)
+
+	return &types.MsgCancelRoundResponse{ClearedReports: uint64(cleared)}, nil
+}
+
+func (k Keeper) verifySubmitData(ctx context.Context, msg *types.MsgSubmitOracleData) error {
+	if msg == nil || msg.DataSet == nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "missing dataset")
 	}
-	if sig[64] != 0 && sig[64] != 1 {
-		return errorsmod.Wrap(errortypes.ErrUnauthorized, "invalid signature recovery id")
+
+	signBytes, err := msg.DataSet.Bytes(sdk.UnwrapSDKContext(ctx).ChainID())
+	if err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
 	}
 
 	providerAcc, err := sdk.AccAddressFromBech32(msg.DataSet.Provider)
@@ -283,11 +340,18 @@ func (k Keeper) verifySubmitData(ctx context.Context, msg *types.MsgSubmitOracle
 	if acc == nil {
 		return errorsmod.Wrap(errortypes.ErrUnauthorized, "account not found")
 	}
-	if acc.GetPubKey() == nil {
+	pubKey := acc.GetPubKey()
+	if pubKey == nil {
 		return errorsmod.Wrap(errortypes.ErrUnauthorized, "public key not found")
 	}
 
-	if !acc.GetPubKey().VerifySignature(signBytes, sig) {
+	// The signature scheme follows the provider's key type
+	sig, err := types.SubmitSignature(pubKey, msg.DataSet.Signature)
+	if err != nil {
+		return errorsmod.Wrap(errortypes.ErrUnauthorized, err.Error())
+	}
+
+	if !pubKey.VerifySignature(signBytes, sig) {
 		return errorsmod.Wrap(errortypes.ErrUnauthorized, "invalid dataset signature")
 	}
 
@@ -1,9 +1,18 @@
 package keeper
 
 import (
+	"context"
+	"strings"
 	"testing"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/gurufinglobal/guru/v2/crypto/ethsecp256k1"
 	"github.com/gurufinglobal/guru/v2/x/oracle/types"
 	"github.com/stretchr/testify/require"
 )
@@ -128,3 +137,410 @@ func TestSubmitOracleDataEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestSubmitOracleDataRawDataByType(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	provider := "guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft"
+	doc := types.OracleRequestDoc{
+		RequestId:       1,
+		Name:            "BTC/USD",
+		OracleType:      types.OracleType_ORACLE_TYPE_CRYPTO,
+		Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+		AccountList:     []string{provider},
+		Quorum:          1,
+		Period:          60,
+		Endpoints:       []*types.OracleEndpoint{{Url: "https://api.coinbase.com/v2/prices/BTC-USD/spot", ParseRule: "data.amount"}},
+		AggregationRule: types.AggregationRule_AGGREGATION_RULE_AVG,
+	}
+	keeper.SetOracleRequestDoc(ctx, doc)
+
+	for _, rawData := range []string{"abc", "Inf", "1e5", "-1", "0"} {
+		msg := &types.MsgSubmitOracleData{
+			AuthorityAddress: provider,
+			DataSet: &types.SubmitDataSet{
+				RequestId: 1,
+				Nonce:     1,
+				RawData:   rawData,
+				Provider:  provider,
+				Signature: []byte{1},
+			},
+		}
+
+		response, err := keeper.SubmitOracleData(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrInvalidRawData, rawData)
+		codespace, code, _ := errorsmod.ABCIInfo(err, false)
+		require.Equal(t, types.ModuleName, codespace, rawData)
+		require.Equal(t, types.ErrInvalidRawData.ABCICode(), code, rawData)
+		require.Nil(t, response)
+		require.Contains(t, err.Error(), "raw data", rawData)
+	}
+}
+
+func TestSubmitOracleDataRawValueOutOfBounds(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	params := types.DefaultParams()
+	params.MinRawValue = math.LegacyNewDec(1000)
+	params.MaxRawValue = math.LegacyNewDec(1000000)
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	provider := "guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft"
+	keeper.SetOracleRequestDoc(ctx, types.OracleRequestDoc{
+		RequestId:       1,
+		Name:            "BTC/USD",
+		OracleType:      types.OracleType_ORACLE_TYPE_CRYPTO,
+		Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+		AccountList:     []string{provider},
+		Quorum:          1,
+		Period:          60,
+		Endpoints:       []*types.OracleEndpoint{{Url: "https://api.coinbase.com/v2/prices/BTC-USD/spot", ParseRule: "data.amount"}},
+		AggregationRule: types.AggregationRule_AGGREGATION_RULE_AVG,
+	})
+
+	// A price in cents instead of dollars and one off by a factor of a million
+	for _, rawData := range []string{"650.0012", "65000120000"} {
+		msg := &types.MsgSubmitOracleData{
+			AuthorityAddress: provider,
+			DataSet: &types.SubmitDataSet{
+				RequestId: 1,
+				Nonce:     1,
+				RawData:   rawData,
+				Provider:  provider,
+				Signature: []byte{1},
+			},
+		}
+
+		response, err := keeper.SubmitOracleData(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrInvalidRawData, rawData)
+		require.ErrorContains(t, err, "raw data", rawData)
+		require.Nil(t, response)
+	}
+}
+
+func TestSubmitOracleDataRequestNotEnabled(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	provider := "guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft"
+	for i, status := range []types.RequestStatus{
+		types.RequestStatus_REQUEST_STATUS_PAUSED,
+		types.RequestStatus_REQUEST_STATUS_DISABLED,
+	} {
+		requestId := uint64(i + 1)
+		keeper.SetOracleRequestDoc(ctx, types.OracleRequestDoc{
+			RequestId:       requestId,
+			Name:            "BTC/USD",
+			OracleType:      types.OracleType_ORACLE_TYPE_CRYPTO,
+			Status:          status,
+			AccountList:     []string{provider},
+			Quorum:          1,
+			Period:          60,
+			Endpoints:       []*types.OracleEndpoint{{Url: "https://api.coinbase.com/v2/prices/BTC-USD/spot", ParseRule: "data.amount"}},
+			AggregationRule: types.AggregationRule_AGGREGATION_RULE_AVG,
+		})
+
+		msg := &types.MsgSubmitOracleData{
+			AuthorityAddress: provider,
+			DataSet: &types.SubmitDataSet{
+				RequestId: requestId,
+				Nonce:     1,
+				RawData:   "100",
+				Provider:  provider,
+				Signature: []byte{1},
+			},
+		}
+
+		response, err := keeper.SubmitOracleData(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrRequestNotEnabled, status.String())
+		require.Nil(t, response)
+	}
+}
+
+func TestSubmitOracleDataErrorCodes(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	provider := "guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft"
+	keeper.SetOracleRequestDoc(ctx, types.OracleRequestDoc{
+		RequestId:       1,
+		Name:            "BTC/USD",
+		OracleType:      types.OracleType_ORACLE_TYPE_CRYPTO,
+		Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+		AccountList:     []string{provider},
+		Quorum:          1,
+		Period:          60,
+		Endpoints:       []*types.OracleEndpoint{{Url: "https://api.coinbase.com/v2/prices/BTC-USD/spot", ParseRule: "data.amount"}},
+		AggregationRule: types.AggregationRule_AGGREGATION_RULE_AVG,
+	})
+
+	testCases := []struct {
+		name      string
+		from      string
+		requestId uint64
+		nonce     uint64
+		expErr    error
+	}{
+		{"unknown request", provider, 2, 1, types.ErrRequestNotFound},
+		{"provider not in account list", "guru1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqdmxucx", 1, 1, types.ErrProviderNotAuthorized},
+		{"stale nonce", provider, 1, 2, types.ErrNonceMismatch},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := &types.MsgSubmitOracleData{
+				AuthorityAddress: tc.from,
+				DataSet: &types.SubmitDataSet{
+					RequestId: tc.requestId,
+					Nonce:     tc.nonce,
+					RawData:   "100",
+					Provider:  tc.from,
+					Signature: []byte{1},
+				},
+			}
+
+			response, err := keeper.SubmitOracleData(sdk.WrapSDKContext(ctx), msg)
+			require.ErrorIs(t, err, tc.expErr)
+			require.Nil(t, response)
+		})
+	}
+}
+
+func TestSubmitOracleDataRawDataTooLarge(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	require.NoError(t, keeper.SetParams(ctx, types.DefaultParams()))
+
+	provider := "guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft"
+	keeper.SetOracleRequestDoc(ctx, types.OracleRequestDoc{
+		RequestId:       1,
+		Name:            "BTC/USD",
+		OracleType:      types.OracleType_ORACLE_TYPE_CRYPTO,
+		Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+		AccountList:     []string{provider},
+		Quorum:          1,
+		Period:          60,
+		Endpoints:       []*types.OracleEndpoint{{Url: "https://api.coinbase.com/v2/prices/BTC-USD/spot", ParseRule: "data.amount"}},
+		AggregationRule: types.AggregationRule_AGGREGATION_RULE_AVG,
+	})
+
+	msg := &types.MsgSubmitOracleData{
+		AuthorityAddress: provider,
+		DataSet: &types.SubmitDataSet{
+			RequestId: 1,
+			Nonce:     1,
+			RawData:   "1" + strings.Repeat("0", int(types.DefaultParams().MaxRawDataBytes)),
+			Provider:  provider,
+			Signature: []byte{1},
+		},
+	}
+
+	response, err := keeper.SubmitOracleData(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrRawDataTooLarge)
+	require.Nil(t, response)
+}
+
+// mockAccountKeeper returns the configured accounts by address
+type mockAccountKeeper map[string]sdk.AccountI
+
+func (m mockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return m[addr.String()]
+}
+
+func TestVerifySubmitDataChainID(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	keeper.accountKeeper = mockAccountKeeper{
+		addr.String(): authtypes.NewBaseAccount(addr, privKey.PubKey(), 0, 0),
+	}
+
+	signed := func(chainID string) *types.MsgSubmitOracleData {
+		dataSet := &types.SubmitDataSet{RequestId: 1, Nonce: 1, RawData: "100", Provider: addr.String()}
+		signBytes, err := dataSet.Bytes(chainID)
+		require.NoError(t, err)
+		dataSet.Signature, err = privKey.Sign(signBytes)
+		require.NoError(t, err)
+		return &types.MsgSubmitOracleData{AuthorityAddress: addr.String(), DataSet: dataSet}
+	}
+
+	require.NoError(t, keeper.verifySubmitData(ctx, signed(ctx.ChainID())))
+
+	// A signature made for another chain must not be replayable here
+	err = keeper.verifySubmitData(ctx, signed("other-chain"))
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+	require.Contains(t, err.Error(), "invalid dataset signature")
+}
+
+func TestSubmitOracleDataEd25519Provider(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	privKey := ed25519.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	secpKey := secp256k1.GenPrivKey()
+	secpAddr := sdk.AccAddress(secpKey.PubKey().Address())
+	keeper.accountKeeper = mockAccountKeeper{
+		addr.String():     authtypes.NewBaseAccount(addr, privKey.PubKey(), 0, 0),
+		secpAddr.String(): authtypes.NewBaseAccount(secpAddr, secpKey.PubKey(), 0, 0),
+	}
+	keeper.SetOracleRequestDoc(ctx, types.OracleRequestDoc{
+		RequestId:       1,
+		Name:            "BTC/USD",
+		OracleType:      types.OracleType_ORACLE_TYPE_CRYPTO,
+		Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+		AccountList:     []string{addr.String(), secpAddr.String()},
+		Quorum:          1,
+		Period:          60,
+		Endpoints:       []*types.OracleEndpoint{{Url: "https://api.coinbase.com/v2/prices/BTC-USD/spot", ParseRule: "data.amount"}},
+		AggregationRule: types.AggregationRule_AGGREGATION_RULE_AVG,
+	})
+
+	signed := func(provider sdk.AccAddress, sign func([]byte) ([]byte, error)) *types.MsgSubmitOracleData {
+		dataSet := &types.SubmitDataSet{RequestId: 1, Nonce: 1, RawData: "100", Provider: provider.String()}
+		signBytes, err := dataSet.Bytes(ctx.ChainID())
+		require.NoError(t, err)
+		dataSet.Signature, err = sign(signBytes)
+		require.NoError(t, err)
+		return &types.MsgSubmitOracleData{AuthorityAddress: provider.String(), DataSet: dataSet}
+	}
+
+	// An ed25519 signature has no recovery id
+	msg := signed(addr, privKey.Sign)
+	require.Len(t, msg.DataSet.Signature, ed25519.SignatureSize)
+	_, err := keeper.SubmitOracleData(ctx, msg)
+	require.NoError(t, err)
+	reports, err := keeper.GetSubmitData(ctx, 1, 1, addr.String())
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+
+	// A signature in another key's shape is rejected before verification
+	msg = signed(addr, privKey.Sign)
+	msg.DataSet.Signature = append(msg.DataSet.Signature, 0)
+	err = keeper.verifySubmitData(ctx, msg)
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+	require.Contains(t, err.Error(), "invalid signature length")
+
+	// Key types other than eth_secp256k1 and ed25519 cannot sign datasets
+	err = keeper.verifySubmitData(ctx, signed(secpAddr, secpKey.Sign))
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+	require.Contains(t, err.Error(), "unsupported provider key type secp256k1")
+}
+
+func TestAccountListNormalization(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	moderator := sdk.AccAddress([]byte("moderator___________")).String()
+	require.NoError(t, keeper.SetModeratorAddress(ctx, moderator))
+
+	provider := "guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft"
+	other := sdk.AccAddress([]byte("other_______________")).String()
+	upper := strings.ToUpper(provider)
+
+	register := func(accounts ...string) (*types.MsgRegisterOracleRequestDocResponse, error) {
+		return keeper.RegisterOracleRequestDoc(ctx, &types.MsgRegisterOracleRequestDoc{
+			ModeratorAddress: moderator,
+			RequestDoc: types.OracleRequestDoc{
+				Name:            "BTC/USD",
+				OracleType:      types.OracleType_ORACLE_TYPE_CRYPTO,
+				Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+				AccountList:     accounts,
+				Quorum:          1,
+				Period:          60,
+				Endpoints:       []*types.OracleEndpoint{{Url: "https://api.coinbase.com/v2/prices/BTC-USD/spot", ParseRule: "data.amount"}},
+				AggregationRule: types.AggregationRule_AGGREGATION_RULE_AVG,
+			},
+		})
+	}
+
+	// An upper case address is stored in canonical form
+	res, err := register(upper)
+	require.NoError(t, err)
+	doc, err := keeper.GetOracleRequestDoc(ctx, res.RequestId)
+	require.NoError(t, err)
+	require.Equal(t, []string{provider}, doc.AccountList)
+
+	// Mixed case, foreign prefixes and the same account in two cases are rejected
+	_, err = register("guru1H9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft")
+	require.ErrorIs(t, err, types.ErrInvalidRequestDoc)
+	_, err = register("cosmos1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft")
+	require.ErrorIs(t, err, types.ErrInvalidRequestDoc)
+	_, err = register(provider, upper)
+	require.ErrorContains(t, err, "duplicate account")
+
+	// Updates normalize the new account list as well
+	_, err = keeper.UpdateOracleRequestDoc(ctx, &types.MsgUpdateOracleRequestDoc{
+		ModeratorAddress: moderator,
+		RequestDoc:       types.OracleRequestDoc{RequestId: res.RequestId, AccountList: []string{upper, strings.ToUpper(other)}, Quorum: 2},
+	})
+	require.NoError(t, err)
+	doc, err = keeper.GetOracleRequestDoc(ctx, res.RequestId)
+	require.NoError(t, err)
+	require.Equal(t, []string{provider, other}, doc.AccountList)
+
+	// Authorization compares account bytes, so lists stored before normalization still match
+	require.True(t, keeper.checkAccountAuthorized([]string{upper}, provider))
+	require.True(t, keeper.checkAccountAuthorized([]string{provider}, upper))
+	require.False(t, keeper.checkAccountAuthorized([]string{provider}, other))
+	require.False(t, keeper.checkAccountAuthorized([]string{"not-an-address"}, "not-an-address"))
+}
+
+func TestCancelRound(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	moderator := sdk.AccAddress([]byte("moderator___________")).String()
+	require.NoError(t, keeper.SetModeratorAddress(ctx, moderator))
+
+	providers := []string{
+		sdk.AccAddress([]byte("provider_1__________")).String(),
+		sdk.AccAddress([]byte("provider_2__________")).String(),
+	}
+	keeper.SetOracleRequestDoc(ctx, types.OracleRequestDoc{
+		RequestId:       1,
+		Name:            "BTC/USD",
+		OracleType:      types.OracleType_ORACLE_TYPE_CRYPTO,
+		Status:          types.RequestStatus_REQUEST_STATUS_ENABLED,
+		AccountList:     providers,
+		Quorum:          2,
+		Period:          60,
+		Nonce:           4,
+		Endpoints:       []*types.OracleEndpoint{{Url: "https://api.coinbase.com/v2/prices/BTC-USD/spot", ParseRule: "data.amount"}},
+		AggregationRule: types.AggregationRule_AGGREGATION_RULE_AVG,
+	})
+	keeper.SetSubmitData(ctx, types.SubmitDataSet{RequestId: 1, Nonce: 5, RawData: "100", Provider: providers[0]})
+	keeper.SetSubmitData(ctx, types.SubmitDataSet{RequestId: 1, Nonce: 5, RawData: "999", Provider: providers[1]})
+
+	// Only the moderator can cancel, and only the open round
+	_, err := keeper.CancelRound(ctx, types.NewMsgCancelRound(providers[0], 1, 5))
+	require.ErrorIs(t, err, types.ErrUnauthorizedModerator)
+	_, err = keeper.CancelRound(ctx, types.NewMsgCancelRound(moderator, 1, 4))
+	require.ErrorIs(t, err, types.ErrNonceMismatch)
+	_, err = keeper.CancelRound(ctx, types.NewMsgCancelRound(moderator, 2, 1))
+	require.ErrorIs(t, err, types.ErrRequestNotFound)
+
+	res, err := keeper.CancelRound(ctx, types.NewMsgCancelRound(moderator, 1, 5))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.ClearedReports)
+
+	// The reports are gone and the nonce advanced without a data set
+	reports, err := keeper.GetSubmitDatas(ctx, 1, 5)
+	require.NoError(t, err)
+	require.Empty(t, reports)
+	doc, err := keeper.GetOracleRequestDoc(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), doc.Nonce)
+	_, err = keeper.GetDataSet(ctx, 1, 5)
+	require.Error(t, err)
+
+	var cancelled sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeRoundCancelled {
+			cancelled = event
+		}
+	}
+	require.Equal(t, types.EventTypeRoundCancelled, cancelled.Type)
+	nonce, ok := cancelled.GetAttribute(types.AttributeKeyNonce)
+	require.True(t, ok)
+	require.Equal(t, "5", nonce.Value)
+
+	// The next round is open for new reports
+	_, err = keeper.CancelRound(ctx, types.NewMsgCancelRound(moderator, 1, 5))
+	require.ErrorIs(t, err, types.ErrNonceMismatch)
+}
@@ -3,8 +3,13 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
 	"github.com/gurufinglobal/guru/v2/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -69,13 +74,166 @@ func (k Keeper) ModeratorAddress(ctx context.Context, req *types.QueryModeratorA
 	}, nil
 }
 
+// OracleSubmitData queries the submissions for a request round.
+// A single provider is looked up directly; otherwise all submissions are paginated.
 func (k Keeper) OracleSubmitData(ctx context.Context, req *types.QueryOracleSubmitDataRequest) (*types.QueryOracleSubmitDataResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	submitDatas, err := k.GetSubmitData(sdkCtx, req.RequestId, req.Nonce, req.Provider)
+	if req.Provider != "" {
+		submitDatas, err := k.GetSubmitData(sdkCtx, req.RequestId, req.Nonce, req.Provider)
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryOracleSubmitDataResponse{
+			SubmitDatas: submitDatas,
+		}, nil
+	}
+
+	store := prefix.NewStore(sdkCtx.KVStore(k.storeKey), types.GetSubmitDataKey(req.RequestId, req.Nonce))
+
+	var submitDatas []*types.SubmitDataSet
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var data types.SubmitDataSet
+		if err := k.cdc.Unmarshal(value, &data); err != nil {
+			return err
+		}
+		submitDatas = append(submitDatas, &data)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
+
 	return &types.QueryOracleSubmitDataResponse{
 		SubmitDatas: submitDatas,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// RequestsByAccount queries the request documents that list an account as provider
+func (k Keeper) RequestsByAccount(ctx context.Context, req *types.QueryRequestsByAccountRequest) (*types.QueryRequestsByAccountResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid account address: %v", err)
+	}
+
+	// Account lists are stored in canonical form, so an upper-case address must match as well
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(sdkCtx.KVStore(k.storeKey), types.GetAccountRequestIndexPrefix(account.String()))
+
+	var docs []types.OracleRequestDoc
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		doc, err := k.GetOracleRequestDoc(sdkCtx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		docs = append(docs, *doc)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRequestsByAccountResponse{
+		RequestDocs: docs,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// PendingRound queries the reports received so far for the round currently collecting reports.
+// The provisional value is computed the same way as at finalization but nothing is stored.
+func (k Keeper) PendingRound(ctx context.Context, req *types.QueryPendingRoundRequest) (*types.QueryPendingRoundResponse, error) {
+	// The outlier filter logs exclusions, which is only wanted when a round actually finalizes
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithLogger(log.NewNopLogger())
+	doc, err := k.GetOracleRequestDoc(sdkCtx, req.RequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := req.Nonce
+	if nonce == 0 {
+		nonce = doc.Nonce + 1
+	}
+	if nonce != doc.Nonce+1 {
+		return nil, errorsmod.Wrapf(types.ErrNonceMismatch, "round %d is not pending: current round is %d", nonce, doc.Nonce+1)
+	}
+
+	reports, err := k.GetSubmitDatas(sdkCtx, doc.RequestId, nonce)
+	if err != nil {
+		return nil, err
+	}
+	accepted := k.acceptedReports(sdkCtx, *doc, nonce, reports)
+
+	quorumMet, _ := k.roundReady(sdkCtx, *doc, reports, accepted)
+
+	var value string
+	if len(accepted) > 0 {
+		// A failed aggregation leaves the value empty; the round would fail the same way
+		result, _ := k.aggregateWithFallback(sdkCtx, *doc, accepted)
+		value = result.value
+	}
+
+	return &types.QueryPendingRoundResponse{
+		RequestId:        doc.RequestId,
+		Nonce:            nonce,
+		Reports:          uint32(len(reports)),
+		Accepted:         uint32(len(accepted)),
+		Quorum:           doc.Quorum,
+		ProvisionalValue: value,
+		QuorumMet:        quorumMet,
+	}, nil
+}
+
+// LatestResults queries the latest data set of each request through its current nonce.
+// Unknown request ids fail the query; requests that have not finalized a round yet are omitted.
+func (k Keeper) LatestResults(ctx context.Context, req *types.QueryLatestResultsRequest) (*types.QueryLatestResultsResponse, error) {
+	if len(req.RequestIds) == 0 {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "at least one request id is required")
+	}
+	if len(req.RequestIds) > types.MaxLatestResultsRequestIds {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "too many request ids: %d (max: %d)", len(req.RequestIds), types.MaxLatestResultsRequestIds)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	results := make(map[uint64]*types.DataSet, len(req.RequestIds))
+	for _, id := range req.RequestIds {
+		doc, err := k.GetOracleRequestDoc(sdkCtx, id)
+		if err != nil {
+			return nil, err
+		}
+		if doc.Nonce == 0 {
+			continue
+		}
+
+		dataSet, err := k.GetDataSet(sdkCtx, id, doc.Nonce)
+		if err != nil {
+			return nil, err
+		}
+		results[id] = dataSet
+	}
+
+	return &types.QueryLatestResultsResponse{
+		Results: results,
+	}, nil
+}
+
+// ModuleState queries the params, the moderator address and the request counts in one call
+func (k Keeper) ModuleState(ctx context.Context, req *types.QueryModuleStateRequest) (*types.QueryModuleStateResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryModuleStateResponse{
+		Params:              k.GetParams(sdkCtx),
+		ModeratorAddress:    k.GetModeratorAddress(sdkCtx),
+		RequestCount:        k.GetOracleRequestDocCount(sdkCtx),
+		EnabledRequestCount: uint64(len(k.GetOracleRequestDocsByStatus(sdkCtx, types.RequestStatus_REQUEST_STATUS_ENABLED))),
+	}, nil
+}
+
+// RoundTiming queries the moving averages of how long the rounds of a request take to finalize
+func (k Keeper) RoundTiming(ctx context.Context, req *types.QueryRoundTimingRequest) (*types.QueryRoundTimingResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if _, err := k.GetOracleRequestDoc(sdkCtx, req.RequestId); err != nil {
+		return nil, err
+	}
+	return &types.QueryRoundTimingResponse{
+		RoundTiming: k.GetRoundTiming(sdkCtx, req.RequestId),
 	}, nil
 }
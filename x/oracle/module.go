@@ -24,7 +24,7 @@ import (
 )
 
 // consensusVersion defines the current x/oracle module consensus version.
-const consensusVersion = 1
+const consensusVersion = 4
 
 var (
 	_ module.AppModule           = AppModule{}
@@ -120,7 +120,16 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), &am.keeper)
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
-	// migration registration will be here in case of verion upgrade
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate %s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate %s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate %s from version 3 to 4: %v", types.ModuleName, err))
+	}
 }
 
 // BeginBlock returns the begin blocker for the oracle module.
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// AggregationFunc computes the result of a round from the submitted values.
+// It is called with at least one value and must not modify the slice elements.
+type AggregationFunc func(values []*big.Float) (*big.Float, error)
+
+// aggregationFuncs maps each supported AggregationRule to its implementation.
+// Adding a rule means adding an enum value in oracle.proto and an entry here.
+var aggregationFuncs = map[AggregationRule]AggregationFunc{
+	AggregationRule_AGGREGATION_RULE_AVG:    aggregateAverage,
+	AggregationRule_AGGREGATION_RULE_MIN:    aggregateMin,
+	AggregationRule_AGGREGATION_RULE_MAX:    aggregateMax,
+	AggregationRule_AGGREGATION_RULE_MEDIAN: aggregateMedian,
+}
+
+// WeightedAggregationFunc computes the result of a round from the submitted values and the
+// weight of each submission. It is called with at least one value and one weight per value.
+type WeightedAggregationFunc func(values []*big.Float, weights []sdkmath.Int) (*big.Float, error)
+
+// weightedAggregationFuncs maps each AggregationRule that weighs submissions by the bonded stake of their provider to its implementation
+var weightedAggregationFuncs = map[AggregationRule]WeightedAggregationFunc{
+	AggregationRule_AGGREGATION_RULE_STAKE_WEIGHTED_MEDIAN: aggregateWeightedMedian,
+}
+
+// GetAggregationFunc returns the implementation registered for rule
+func GetAggregationFunc(rule AggregationRule) (AggregationFunc, bool) {
+	fn, ok := aggregationFuncs[rule]
+	return fn, ok
+}
+
+// GetWeightedAggregationFunc returns the stake-weighted implementation registered for rule
+func GetWeightedAggregationFunc(rule AggregationRule) (WeightedAggregationFunc, bool) {
+	fn, ok := weightedAggregationFuncs[rule]
+	return fn, ok
+}
+
+// ValidateAggregationRule returns an error if rule has no registered implementation
+func ValidateAggregationRule(rule AggregationRule) error {
+	_, plain := aggregationFuncs[rule]
+	_, weighted := weightedAggregationFuncs[rule]
+	if !plain && !weighted {
+		return fmt.Errorf("unsupported aggregation rule: %s", rule)
+	}
+	return nil
+}
+
+// aggregateAverage returns the arithmetic mean of values
+func aggregateAverage(values []*big.Float) (*big.Float, error) {
+	sum := new(big.Float)
+	for _, value := range values {
+		sum.Add(sum, value)
+	}
+
+	return new(big.Float).Quo(sum, new(big.Float).SetInt64(int64(len(values)))), nil
+}
+
+// aggregateMin returns the smallest value
+func aggregateMin(values []*big.Float) (*big.Float, error) {
+	min := values[0]
+	for _, value := range values[1:] {
+		if value.Cmp(min) < 0 {
+			min = value
+		}
+	}
+	return min, nil
+}
+
+// aggregateMax returns the largest value
+func aggregateMax(values []*big.Float) (*big.Float, error) {
+	max := values[0]
+	for _, value := range values[1:] {
+		if value.Cmp(max) > 0 {
+			max = value
+		}
+	}
+	return max, nil
+}
+
+// aggregateMedian returns the middle value, or the mean of the two middle values for an even count
+func aggregateMedian(values []*big.Float) (*big.Float, error) {
+	sorted := make([]*big.Float, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) < 0
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		median := new(big.Float).Add(sorted[mid-1], sorted[mid])
+		return median.Quo(median, new(big.Float).SetInt64(2)), nil
+	}
+	return sorted[mid], nil
+}
+
+// aggregateWeightedMedian returns the smallest value at which the weights of the values up to it reach half of the total weight.
+// It fails when the weights add up to zero, e.g. because no submitting provider has bonded stake.
+func aggregateWeightedMedian(values []*big.Float, weights []sdkmath.Int) (*big.Float, error) {
+	total := sdkmath.ZeroInt()
+	for _, weight := range weights {
+		total = total.Add(weight)
+	}
+	if !total.IsPositive() {
+		return nil, errors.New("no submission carries weight")
+	}
+
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return values[order[i]].Cmp(values[order[j]]) < 0
+	})
+
+	cumulative := sdkmath.ZeroInt()
+	for _, i := range order {
+		cumulative = cumulative.Add(weights[i])
+		if cumulative.MulRaw(2).GTE(total) {
+			return values[i], nil
+		}
+	}
+	return values[order[len(order)-1]], nil
+}
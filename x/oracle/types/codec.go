@@ -26,6 +26,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateOracleRequestDoc{}, "oracle/UpdateOracleRequestDoc", nil)
 	cdc.RegisterConcrete(&MsgSubmitOracleData{}, "oracle/SubmitOracleData", nil)
 	cdc.RegisterConcrete(&MsgUpdateModeratorAddress{}, "oracle/UpdateModeratorAddress", nil)
+	cdc.RegisterConcrete(&MsgCancelRound{}, "oracle/CancelRound", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "oracle/UpdateParams", nil)
 }
 
@@ -36,6 +37,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateOracleRequestDoc{},
 		&MsgSubmitOracleData{},
 		&MsgUpdateModeratorAddress{},
+		&MsgCancelRound{},
 		&MsgUpdateParams{},
 	)
 
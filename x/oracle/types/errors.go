@@ -10,6 +10,14 @@ const (
 	codeInvalidProvider
 	codeInvalidRawData
 	codeQuorumNotMet
+	codeRequestNotEnabled
+	codeRequestNotFound
+	codeNonceMismatch
+	codeProviderNotAuthorized
+	codeModeratorNotSet
+	codeUnauthorizedModerator
+	codeInvalidRequestDoc
+	codeRawDataTooLarge
 )
 
 var (
@@ -18,4 +26,16 @@ var (
 	ErrInvalidProvider  = errorsmod.Register(ModuleName, codeInvalidProvider, "invalid provider")
 	ErrInvalidRawData   = errorsmod.Register(ModuleName, codeInvalidRawData, "invalid raw data")
 	ErrQuorumNotMet     = errorsmod.Register(ModuleName, codeQuorumNotMet, "quorum not met")
+
+	// ErrRequestNotEnabled is returned for submissions to a paused or disabled request.
+	// Providers use it to stop scheduling the request instead of retrying.
+	ErrRequestNotEnabled = errorsmod.Register(ModuleName, codeRequestNotEnabled, "request not enabled")
+
+	ErrRequestNotFound       = errorsmod.Register(ModuleName, codeRequestNotFound, "request not found")
+	ErrNonceMismatch         = errorsmod.Register(ModuleName, codeNonceMismatch, "nonce mismatch")
+	ErrProviderNotAuthorized = errorsmod.Register(ModuleName, codeProviderNotAuthorized, "provider not in account list")
+	ErrModeratorNotSet       = errorsmod.Register(ModuleName, codeModeratorNotSet, "moderator address not set")
+	ErrUnauthorizedModerator = errorsmod.Register(ModuleName, codeUnauthorizedModerator, "unauthorized moderator")
+	ErrInvalidRequestDoc     = errorsmod.Register(ModuleName, codeInvalidRequestDoc, "invalid request document")
+	ErrRawDataTooLarge       = errorsmod.Register(ModuleName, codeRawDataTooLarge, "raw data too large")
 )
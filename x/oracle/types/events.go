@@ -1,5 +1,10 @@
 package types
 
+import (
+	"bytes"
+	"encoding/json"
+)
+
 // Oracle module event type constants
 const (
 	// EventTypeRegisterOracleRequestDoc defines the event type for registering oracle request document
@@ -16,6 +21,12 @@ const (
 
 	// EventTypeSubmitOracleData defines the event type for submitting oracle data
 	EventTypeSubmitOracleData = "submit_oracle_data"
+
+	// EventTypeRoundCompleted defines the event type summarizing a finalized oracle round
+	EventTypeRoundCompleted = "round_completed"
+
+	// EventTypeRoundCancelled defines the event type for a round the moderator aborted without a result
+	EventTypeRoundCancelled = "round_cancelled"
 )
 
 // Event attribute keys
@@ -34,12 +45,44 @@ const (
 	AttributeKeyNonce            = "nonce"
 	AttributeKeyFromAddress      = "from_address"
 	AttributeKeyRawData          = "raw_data"
+	AttributeKeyRawAggregate     = "raw_aggregate"
 	AttributeKeyAggregationRule  = "aggregation_rule"
 	AttributeKeyQuorum           = "quorum"
 	AttributeKeyBlockHeight      = "block_height"
 	AttributeKeyBlockTime        = "block_time"
+	AttributeKeyReports          = "reports"
+	AttributeKeyExcluded         = "excluded"
+	AttributeKeyMissed           = "missed"
+	AttributeKeyRoundDuration    = "round_duration"
 )
 
 const (
 	AttributeKeyOracleDataNone = "oracle_data_nonce"
 )
+
+// EndpointsAttribute encodes endpoints as canonical JSON for event attributes.
+// Object keys are sorted so the attribute bytes do not depend on struct field order or Go version.
+func EndpointsAttribute(endpoints []*OracleEndpoint) (string, error) {
+	raw, err := json.Marshal(endpoints)
+	if err != nil {
+		return "", err
+	}
+
+	// Round-trip through generic values: encoding/json always writes map keys in sorted order
+	var generic any
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&generic); err != nil {
+		return "", err
+	}
+
+	// URLs are kept readable: '&', '<' and '>' are not HTML-escaped
+	var canonical bytes.Buffer
+	encoder := json.NewEncoder(&canonical)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(generic); err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimSuffix(canonical.Bytes(), []byte("\n"))), nil
+}
@@ -112,6 +112,23 @@ type Params struct {
 	// max_account_list_size defines the maximum size of the account list for oracle requests
 	// This also effectively limits the maximum submissions per aggregation since each account can only submit once(Updated when resubmitted)
 	MaxAccountListSize uint64 `protobuf:"varint,5,opt,name=max_account_list_size,json=maxAccountListSize,proto3" json:"max_account_list_size,omitempty"`
+	// max_magnitude_ratio defines the largest allowed ratio between a submission and the
+	// round median before the submission is excluded as a suspected unit mismatch.
+	// Zero disables the check.
+	MaxMagnitudeRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_magnitude_ratio,json=maxMagnitudeRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_magnitude_ratio"`
+	// max_raw_data_bytes defines the largest raw data, in bytes, accepted in a single
+	// submission. It must be positive.
+	MaxRawDataBytes uint64 `protobuf:"varint,7,opt,name=max_raw_data_bytes,json=maxRawDataBytes,proto3" json:"max_raw_data_bytes,omitempty"`
+	// trusted_provider_types lists the oracle types whose requests may use
+	// QUORUM_MODE_TRUSTED_PROVIDER. Price types (currency, stock and crypto)
+	// cannot be listed. Empty disables the mode.
+	TrustedProviderTypes []OracleType `protobuf:"varint,8,rep,packed,name=trusted_provider_types,json=trustedProviderTypes,proto3,enum=guru.oracle.v1.OracleType" json:"trusted_provider_types,omitempty"`
+	// min_raw_value defines the smallest value accepted in a submission to a request of
+	// a numeric oracle type. Zero disables the bound.
+	MinRawValue cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=min_raw_value,json=minRawValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_raw_value"`
+	// max_raw_value defines the largest value accepted in a submission to a request of
+	// a numeric oracle type. Zero disables the bound.
+	MaxRawValue cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=max_raw_value,json=maxRawValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_raw_value"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -168,6 +185,20 @@ func (m *Params) GetMaxAccountListSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxRawDataBytes() uint64 {
+	if m != nil {
+		return m.MaxRawDataBytes
+	}
+	return 0
+}
+
+func (m *Params) GetTrustedProviderTypes() []OracleType {
+	if m != nil {
+		return m.TrustedProviderTypes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "guru.oracle.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "guru.oracle.v1.Params")
@@ -176,40 +207,48 @@ func init() {
 func init() { proto.RegisterFile("guru/oracle/v1/genesis.proto", fileDescriptor_c4a1ce927fa70879) }
 
 var fileDescriptor_c4a1ce927fa70879 = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x9b, 0xb6, 0x54, 0xe0, 0x15, 0x44, 0x03, 0x85, 0xb0, 0xa1, 0x2c, 0x1a, 0x97, 0x0a,
-	0x44, 0xa2, 0x16, 0x04, 0xe7, 0x95, 0x0a, 0x84, 0x34, 0x89, 0x29, 0x95, 0x40, 0xe2, 0x62, 0xb9,
-	0x8e, 0x97, 0x5a, 0xc4, 0x76, 0xb1, 0x9d, 0xb6, 0xdb, 0x91, 0x27, 0xe0, 0x31, 0x38, 0x72, 0xe0,
-	0x21, 0x76, 0x9c, 0x38, 0x21, 0x0e, 0xd3, 0xd4, 0x1e, 0x78, 0x0d, 0x14, 0xdb, 0x43, 0xa2, 0xdc,
-	0xb8, 0x44, 0xf1, 0xff, 0xf7, 0xf7, 0xff, 0xfb, 0xec, 0xcf, 0xe0, 0x7e, 0x5e, 0xca, 0x32, 0x11,
-	0x12, 0xe1, 0x82, 0x24, 0xf3, 0x7e, 0x92, 0x13, 0x4e, 0x14, 0x55, 0xf1, 0x4c, 0x0a, 0x2d, 0xfc,
-	0x1b, 0x15, 0x8d, 0x2d, 0x8d, 0xe7, 0xfd, 0xed, 0xdb, 0xb9, 0xc8, 0x85, 0x41, 0x49, 0xf5, 0x67,
-	0x5d, 0xdb, 0x3b, 0x1b, 0x19, 0xce, 0x6f, 0xe1, 0x3d, 0x2c, 0x14, 0x13, 0x0a, 0xda, 0x5d, 0x76,
-	0xe1, 0x50, 0x07, 0x31, 0xca, 0x45, 0x62, 0xbe, 0x56, 0xda, 0xfb, 0x54, 0x07, 0xed, 0x57, 0xb6,
-	0x85, 0xb1, 0x46, 0x9a, 0xf8, 0x4f, 0x41, 0x6b, 0x86, 0x24, 0x62, 0x2a, 0xf0, 0x22, 0xaf, 0xb7,
-	0x35, 0xb8, 0x13, 0xff, 0xdd, 0x52, 0x7c, 0x68, 0xe8, 0xb0, 0x79, 0x7a, 0xbe, 0x5b, 0x4b, 0x9d,
-	0xd7, 0x7f, 0x0e, 0x02, 0xeb, 0x80, 0x92, 0x7c, 0x2c, 0x89, 0xd2, 0x30, 0x13, 0x18, 0x62, 0x51,
-	0x72, 0x1d, 0xd4, 0x23, 0xaf, 0xd7, 0x4c, 0xbb, 0x96, 0xa7, 0x16, 0x8f, 0x04, 0x7e, 0x51, 0x41,
-	0xff, 0x2d, 0xb8, 0xf5, 0xef, 0x46, 0x15, 0x34, 0xa2, 0x46, 0x6f, 0x6b, 0x10, 0x6d, 0xd6, 0x7e,
-	0xb3, 0x91, 0xe1, 0xba, 0xe8, 0x6c, 0x66, 0x2b, 0xff, 0x11, 0xe8, 0x30, 0x91, 0x11, 0x89, 0xb4,
-	0x90, 0x10, 0x65, 0x99, 0x24, 0x4a, 0x05, 0xcd, 0xc8, 0xeb, 0x5d, 0x4b, 0x6f, 0xfe, 0x01, 0xfb,
-	0x56, 0xdf, 0xbb, 0xa8, 0x83, 0x96, 0x3d, 0x96, 0xff, 0x00, 0x5c, 0x27, 0x1c, 0x4d, 0x0a, 0x02,
-	0x6d, 0xa6, 0xb9, 0x85, 0xab, 0x69, 0xdb, 0x8a, 0xb6, 0x7e, 0x65, 0x52, 0xe5, 0x84, 0x51, 0x0d,
-	0x17, 0x94, 0x67, 0x62, 0xe1, 0x8e, 0xd8, 0xb6, 0xe2, 0x3b, 0xa3, 0xf9, 0x14, 0x74, 0x19, 0xe5,
-	0xd0, 0x19, 0x67, 0x44, 0x5e, 0x9a, 0x1b, 0x91, 0xd7, 0x6b, 0x0f, 0x9f, 0x55, 0x9d, 0xff, 0x3c,
-	0xdf, 0xdd, 0xb1, 0x13, 0x52, 0xd9, 0x87, 0x98, 0x8a, 0x84, 0x21, 0x3d, 0x8d, 0x0f, 0x48, 0x8e,
-	0xf0, 0xf1, 0x88, 0xe0, 0xef, 0xdf, 0x1e, 0x03, 0x37, 0xc0, 0x11, 0xc1, 0x5f, 0x7e, 0x7d, 0x7d,
-	0xe8, 0xa5, 0x3e, 0xa3, 0x7c, 0x6c, 0x32, 0x0f, 0x89, 0x74, 0xa5, 0x38, 0xb8, 0xab, 0x0a, 0xa4,
-	0xa6, 0xf0, 0x48, 0x22, 0xac, 0xa9, 0xe0, 0x30, 0x13, 0x0b, 0xae, 0x29, 0x23, 0xe6, 0xc8, 0xff,
-	0x5f, 0xac, 0x6b, 0x62, 0x5f, 0xba, 0xd4, 0x91, 0x0b, 0xf5, 0xfb, 0xa0, 0xcb, 0xd0, 0x12, 0x22,
-	0x6c, 0x06, 0x0c, 0x0b, 0xaa, 0x34, 0x54, 0xf4, 0x84, 0x04, 0x57, 0xcc, 0x3d, 0xf8, 0x0c, 0x2d,
-	0xf7, 0x2d, 0x3b, 0xa0, 0x4a, 0x8f, 0xe9, 0x09, 0x19, 0xbe, 0x3e, 0x5d, 0x85, 0xde, 0xd9, 0x2a,
-	0xf4, 0x2e, 0x56, 0xa1, 0xf7, 0x79, 0x1d, 0xd6, 0xce, 0xd6, 0x61, 0xed, 0xc7, 0x3a, 0xac, 0xbd,
-	0x4f, 0x72, 0xaa, 0xa7, 0xe5, 0x24, 0xc6, 0x82, 0x25, 0xd5, 0xb8, 0x8f, 0x28, 0xcf, 0x0b, 0x31,
-	0x41, 0x85, 0x59, 0x25, 0xf3, 0x41, 0xb2, 0xbc, 0x7c, 0xea, 0xfa, 0x78, 0x46, 0xd4, 0xa4, 0x65,
-	0x5e, 0xee, 0x93, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x04, 0xcc, 0xf6, 0xe9, 0x4a, 0x03, 0x00,
-	0x00,
+	// 646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6e, 0x13, 0x3b,
+	0x14, 0xc6, 0x33, 0x4d, 0x9a, 0xdb, 0xba, 0x69, 0xef, 0xcd, 0xb4, 0xe9, 0x1d, 0x5a, 0x94, 0x46,
+	0x65, 0x13, 0x51, 0x31, 0xa3, 0x06, 0x04, 0xeb, 0x86, 0x08, 0x84, 0x54, 0x44, 0x34, 0x45, 0x45,
+	0xea, 0xc6, 0x3a, 0x99, 0x71, 0xa7, 0x16, 0xf1, 0x38, 0xd8, 0x9e, 0xfc, 0xe9, 0x92, 0x27, 0xe0,
+	0x31, 0x58, 0xb2, 0xe0, 0x21, 0xba, 0xac, 0x58, 0x21, 0x16, 0x15, 0x6a, 0x17, 0x3c, 0x06, 0xc8,
+	0xf6, 0x04, 0x41, 0x60, 0x97, 0x4d, 0x14, 0x9f, 0xef, 0x3b, 0xbf, 0x73, 0x8e, 0x4f, 0x62, 0x74,
+	0x3b, 0xc9, 0x44, 0x16, 0x70, 0x01, 0x51, 0x9f, 0x04, 0xc3, 0xfd, 0x20, 0x21, 0x29, 0x91, 0x54,
+	0xfa, 0x03, 0xc1, 0x15, 0x77, 0xd7, 0xb4, 0xea, 0x5b, 0xd5, 0x1f, 0xee, 0x6f, 0x6d, 0x24, 0x3c,
+	0xe1, 0x46, 0x0a, 0xf4, 0x37, 0xeb, 0xda, 0xda, 0x9e, 0x61, 0xe4, 0x7e, 0x2b, 0xde, 0x8a, 0xb8,
+	0x64, 0x5c, 0x62, 0x9b, 0x65, 0x0f, 0xb9, 0x54, 0x05, 0x46, 0x53, 0x1e, 0x98, 0x4f, 0x1b, 0xda,
+	0x7d, 0xbb, 0x80, 0x2a, 0x4f, 0x6d, 0x0b, 0x47, 0x0a, 0x14, 0x71, 0x1f, 0xa0, 0xf2, 0x00, 0x04,
+	0x30, 0xe9, 0x39, 0x0d, 0xa7, 0xb9, 0xd2, 0xda, 0xf4, 0x7f, 0x6f, 0xc9, 0xef, 0x1a, 0xb5, 0x5d,
+	0xba, 0xb8, 0xda, 0x29, 0x84, 0xb9, 0xd7, 0x7d, 0x84, 0x3c, 0xeb, 0xc0, 0x82, 0xbc, 0xc9, 0x88,
+	0x54, 0x38, 0xe6, 0x11, 0x8e, 0x78, 0x96, 0x2a, 0x6f, 0xa1, 0xe1, 0x34, 0x4b, 0x61, 0xcd, 0xea,
+	0xa1, 0x95, 0x3b, 0x3c, 0x7a, 0xac, 0x45, 0xf7, 0x18, 0xad, 0xff, 0x99, 0x28, 0xbd, 0x62, 0xa3,
+	0xd8, 0x5c, 0x69, 0x35, 0x66, 0x6b, 0xbf, 0x98, 0x61, 0xe4, 0x5d, 0x54, 0x67, 0xd9, 0xd2, 0xdd,
+	0x43, 0x55, 0xc6, 0x63, 0x22, 0x40, 0x71, 0x81, 0x21, 0x8e, 0x05, 0x91, 0xd2, 0x2b, 0x35, 0x9c,
+	0xe6, 0x72, 0xf8, 0xdf, 0x4f, 0xe1, 0xc0, 0xc6, 0x77, 0xbf, 0x2f, 0xa2, 0xb2, 0x1d, 0xcb, 0xbd,
+	0x83, 0x56, 0x49, 0x0a, 0xbd, 0x3e, 0xc1, 0x96, 0x69, 0x6e, 0x61, 0x29, 0xac, 0xd8, 0xa0, 0xad,
+	0xaf, 0x4d, 0x32, 0xeb, 0x31, 0xaa, 0xf0, 0x88, 0xa6, 0x31, 0x1f, 0xe5, 0x23, 0x56, 0x6c, 0xf0,
+	0x95, 0x89, 0xb9, 0x14, 0xd5, 0x18, 0x4d, 0x71, 0x6e, 0x1c, 0x10, 0x31, 0x35, 0x17, 0x1b, 0x4e,
+	0xb3, 0xd2, 0x7e, 0xa8, 0x3b, 0xff, 0x72, 0xb5, 0xb3, 0x6d, 0x37, 0x24, 0xe3, 0xd7, 0x3e, 0xe5,
+	0x01, 0x03, 0x75, 0xe6, 0x1f, 0x92, 0x04, 0xa2, 0x49, 0x87, 0x44, 0x9f, 0x3e, 0xde, 0x43, 0xf9,
+	0x02, 0x3b, 0x24, 0x7a, 0xff, 0xed, 0xc3, 0x5d, 0x27, 0x74, 0x19, 0x4d, 0x8f, 0x0c, 0xb3, 0x4b,
+	0x44, 0x5e, 0x2a, 0x45, 0xff, 0xcb, 0x3e, 0xc8, 0x33, 0x7c, 0x2a, 0x20, 0x52, 0x94, 0xa7, 0x38,
+	0xe6, 0xa3, 0x54, 0x51, 0x46, 0xbc, 0xd2, 0x5c, 0xc5, 0x6a, 0x06, 0xfb, 0x24, 0xa7, 0x76, 0x72,
+	0xa8, 0xbb, 0x8f, 0x6a, 0x0c, 0xc6, 0x18, 0x22, 0xb3, 0x60, 0xdc, 0xa7, 0x52, 0x61, 0x49, 0xcf,
+	0x89, 0xb7, 0x68, 0xee, 0xc1, 0x65, 0x30, 0x3e, 0xb0, 0xda, 0x21, 0x95, 0xea, 0x88, 0x9e, 0x13,
+	0xf7, 0x14, 0xad, 0xeb, 0x14, 0x06, 0x49, 0x4a, 0x55, 0x16, 0x13, 0x2c, 0x40, 0x51, 0xee, 0x95,
+	0xe7, 0x6a, 0xaf, 0xca, 0x60, 0xfc, 0x7c, 0x4a, 0x0c, 0x35, 0xd0, 0xdd, 0x43, 0xba, 0x3a, 0x16,
+	0x30, 0xc2, 0x31, 0x28, 0xc0, 0xbd, 0x89, 0x22, 0xd2, 0xfb, 0xc7, 0xf4, 0xf5, 0x2f, 0x83, 0x71,
+	0x08, 0xa3, 0x0e, 0x28, 0x68, 0xeb, 0xb0, 0xdb, 0x45, 0x9b, 0x4a, 0x64, 0x52, 0x91, 0x58, 0xff,
+	0x5b, 0x86, 0x34, 0x26, 0x02, 0xab, 0xc9, 0x80, 0x48, 0x6f, 0xa9, 0x51, 0x6c, 0xae, 0xb5, 0xb6,
+	0xfe, 0xfe, 0xfb, 0x7b, 0x39, 0x19, 0x90, 0x70, 0x23, 0xcf, 0xec, 0xe6, 0x89, 0x3a, 0x28, 0xdd,
+	0x13, 0xb4, 0xaa, 0x97, 0xae, 0xcb, 0x0f, 0xa1, 0x9f, 0x11, 0x6f, 0x79, 0xae, 0x01, 0x57, 0x18,
+	0x4d, 0x43, 0x18, 0x1d, 0x6b, 0x94, 0x61, 0xc3, 0xf8, 0x17, 0x36, 0x9a, 0x93, 0x0d, 0xe3, 0x29,
+	0xbb, 0xfd, 0xec, 0x24, 0x48, 0xa8, 0x3a, 0xcb, 0x7a, 0x7e, 0xc4, 0x59, 0xa0, 0xa7, 0x3e, 0xa5,
+	0x69, 0xd2, 0xe7, 0x3d, 0xe8, 0x9b, 0x53, 0x30, 0x6c, 0x05, 0xe3, 0xe9, 0x8b, 0x63, 0xae, 0xe8,
+	0xe2, 0xba, 0xee, 0x5c, 0x5e, 0xd7, 0x9d, 0xaf, 0xd7, 0x75, 0xe7, 0xdd, 0x4d, 0xbd, 0x70, 0x79,
+	0x53, 0x2f, 0x7c, 0xbe, 0xa9, 0x17, 0x7a, 0x65, 0xf3, 0xb0, 0xdc, 0xff, 0x31, 0x00, 0xbc, 0x94,
+	0xdf, 0xc7, 0xe9, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -291,6 +330,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxRawValue.Size()
+		i -= size
+		if _, err := m.MaxRawValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MinRawValue.Size()
+		i -= size
+		if _, err := m.MinRawValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.TrustedProviderTypes) > 0 {
+		dAtA3 := make([]byte, len(m.TrustedProviderTypes)*10)
+		var j2 int
+		for _, num := range m.TrustedProviderTypes {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGenesis(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxRawDataBytes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRawDataBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxMagnitudeRatio.Size()
+		i -= size
+		if _, err := m.MaxMagnitudeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.MaxAccountListSize != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxAccountListSize))
 		i--
@@ -388,6 +480,22 @@ func (m *Params) Size() (n int) {
 	if m.MaxAccountListSize != 0 {
 		n += 1 + sovGenesis(uint64(m.MaxAccountListSize))
 	}
+	l = m.MaxMagnitudeRatio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxRawDataBytes != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxRawDataBytes))
+	}
+	if len(m.TrustedProviderTypes) > 0 {
+		l = 0
+		for _, e := range m.TrustedProviderTypes {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	l = m.MinRawValue.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxRawValue.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -718,6 +826,193 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMagnitudeRatio", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMagnitudeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRawDataBytes", wireType)
+			}
+			m.MaxRawDataBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRawDataBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType == 0 {
+				var v OracleType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= OracleType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TrustedProviderTypes = append(m.TrustedProviderTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.TrustedProviderTypes) == 0 {
+					m.TrustedProviderTypes = make([]OracleType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v OracleType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OracleType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TrustedProviderTypes = append(m.TrustedProviderTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedProviderTypes", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRawValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRawValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRawValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRawValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
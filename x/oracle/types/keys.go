@@ -3,6 +3,8 @@ package types
 import (
 	"encoding/binary"
 	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -19,6 +21,9 @@ const (
 	MemStoreKey = "mem_oracle"
 )
 
+// MaxLatestResultsRequestIds bounds the number of requests a single LatestResults query may look up
+const MaxLatestResultsRequestIds = 100
+
 // KV Store key prefix bytes
 const (
 	preficParams = iota + 1
@@ -27,6 +32,8 @@ const (
 	prefixOracleRequestDocCount
 	prefixOracleData
 	prefixOracleDataSet
+	prefixAccountRequestIndex
+	prefixRoundTiming
 )
 
 // KV Store key prefixes
@@ -37,6 +44,8 @@ var (
 	KeyOracleRequestDocCount = []byte{prefixOracleRequestDocCount}
 	KeyOracleData            = []byte{prefixOracleData}
 	KeyOracleDataSet         = []byte{prefixOracleDataSet}
+	KeyAccountRequestIndex   = []byte{prefixAccountRequestIndex}
+	KeyRoundTiming           = []byte{prefixRoundTiming}
 )
 
 // GetOracleRequestDocKey returns the key for storing OracleRequsetDoc
@@ -44,6 +53,17 @@ func GetOracleRequestDocKey(id uint64) []byte {
 	return append(KeyOracleRequestDoc, IDToBytes(id)...)
 }
 
+// GetAccountRequestIndexPrefix returns the prefix of the request ids indexed for an account.
+// The account is length-prefixed so that one address never prefixes another.
+func GetAccountRequestIndexPrefix(account string) []byte {
+	return append(KeyAccountRequestIndex, address.MustLengthPrefix(StringToBytes(account))...)
+}
+
+// GetAccountRequestIndexKey returns the key marking that request id lists account as a provider
+func GetAccountRequestIndexKey(account string, id uint64) []byte {
+	return append(GetAccountRequestIndexPrefix(account), IDToBytes(id)...)
+}
+
 // GetOracleDataKey returns the key for storing oracle data
 func GetOracleDataKey(id uint64) []byte {
 	bz := make([]byte, 8)
@@ -76,6 +96,11 @@ func GetDataSetKey(request_id uint64, nonce uint64) []byte {
 	return append(KeyOracleDataSet, IDToBytes(request_id)...)
 }
 
+// GetRoundTimingKey returns the key for storing the round timing statistics of a request
+func GetRoundTimingKey(id uint64) []byte {
+	return append(KeyRoundTiming, IDToBytes(id)...)
+}
+
 func IDToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
 	binary.BigEndian.PutUint64(bz, id)
@@ -230,3 +230,51 @@ func (msg MsgUpdateModeratorAddress) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgCancelRound creates a new MsgCancelRound instance
+func NewMsgCancelRound(moderatorAddress string, requestId uint64, nonce uint64) *MsgCancelRound {
+	return &MsgCancelRound{
+		ModeratorAddress: moderatorAddress,
+		RequestId:        requestId,
+		Nonce:            nonce,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgCancelRound) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgCancelRound) Type() string {
+	return "cancel_round"
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgCancelRound) GetSigners() []sdk.AccAddress {
+	moderatorAddress, err := sdk.AccAddressFromBech32(msg.ModeratorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{moderatorAddress}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgCancelRound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgCancelRound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.ModeratorAddress); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid moderator address (%s)", err)
+	}
+	if msg.RequestId == 0 {
+		return errorsmod.Wrap(ErrInvalidRequestId, "request id cannot be zero")
+	}
+	if msg.Nonce == 0 {
+		return errorsmod.Wrap(ErrInvalidNonce, "nonce cannot be zero")
+	}
+	return nil
+}
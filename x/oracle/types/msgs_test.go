@@ -1,8 +1,10 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
@@ -32,6 +34,52 @@ func TestMsgRegisterOracleRequestDoc(t *testing.T) {
 		RequestDoc:       validMsg.RequestDoc,
 	}
 	require.Error(t, invalidMsg.ValidateBasic())
+
+	// Endpoint transforms are validated at registration
+	transformMsg := validMsg
+	transformMsg.RequestDoc.Endpoints = []*OracleEndpoint{{
+		Url:       "https://api.coinbase.com/v2/prices/BTC-USD/spot",
+		ParseRule: "data.amount",
+		Transform: &EndpointTransform{Scale: "0.001", Invert: true},
+	}}
+	require.NoError(t, transformMsg.ValidateBasic())
+
+	for _, scale := range []string{"abc", "0", "-2"} {
+		transformMsg.RequestDoc.Endpoints[0].Transform = &EndpointTransform{Scale: scale}
+		require.Error(t, transformMsg.ValidateBasic(), scale)
+	}
+	transformMsg.RequestDoc.Endpoints[0].Transform = nil
+	transformMsg.RequestDoc.Endpoints[0].MessageType = "example.feed.v1.Quote"
+	require.NoError(t, transformMsg.ValidateBasic())
+	transformMsg.RequestDoc.Endpoints[0].MessageType = "example feed"
+	require.ErrorContains(t, transformMsg.ValidateBasic(), "message type")
+	// Account lists must be non-empty and free of duplicates
+	accountsMsg := validMsg
+	accountsMsg.RequestDoc.AccountList = nil
+	require.ErrorContains(t, accountsMsg.ValidateBasic(), "account list cannot be empty")
+	accountsMsg.RequestDoc.AccountList = []string{"guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft", "guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft"}
+	require.ErrorContains(t, accountsMsg.ValidateBasic(), "duplicate account")
+
+	// Strict endpoint assignment requires one endpoint per account
+	strictMsg := validMsg
+	strictMsg.RequestDoc.EndpointAssignment = EndpointAssignment_ENDPOINT_ASSIGNMENT_STRICT
+	require.NoError(t, strictMsg.ValidateBasic())
+
+	strictMsg.RequestDoc.AccountList = []string{"guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft", "guru1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqteqykr"}
+	require.ErrorContains(t, strictMsg.ValidateBasic(), "strict endpoint assignment")
+
+	strictMsg.RequestDoc.EndpointAssignment = EndpointAssignment_ENDPOINT_ASSIGNMENT_WRAP
+	require.NoError(t, strictMsg.ValidateBasic())
+
+	// Stake-weighted quorum requires a fraction in (0, 1]
+	stakeMsg := validMsg
+	stakeMsg.RequestDoc.QuorumMode = QuorumMode_QUORUM_MODE_STAKE_WEIGHTED
+	for _, fraction := range []string{"", "abc", "0", "1.5"} {
+		stakeMsg.RequestDoc.QuorumStakeFraction = fraction
+		require.ErrorContains(t, stakeMsg.ValidateBasic(), "quorum stake fraction", fraction)
+	}
+	stakeMsg.RequestDoc.QuorumStakeFraction = "0.67"
+	require.NoError(t, stakeMsg.ValidateBasic())
 }
 
 func TestMsgSubmitOracleData(t *testing.T) {
@@ -153,3 +201,232 @@ func TestMsgUpdateModeratorAddress(t *testing.T) {
 	}
 	require.Error(t, invalidMsg4.ValidateBasic())
 }
+
+func TestMsgCancelRound(t *testing.T) {
+	validMsg := NewMsgCancelRound("guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft", 1, 5)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	// invalid moderator address
+	require.Error(t, NewMsgCancelRound("h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft", 1, 5).ValidateBasic())
+
+	// request id and nonce cannot be zero
+	require.ErrorIs(t, NewMsgCancelRound("guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft", 0, 5).ValidateBasic(), ErrInvalidRequestId)
+	require.ErrorIs(t, NewMsgCancelRound("guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft", 1, 0).ValidateBasic(), ErrInvalidNonce)
+}
+
+func TestValidateRawData(t *testing.T) {
+	params := DefaultParams()
+
+	// Numeric oracle types need a positive decimal
+	require.NoError(t, ValidateRawData(OracleType_ORACLE_TYPE_CRYPTO, "65000.12", params))
+	require.NoError(t, ValidateRawData(OracleType_ORACLE_TYPE_MIN_GAS_PRICE, "0.000000000000000001", params))
+	for _, rawData := range []string{"", "abc", "Inf", "1e5", "0x10", "0", "-1.5", "0.0000000000000000001"} {
+		require.Error(t, ValidateRawData(OracleType_ORACLE_TYPE_CURRENCY, rawData, params), rawData)
+	}
+
+	// Non-numeric types only need content
+	require.NoError(t, ValidateRawData(OracleType_ORACLE_TYPE_UNSPECIFIED, "abc", params))
+	require.Error(t, ValidateRawData(OracleType_ORACLE_TYPE_UNSPECIFIED, "", params))
+
+	// Configured bounds are inclusive and only apply to numeric types
+	params.MinRawValue = sdkmath.LegacyNewDecWithPrec(1, 2)
+	params.MaxRawValue = sdkmath.LegacyNewDec(1000000)
+	require.NoError(t, ValidateRawData(OracleType_ORACLE_TYPE_CRYPTO, "0.01", params))
+	require.NoError(t, ValidateRawData(OracleType_ORACLE_TYPE_CRYPTO, "1000000", params))
+	require.ErrorContains(t, ValidateRawData(OracleType_ORACLE_TYPE_CRYPTO, "0.009", params), "below the minimum")
+	require.ErrorContains(t, ValidateRawData(OracleType_ORACLE_TYPE_CRYPTO, "1000000.1", params), "above the maximum")
+	require.NoError(t, ValidateRawData(OracleType_ORACLE_TYPE_UNSPECIFIED, "abc", params))
+
+	// Params stored before the bounds existed have nil bounds, which disable them
+	params.MinRawValue, params.MaxRawValue = sdkmath.LegacyDec{}, sdkmath.LegacyDec{}
+	require.NoError(t, ValidateRawData(OracleType_ORACLE_TYPE_CRYPTO, "0.000000000000000001", params))
+}
+
+func TestParamsRawValueBounds(t *testing.T) {
+	params := DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.MinRawValue = sdkmath.LegacyNewDec(10)
+	params.MaxRawValue = sdkmath.LegacyNewDec(10)
+	require.NoError(t, params.Validate())
+
+	// Only the upper bound set
+	params.MinRawValue = sdkmath.LegacyZeroDec()
+	require.NoError(t, params.Validate())
+
+	params.MinRawValue = sdkmath.LegacyNewDec(11)
+	require.ErrorContains(t, params.Validate(), "cannot exceed")
+
+	params.MinRawValue = sdkmath.LegacyNewDec(-1)
+	require.ErrorContains(t, params.Validate(), "min raw value cannot be negative")
+
+	params.MinRawValue = sdkmath.LegacyZeroDec()
+	params.MaxRawValue = sdkmath.LegacyNewDec(-1)
+	require.ErrorContains(t, params.Validate(), "max raw value cannot be negative")
+}
+
+func TestOracleValue(t *testing.T) {
+	// Parsing is strict and the string form is canonical
+	value, err := ParseOracleValue("65000.1200")
+	require.NoError(t, err)
+	require.Equal(t, "65000.12", value.String())
+	require.Equal(t, "65000.120000000000000000", value.Dec().String())
+	for _, s := range []string{"", "abc", "1e5", "Inf", "1.0000000000000000001"} {
+		_, err := ParseOracleValue(s)
+		require.Error(t, err, s)
+	}
+
+	// Whole numbers drop the decimal point
+	value, err = ParseOracleValue("101.000")
+	require.NoError(t, err)
+	require.Equal(t, "101", value.String())
+
+	// big.Float results are rounded to OracleValuePrecision decimals
+	one, _ := ParseOracleValue("1")
+	three, _ := ParseOracleValue("3")
+	third := new(big.Float).Quo(one.BigFloat(), three.BigFloat())
+	value, err = OracleValueFromBigFloat(third)
+	require.NoError(t, err)
+	require.Equal(t, "0.333333333333333333", value.String())
+
+	_, err = OracleValueFromBigFloat(new(big.Float).SetInf(false))
+	require.Error(t, err)
+
+	// Round trip through big.Float keeps every decimal
+	value, err = ParseOracleValue("0.000000000000000001")
+	require.NoError(t, err)
+	roundTrip, err := OracleValueFromBigFloat(value.BigFloat())
+	require.NoError(t, err)
+	require.Equal(t, value.String(), roundTrip.String())
+	require.True(t, value.IsPositive())
+	require.False(t, OracleValue{}.IsPositive())
+}
+
+func TestFallbackRules(t *testing.T) {
+	doc := OracleRequestDoc{
+		OracleType:      OracleType_ORACLE_TYPE_CRYPTO,
+		Name:            "fallback",
+		Period:          60,
+		AccountList:     []string{"guru1h9y8h0rh6tqxrj045fyvarnnyyxdg07693zkft"},
+		Quorum:          1,
+		Endpoints:       []*OracleEndpoint{{Url: "https://example.com", ParseRule: "price"}},
+		AggregationRule: AggregationRule_AGGREGATION_RULE_MEDIAN,
+		FallbackRules:   []AggregationRule{AggregationRule_AGGREGATION_RULE_AVG, AggregationRule_AGGREGATION_RULE_MIN},
+		Status:          RequestStatus_REQUEST_STATUS_ENABLED,
+	}
+	require.NoError(t, doc.Validate())
+	require.Equal(t, []AggregationRule{
+		AggregationRule_AGGREGATION_RULE_MEDIAN,
+		AggregationRule_AGGREGATION_RULE_AVG,
+		AggregationRule_AGGREGATION_RULE_MIN,
+	}, doc.AggregationRules())
+
+	// Unregistered, unspecified and repeated rules are rejected
+	for _, fallbacks := range [][]AggregationRule{
+		{AggregationRule(99)},
+		{AggregationRule_AGGREGATION_RULE_UNSPECIFIED},
+		{AggregationRule_AGGREGATION_RULE_MEDIAN},
+		{AggregationRule_AGGREGATION_RULE_AVG, AggregationRule_AGGREGATION_RULE_AVG},
+	} {
+		doc.FallbackRules = fallbacks
+		require.Error(t, doc.Validate(), fallbacks)
+	}
+}
+
+func TestAggregationRegistry(t *testing.T) {
+	values := func(raw ...string) []*big.Float {
+		out := make([]*big.Float, len(raw))
+		for i, r := range raw {
+			out[i], _ = new(big.Float).SetString(r)
+		}
+		return out
+	}
+
+	tests := []struct {
+		rule     AggregationRule
+		values   []*big.Float
+		expected string
+	}{
+		{AggregationRule_AGGREGATION_RULE_AVG, values("1", "2", "3", "4"), "2.5"},
+		{AggregationRule_AGGREGATION_RULE_MIN, values("3", "1.5", "2"), "1.5"},
+		{AggregationRule_AGGREGATION_RULE_MAX, values("3", "1.5", "2"), "3"},
+		{AggregationRule_AGGREGATION_RULE_MEDIAN, values("3", "1", "2"), "2"},
+		{AggregationRule_AGGREGATION_RULE_MEDIAN, values("4", "1", "3", "2"), "2.5"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.rule.String(), func(t *testing.T) {
+			require.NoError(t, ValidateAggregationRule(tc.rule))
+			fn, ok := GetAggregationFunc(tc.rule)
+			require.True(t, ok)
+			result, err := fn(tc.values)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, result.Text('f', -1))
+		})
+	}
+
+	require.Error(t, ValidateAggregationRule(AggregationRule_AGGREGATION_RULE_UNSPECIFIED))
+	require.Error(t, ValidateAggregationRule(AggregationRule(99)))
+	_, ok := GetAggregationFunc(AggregationRule(99))
+	require.False(t, ok)
+}
+
+func TestWeightedMedian(t *testing.T) {
+	rule := AggregationRule_AGGREGATION_RULE_STAKE_WEIGHTED_MEDIAN
+	require.NoError(t, ValidateAggregationRule(rule))
+	_, ok := GetAggregationFunc(rule)
+	require.False(t, ok)
+	fn, ok := GetWeightedAggregationFunc(rule)
+	require.True(t, ok)
+
+	values := []*big.Float{big.NewFloat(3), big.NewFloat(1), big.NewFloat(2)}
+	weights := func(w ...int64) []sdkmath.Int {
+		out := make([]sdkmath.Int, len(w))
+		for i, v := range w {
+			out[i] = sdkmath.NewInt(v)
+		}
+		return out
+	}
+
+	tests := []struct {
+		weights  []sdkmath.Int
+		expected string
+	}{
+		{weights(1, 1, 1), "2"},
+		{weights(700, 200, 100), "3"},
+		{weights(100, 700, 200), "1"},
+		{weights(0, 1, 1), "1"},
+	}
+	for _, tc := range tests {
+		result, err := fn(values, tc.weights)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, result.Text('f', -1), tc.weights)
+	}
+
+	_, err := fn(values, weights(0, 0, 0))
+	require.Error(t, err)
+}
+
+func TestEndpointsAttribute(t *testing.T) {
+	endpoints := []*OracleEndpoint{
+		{Url: "https://api.example.com/v1/price?pair=BTC-USD&limit=1", ParseRule: "data.amount"},
+		{
+			Url:       "https://api.example.org/v1/rates/USD-KRW",
+			ParseRule: "data.rate",
+			Transform: &EndpointTransform{Scale: "1000", Invert: true},
+		},
+	}
+
+	expected := `[{"parse_rule":"data.amount","url":"https://api.example.com/v1/price?pair=BTC-USD&limit=1"},` +
+		`{"parse_rule":"data.rate","transform":{"invert":true,"scale":"1000"},"url":"https://api.example.org/v1/rates/USD-KRW"}]`
+
+	for i := 0; i < 10; i++ {
+		attr, err := EndpointsAttribute(endpoints)
+		require.NoError(t, err)
+		require.Equal(t, expected, attr)
+	}
+
+	attr, err := EndpointsAttribute(nil)
+	require.NoError(t, err)
+	require.Equal(t, "null", attr)
+}
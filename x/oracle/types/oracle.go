@@ -3,8 +3,17 @@ package types
 import (
 	"encoding/binary"
 	"fmt"
+	"math/big"
+	"slices"
 
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gurufinglobal/guru/v2/crypto/ethsecp256k1"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // ValidateWithParams performs validation on OracleRequestDoc with parameter-based limits
@@ -29,13 +38,49 @@ func (doc OracleRequestDoc) ValidateWithParams(params Params) error {
 	if len(doc.Endpoints) == 0 {
 		return fmt.Errorf("endpoints cannot be empty")
 	}
+	// Validate optional endpoint transforms and message types
+	for i, endpoint := range doc.Endpoints {
+		if err := endpoint.GetTransform().Validate(); err != nil {
+			return fmt.Errorf("endpoint %d transform is invalid: %v", i, err)
+		}
+		if endpoint.MessageType != "" && !protoreflect.FullName(endpoint.MessageType).IsValid() {
+			return fmt.Errorf("endpoint %d message type is not a fully qualified message name: %q", i, endpoint.MessageType)
+		}
+	}
 	// Check if aggregation rule is unspecified
 	if doc.AggregationRule == AggregationRule_AGGREGATION_RULE_UNSPECIFIED {
 		return fmt.Errorf("aggregation rule cannot be unspecified")
 	}
-	// Check if aggregation rule is empty
-	if doc.AggregationRule == 0 {
-		return fmt.Errorf("aggregation rule cannot be empty")
+	// Check if aggregation rule has an implementation
+	if err := ValidateAggregationRule(doc.AggregationRule); err != nil {
+		return err
+	}
+	// Check that every fallback rule has an implementation and is tried once
+	seenRules := map[AggregationRule]bool{doc.AggregationRule: true}
+	for _, rule := range doc.FallbackRules {
+		if err := ValidateAggregationRule(rule); err != nil {
+			return fmt.Errorf("invalid fallback rule: %v", err)
+		}
+		if seenRules[rule] {
+			return fmt.Errorf("aggregation rule %s is listed more than once", rule)
+		}
+		seenRules[rule] = true
+	}
+	// Check if result format is known
+	if _, ok := ResultFormat_name[int32(doc.ResultFormat)]; !ok {
+		return fmt.Errorf("unsupported result format: %s", doc.ResultFormat)
+	}
+	// Check if coverage mode is known
+	if _, ok := CoverageMode_name[int32(doc.CoverageMode)]; !ok {
+		return fmt.Errorf("unsupported coverage mode: %s", doc.CoverageMode)
+	}
+	// Check if quorum mode is known
+	if _, ok := QuorumMode_name[int32(doc.QuorumMode)]; !ok {
+		return fmt.Errorf("unsupported quorum mode: %s", doc.QuorumMode)
+	}
+	// Check if endpoint assignment is known
+	if _, ok := EndpointAssignment_name[int32(doc.EndpointAssignment)]; !ok {
+		return fmt.Errorf("unsupported endpoint assignment: %s", doc.EndpointAssignment)
 	}
 	// Check if account list is nil
 	if doc.AccountList == nil {
@@ -51,11 +96,36 @@ func (doc OracleRequestDoc) ValidateWithParams(params Params) error {
 		return fmt.Errorf("account list size exceeds maximum allowed: %d, maximum: %d", len(doc.AccountList), params.MaxAccountListSize)
 	}
 
-	// Validate each account in the account list
+	// Validate each account in the account list; a duplicate would skew endpoint assignment and the quorum
+	seen := make(map[string]struct{}, len(doc.AccountList))
 	for _, account := range doc.AccountList {
-		if _, err := sdk.AccAddressFromBech32(account); err != nil {
+		acc, err := sdk.AccAddressFromBech32(account)
+		if err != nil {
 			return fmt.Errorf("account address is not valid bech32: %v", err)
 		}
+		if _, ok := seen[acc.String()]; ok {
+			return fmt.Errorf("account list contains duplicate account: %s", account)
+		}
+		seen[acc.String()] = struct{}{}
+	}
+	// Strict assignment requires one endpoint per provider
+	if doc.EndpointAssignment == EndpointAssignment_ENDPOINT_ASSIGNMENT_STRICT && len(doc.AccountList) != len(doc.Endpoints) {
+		return fmt.Errorf("strict endpoint assignment requires as many endpoints as accounts: %d endpoints, %d accounts", len(doc.Endpoints), len(doc.AccountList))
+	}
+	// Stake-weighted quorum needs the fraction of stake that must report
+	if doc.QuorumMode == QuorumMode_QUORUM_MODE_STAKE_WEIGHTED {
+		if _, err := doc.StakeQuorumFraction(); err != nil {
+			return err
+		}
+	}
+	// A trusted provider must be the only provider and its oracle type must be allowed by params
+	if doc.QuorumMode == QuorumMode_QUORUM_MODE_TRUSTED_PROVIDER {
+		if !slices.Contains(params.TrustedProviderTypes, doc.OracleType) {
+			return fmt.Errorf("trusted provider mode is not allowed for oracle type %s", doc.OracleType)
+		}
+		if len(doc.AccountList) != 1 || doc.Quorum != 1 {
+			return fmt.Errorf("trusted provider mode requires exactly one account and a quorum of 1")
+		}
 	}
 	// Check if quorum is zero
 	if doc.Quorum == 0 {
@@ -83,6 +153,110 @@ func (doc OracleRequestDoc) ValidateWithParams(params Params) error {
 	return nil
 }
 
+// AggregationRules returns the aggregation rule followed by the fallback rules, in the order they are tried
+func (doc OracleRequestDoc) AggregationRules() []AggregationRule {
+	return append([]AggregationRule{doc.AggregationRule}, doc.FallbackRules...)
+}
+
+// StakeQuorumFraction parses the stake fraction required by a stake-weighted quorum
+func (doc OracleRequestDoc) StakeQuorumFraction() (sdkmath.LegacyDec, error) {
+	fraction, err := sdkmath.LegacyNewDecFromStr(doc.QuorumStakeFraction)
+	if err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("quorum stake fraction is not a decimal: %q", doc.QuorumStakeFraction)
+	}
+	if !fraction.IsPositive() || fraction.GT(sdkmath.LegacyOneDec()) {
+		return sdkmath.LegacyDec{}, fmt.Errorf("quorum stake fraction must be greater than 0 and at most 1: %s", fraction)
+	}
+	return fraction, nil
+}
+
+// NormalizeAccountList returns accounts in canonical bech32 form, e.g. in lower case for an address written in upper case.
+// It fails on the first account that is not a valid bech32 account address.
+func NormalizeAccountList(accounts []string) ([]string, error) {
+	normalized := make([]string, len(accounts))
+	for i, account := range accounts {
+		acc, err := sdk.AccAddressFromBech32(account)
+		if err != nil {
+			return nil, fmt.Errorf("account address is not valid bech32: %v", err)
+		}
+		normalized[i] = acc.String()
+	}
+	return normalized, nil
+}
+
+// Validate checks that the transform can be applied. A nil transform is valid.
+func (t *EndpointTransform) Validate() error {
+	if t == nil || t.Scale == "" {
+		return nil
+	}
+
+	scale, ok := new(big.Float).SetString(t.Scale)
+	if !ok {
+		return fmt.Errorf("scale is not a decimal number: %q", t.Scale)
+	}
+	if scale.Sign() <= 0 {
+		return fmt.Errorf("scale must be positive: %q", t.Scale)
+	}
+
+	return nil
+}
+
+// IsNumeric reports whether results of this oracle type are consumed as decimal numbers
+func (t OracleType) IsNumeric() bool {
+	switch t {
+	case OracleType_ORACLE_TYPE_MIN_GAS_PRICE,
+		OracleType_ORACLE_TYPE_CURRENCY,
+		OracleType_ORACLE_TYPE_STOCK,
+		OracleType_ORACLE_TYPE_CRYPTO:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsPrice reports whether results of this oracle type are market prices, which must always be aggregated
+// from several providers
+func (t OracleType) IsPrice() bool {
+	switch t {
+	case OracleType_ORACLE_TYPE_CURRENCY,
+		OracleType_ORACLE_TYPE_STOCK,
+		OracleType_ORACLE_TYPE_CRYPTO:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateRawData checks that submitted raw data fits the oracle type of its request.
+// Numeric types require a positive OracleValue within the min_raw_value and max_raw_value params;
+// other types accept any non-empty value.
+func ValidateRawData(oracleType OracleType, rawData string, params Params) error {
+	if rawData == "" {
+		return errorsmod.Wrap(ErrInvalidRawData, "raw data is empty")
+	}
+	if !oracleType.IsNumeric() {
+		return nil
+	}
+
+	value, err := ParseOracleValue(rawData)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidRawData, "raw data %q is not a valid decimal for %s: %v", rawData, oracleType, err)
+	}
+	if !value.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidRawData, "raw data %q must be positive for %s", rawData, oracleType)
+	}
+
+	lower, upper := params.RawValueBounds()
+	if lower.IsPositive() && value.Dec().LT(lower) {
+		return errorsmod.Wrapf(ErrInvalidRawData, "raw data %s is below the minimum %s", value, lower)
+	}
+	if upper.IsPositive() && value.Dec().GT(upper) {
+		return errorsmod.Wrapf(ErrInvalidRawData, "raw data %s is above the maximum %s", value, upper)
+	}
+
+	return nil
+}
+
 // Validate performs basic validation on OracleRequestDoc with default limits
 func (doc OracleRequestDoc) Validate() error {
 	// Use default parameters for validation
@@ -90,21 +264,64 @@ func (doc OracleRequestDoc) Validate() error {
 	return doc.ValidateWithParams(defaultParams)
 }
 
-// SignBytes returns the canonical, unhashed bytes to be signed for SubmitDataSet.
-// This encoding matches the Hash() preimage exactly, but without hashing.
-func (sds SubmitDataSet) Bytes() ([]byte, error) {
-	domain := []byte("guru.oracle.SubmitDataSet")
+// RoundTimingSmoothing is the weight of the newest round in the round timing moving averages
+var RoundTimingSmoothing = sdkmath.LegacyNewDecWithPrec(2, 1)
+
+// Observe folds the duration of a round that finalized at height into the moving averages.
+// The first sample seeds the averages; a stored average that fails to parse is reseeded.
+func (t RoundTiming) Observe(seconds, blocks, height uint64) RoundTiming {
+	emaSeconds := sdkmath.LegacyNewDec(int64(seconds))
+	emaBlocks := sdkmath.LegacyNewDec(int64(blocks))
+	if t.Samples > 0 {
+		prevSeconds, errSeconds := sdkmath.LegacyNewDecFromStr(t.EmaDurationSeconds)
+		prevBlocks, errBlocks := sdkmath.LegacyNewDecFromStr(t.EmaDurationBlocks)
+		if errSeconds == nil && errBlocks == nil {
+			keep := sdkmath.LegacyOneDec().Sub(RoundTimingSmoothing)
+			emaSeconds = emaSeconds.Mul(RoundTimingSmoothing).Add(prevSeconds.Mul(keep))
+			emaBlocks = emaBlocks.Mul(RoundTimingSmoothing).Add(prevBlocks.Mul(keep))
+		}
+	}
+
+	return RoundTiming{
+		RequestId:           t.RequestId,
+		Samples:             t.Samples + 1,
+		EmaDurationSeconds:  emaSeconds.String(),
+		EmaDurationBlocks:   emaBlocks.String(),
+		LastDurationSeconds: seconds,
+		LastDurationBlocks:  blocks,
+		LastHeight:          height,
+	}
+}
+
+// StartRound records the block at which the open round of the request started
+func (t RoundTiming) StartRound(height, blockTime uint64) RoundTiming {
+	t.RoundStartHeight = height
+	t.RoundStartTime = blockTime
+	return t
+}
+
+// SubmitDataSetSignDomain tags the bytes signed for a SubmitDataSet. The version suffix changes whenever the encoding does.
+const SubmitDataSetSignDomain = "guru.oracle.SubmitDataSet/v2"
+
+// Bytes returns the canonical, unhashed bytes to be signed for SubmitDataSet.
+// The domain tag and chainID are prefixed so a signature is only valid for this message type on this chain.
+func (sds SubmitDataSet) Bytes(chainID string) ([]byte, error) {
+	domain := []byte(SubmitDataSetSignDomain)
 
-	buf := make([]byte, 0, len(domain)+8+8+4+len(sds.RawData)+20)
+	buf := make([]byte, 0, len(domain)+4+len(chainID)+8+8+4+len(sds.RawData)+20)
 	buf = append(buf, domain...)
 
+	var l4 [4]byte
+	binary.BigEndian.PutUint32(l4[:], uint32(len(chainID)))
+	buf = append(buf, l4[:]...)
+	buf = append(buf, chainID...)
+
 	var u64 [8]byte
 	binary.BigEndian.PutUint64(u64[:], sds.RequestId)
 	buf = append(buf, u64[:]...)
 	binary.BigEndian.PutUint64(u64[:], sds.Nonce)
 	buf = append(buf, u64[:]...)
 
-	var l4 [4]byte
 	binary.BigEndian.PutUint32(l4[:], uint32(len(sds.RawData)))
 	buf = append(buf, l4[:]...)
 	buf = append(buf, []byte(sds.RawData)...)
@@ -116,3 +333,28 @@ func (sds SubmitDataSet) Bytes() ([]byte, error) {
 
 	return append(buf, acc.Bytes()...), nil
 }
+
+// SubmitSignature checks that sig has the shape produced by the provider's key type and returns it in the form
+// the key verifies. eth_secp256k1 signatures are [R || S || V] with the recovery id normalized to 0 or 1;
+// ed25519 signatures are used as is. Other key types cannot sign a SubmitDataSet.
+func SubmitSignature(pubKey cryptotypes.PubKey, sig []byte) ([]byte, error) {
+	switch pubKey.(type) {
+	case *ethsecp256k1.PubKey:
+		if len(sig) != crypto.SignatureLength {
+			return nil, fmt.Errorf("invalid signature length")
+		}
+		if sig[64] >= 27 {
+			sig[64] -= 27
+		}
+		if sig[64] != 0 && sig[64] != 1 {
+			return nil, fmt.Errorf("invalid signature recovery id")
+		}
+	case *ed25519.PubKey:
+		if len(sig) != ed25519.SignatureSize {
+			return nil, fmt.Errorf("invalid signature length")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported provider key type %s", pubKey.Type())
+	}
+	return sig, nil
+}
@@ -103,6 +103,112 @@ func (RequestStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f372f15f6da5f250, []int{1}
 }
 
+// EndpointAssignment defines how providers are mapped to endpoints when the
+// account list and endpoint list differ in length
+type EndpointAssignment int32
+
+const (
+	// Default value, behaves like ENDPOINT_ASSIGNMENT_WRAP
+	EndpointAssignment_ENDPOINT_ASSIGNMENT_UNSPECIFIED EndpointAssignment = 0
+	// Providers wrap around the endpoint list, so several may share an endpoint
+	EndpointAssignment_ENDPOINT_ASSIGNMENT_WRAP EndpointAssignment = 1
+	// The account list and endpoint list must have the same length
+	EndpointAssignment_ENDPOINT_ASSIGNMENT_STRICT EndpointAssignment = 2
+)
+
+var EndpointAssignment_name = map[int32]string{
+	0: "ENDPOINT_ASSIGNMENT_UNSPECIFIED",
+	1: "ENDPOINT_ASSIGNMENT_WRAP",
+	2: "ENDPOINT_ASSIGNMENT_STRICT",
+}
+
+var EndpointAssignment_value = map[string]int32{
+	"ENDPOINT_ASSIGNMENT_UNSPECIFIED": 0,
+	"ENDPOINT_ASSIGNMENT_WRAP":        1,
+	"ENDPOINT_ASSIGNMENT_STRICT":      2,
+}
+
+func (x EndpointAssignment) String() string {
+	return proto.EnumName(EndpointAssignment_name, int32(x))
+}
+
+func (EndpointAssignment) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f372f15f6da5f250, []int{2}
+}
+
+// QuorumMode defines how a round decides that enough providers reported
+type QuorumMode int32
+
+const (
+	// Default value, behaves like QUORUM_MODE_COUNT
+	QuorumMode_QUORUM_MODE_UNSPECIFIED QuorumMode = 0
+	// At least quorum providers must report
+	QuorumMode_QUORUM_MODE_COUNT QuorumMode = 1
+	// The reporting providers must hold at least quorum_stake_fraction of the
+	// bonded stake of all providers in the account list
+	QuorumMode_QUORUM_MODE_STAKE_WEIGHTED QuorumMode = 2
+	// The single provider in the account list is trusted: its submission
+	// finalizes the round without outlier filtering. Only allowed for the
+	// oracle types listed in the trusted_provider_types param
+	QuorumMode_QUORUM_MODE_TRUSTED_PROVIDER QuorumMode = 3
+)
+
+var QuorumMode_name = map[int32]string{
+	0: "QUORUM_MODE_UNSPECIFIED",
+	1: "QUORUM_MODE_COUNT",
+	2: "QUORUM_MODE_STAKE_WEIGHTED",
+	3: "QUORUM_MODE_TRUSTED_PROVIDER",
+}
+
+var QuorumMode_value = map[string]int32{
+	"QUORUM_MODE_UNSPECIFIED":      0,
+	"QUORUM_MODE_COUNT":            1,
+	"QUORUM_MODE_STAKE_WEIGHTED":   2,
+	"QUORUM_MODE_TRUSTED_PROVIDER": 3,
+}
+
+func (x QuorumMode) String() string {
+	return proto.EnumName(QuorumMode_name, int32(x))
+}
+
+func (QuorumMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f372f15f6da5f250, []int{3}
+}
+
+// CoverageMode defines whether a round may finalize without every provider
+type CoverageMode int32
+
+const (
+	// Default value, behaves like COVERAGE_MODE_QUORUM
+	CoverageMode_COVERAGE_MODE_UNSPECIFIED CoverageMode = 0
+	// The round finalizes once the quorum is met
+	CoverageMode_COVERAGE_MODE_QUORUM CoverageMode = 1
+	// The round only finalizes once every provider in the account list
+	// reported and no report was excluded as an outlier; otherwise it stays
+	// open and providers retry
+	CoverageMode_COVERAGE_MODE_FULL CoverageMode = 2
+)
+
+var CoverageMode_name = map[int32]string{
+	0: "COVERAGE_MODE_UNSPECIFIED",
+	1: "COVERAGE_MODE_QUORUM",
+	2: "COVERAGE_MODE_FULL",
+}
+
+var CoverageMode_value = map[string]int32{
+	"COVERAGE_MODE_UNSPECIFIED": 0,
+	"COVERAGE_MODE_QUORUM":      1,
+	"COVERAGE_MODE_FULL":        2,
+}
+
+func (x CoverageMode) String() string {
+	return proto.EnumName(CoverageMode_name, int32(x))
+}
+
+func (CoverageMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f372f15f6da5f250, []int{4}
+}
+
 // AggregationRule defines the enumeration for aggregating oracle data
 // Specifies how multiple data points should be combined into a single value
 type AggregationRule int32
@@ -118,6 +224,9 @@ const (
 	AggregationRule_AGGREGATION_RULE_MAX AggregationRule = 3
 	// Use median value to aggregate the data
 	AggregationRule_AGGREGATION_RULE_MEDIAN AggregationRule = 4
+	// Use the median weighted by the bonded stake of each submitting provider.
+	// Fails when no submitting provider has bonded stake, so it is usually followed by a fallback rule.
+	AggregationRule_AGGREGATION_RULE_STAKE_WEIGHTED_MEDIAN AggregationRule = 5
 )
 
 var AggregationRule_name = map[int32]string{
@@ -126,14 +235,16 @@ var AggregationRule_name = map[int32]string{
 	2: "AGGREGATION_RULE_MIN",
 	3: "AGGREGATION_RULE_MAX",
 	4: "AGGREGATION_RULE_MEDIAN",
+	5: "AGGREGATION_RULE_STAKE_WEIGHTED_MEDIAN",
 }
 
 var AggregationRule_value = map[string]int32{
-	"AGGREGATION_RULE_UNSPECIFIED": 0,
-	"AGGREGATION_RULE_AVG":         1,
-	"AGGREGATION_RULE_MIN":         2,
-	"AGGREGATION_RULE_MAX":         3,
-	"AGGREGATION_RULE_MEDIAN":      4,
+	"AGGREGATION_RULE_UNSPECIFIED":           0,
+	"AGGREGATION_RULE_AVG":                   1,
+	"AGGREGATION_RULE_MIN":                   2,
+	"AGGREGATION_RULE_MAX":                   3,
+	"AGGREGATION_RULE_MEDIAN":                4,
+	"AGGREGATION_RULE_STAKE_WEIGHTED_MEDIAN": 5,
 }
 
 func (x AggregationRule) String() string {
@@ -141,7 +252,44 @@ func (x AggregationRule) String() string {
 }
 
 func (AggregationRule) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f372f15f6da5f250, []int{2}
+	return fileDescriptor_f372f15f6da5f250, []int{5}
+}
+
+// ResultFormat defines which form of the aggregated value a request emits in
+// its completion event
+type ResultFormat int32
+
+const (
+	// Default value, behaves like RESULT_FORMAT_NORMALIZED
+	ResultFormat_RESULT_FORMAT_UNSPECIFIED ResultFormat = 0
+	// The canonical decimal rounded to 18 places (e.g. "0.333333333333333333")
+	ResultFormat_RESULT_FORMAT_NORMALIZED ResultFormat = 1
+	// The aggregate before rounding, as computed from the submissions
+	ResultFormat_RESULT_FORMAT_RAW ResultFormat = 2
+	// The canonical decimal and the aggregate before rounding
+	ResultFormat_RESULT_FORMAT_BOTH ResultFormat = 3
+)
+
+var ResultFormat_name = map[int32]string{
+	0: "RESULT_FORMAT_UNSPECIFIED",
+	1: "RESULT_FORMAT_NORMALIZED",
+	2: "RESULT_FORMAT_RAW",
+	3: "RESULT_FORMAT_BOTH",
+}
+
+var ResultFormat_value = map[string]int32{
+	"RESULT_FORMAT_UNSPECIFIED": 0,
+	"RESULT_FORMAT_NORMALIZED":  1,
+	"RESULT_FORMAT_RAW":         2,
+	"RESULT_FORMAT_BOTH":        3,
+}
+
+func (x ResultFormat) String() string {
+	return proto.EnumName(ResultFormat_name, int32(x))
+}
+
+func (ResultFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f372f15f6da5f250, []int{6}
 }
 
 // OracleRequestDoc defines the structure for oracle request documents
@@ -169,6 +317,19 @@ type OracleRequestDoc struct {
 	Status RequestStatus `protobuf:"varint,10,opt,name=status,proto3,enum=guru.oracle.v1.RequestStatus" json:"status,omitempty"`
 	// Sequential number to ensure data freshness
 	Nonce uint64 `protobuf:"varint,12,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// How providers are assigned to endpoints
+	EndpointAssignment EndpointAssignment `protobuf:"varint,13,opt,name=endpoint_assignment,json=endpointAssignment,proto3,enum=guru.oracle.v1.EndpointAssignment" json:"endpoint_assignment,omitempty"`
+	// How the quorum of a round is decided
+	QuorumMode QuorumMode `protobuf:"varint,14,opt,name=quorum_mode,json=quorumMode,proto3,enum=guru.oracle.v1.QuorumMode" json:"quorum_mode,omitempty"`
+	// Decimal fraction in (0, 1] of the providers' bonded stake required with
+	// QUORUM_MODE_STAKE_WEIGHTED (e.g. "0.67")
+	QuorumStakeFraction string `protobuf:"bytes,15,opt,name=quorum_stake_fraction,json=quorumStakeFraction,proto3" json:"quorum_stake_fraction,omitempty"`
+	// Rules tried in order when aggregation_rule fails to produce a result
+	FallbackRules []AggregationRule `protobuf:"varint,16,rep,packed,name=fallback_rules,json=fallbackRules,proto3,enum=guru.oracle.v1.AggregationRule" json:"fallback_rules,omitempty"`
+	// Form of the aggregated value emitted when a round completes
+	ResultFormat ResultFormat `protobuf:"varint,17,opt,name=result_format,json=resultFormat,proto3,enum=guru.oracle.v1.ResultFormat" json:"result_format,omitempty"`
+	// Whether a round may finalize with partial provider coverage
+	CoverageMode CoverageMode `protobuf:"varint,18,opt,name=coverage_mode,json=coverageMode,proto3,enum=guru.oracle.v1.CoverageMode" json:"coverage_mode,omitempty"`
 }
 
 func (m *OracleRequestDoc) Reset()         { *m = OracleRequestDoc{} }
@@ -281,11 +442,59 @@ func (m *OracleRequestDoc) GetNonce() uint64 {
 	return 0
 }
 
+func (m *OracleRequestDoc) GetEndpointAssignment() EndpointAssignment {
+	if m != nil {
+		return m.EndpointAssignment
+	}
+	return EndpointAssignment_ENDPOINT_ASSIGNMENT_UNSPECIFIED
+}
+
+func (m *OracleRequestDoc) GetQuorumMode() QuorumMode {
+	if m != nil {
+		return m.QuorumMode
+	}
+	return QuorumMode_QUORUM_MODE_UNSPECIFIED
+}
+
+func (m *OracleRequestDoc) GetQuorumStakeFraction() string {
+	if m != nil {
+		return m.QuorumStakeFraction
+	}
+	return ""
+}
+
+func (m *OracleRequestDoc) GetFallbackRules() []AggregationRule {
+	if m != nil {
+		return m.FallbackRules
+	}
+	return nil
+}
+
+func (m *OracleRequestDoc) GetResultFormat() ResultFormat {
+	if m != nil {
+		return m.ResultFormat
+	}
+	return ResultFormat_RESULT_FORMAT_UNSPECIFIED
+}
+
+func (m *OracleRequestDoc) GetCoverageMode() CoverageMode {
+	if m != nil {
+		return m.CoverageMode
+	}
+	return CoverageMode_COVERAGE_MODE_UNSPECIFIED
+}
+
 type OracleEndpoint struct {
 	// URL of the oracle endpoint
 	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	// Type of the oracle endpoint
 	ParseRule string `protobuf:"bytes,2,opt,name=parse_rule,json=parseRule,proto3" json:"parse_rule,omitempty"`
+	// Optional transform applied to the extracted value before submission
+	Transform *EndpointTransform `protobuf:"bytes,3,opt,name=transform,proto3" json:"transform,omitempty"`
+	// Fully qualified name of a protobuf message type (e.g. "example.feed.v1.Quote").
+	// When set, the response body is decoded as that message and parse_rule addresses its fields
+	// by their proto names. The type must be registered in the oracle daemon.
+	MessageType string `protobuf:"bytes,4,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
 }
 
 func (m *OracleEndpoint) Reset()         { *m = OracleEndpoint{} }
@@ -335,6 +544,76 @@ func (m *OracleEndpoint) GetParseRule() string {
 	return ""
 }
 
+func (m *OracleEndpoint) GetTransform() *EndpointTransform {
+	if m != nil {
+		return m.Transform
+	}
+	return nil
+}
+
+func (m *OracleEndpoint) GetMessageType() string {
+	if m != nil {
+		return m.MessageType
+	}
+	return ""
+}
+
+// EndpointTransform defines a numeric transform applied to an extracted value.
+// When both are set, the value is inverted first and then scaled.
+type EndpointTransform struct {
+	// Decimal multiplier applied to the value (e.g. "1000" for a feed reported in thousands)
+	Scale string `protobuf:"bytes,1,opt,name=scale,proto3" json:"scale,omitempty"`
+	// Replace the value with its reciprocal (e.g. USD/KRW -> KRW/USD)
+	Invert bool `protobuf:"varint,2,opt,name=invert,proto3" json:"invert,omitempty"`
+}
+
+func (m *EndpointTransform) Reset()         { *m = EndpointTransform{} }
+func (m *EndpointTransform) String() string { return proto.CompactTextString(m) }
+func (*EndpointTransform) ProtoMessage()    {}
+func (*EndpointTransform) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f372f15f6da5f250, []int{2}
+}
+func (m *EndpointTransform) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndpointTransform) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndpointTransform.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndpointTransform) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndpointTransform.Merge(m, src)
+}
+func (m *EndpointTransform) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndpointTransform) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndpointTransform.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndpointTransform proto.InternalMessageInfo
+
+func (m *EndpointTransform) GetScale() string {
+	if m != nil {
+		return m.Scale
+	}
+	return ""
+}
+
+func (m *EndpointTransform) GetInvert() bool {
+	if m != nil {
+		return m.Invert
+	}
+	return false
+}
+
 // SubmitDataSet defines the structure for oracle data sets for submit
 // This represents a single data point provided by an oracle node
 type SubmitDataSet struct {
@@ -354,7 +633,7 @@ func (m *SubmitDataSet) Reset()         { *m = SubmitDataSet{} }
 func (m *SubmitDataSet) String() string { return proto.CompactTextString(m) }
 func (*SubmitDataSet) ProtoMessage()    {}
 func (*SubmitDataSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f372f15f6da5f250, []int{2}
+	return fileDescriptor_f372f15f6da5f250, []int{3}
 }
 func (m *SubmitDataSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -430,13 +709,19 @@ type DataSet struct {
 	BlockTime uint64 `protobuf:"varint,4,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
 	// raw_data represents the raw data in string format (can be JSON, CSV, etc.)
 	RawData string `protobuf:"bytes,5,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	// aggregation_rule is the rule that produced raw_data, which differs from
+	// the request's aggregation_rule when a fallback rule was used
+	AggregationRule AggregationRule `protobuf:"varint,6,opt,name=aggregation_rule,json=aggregationRule,proto3,enum=guru.oracle.v1.AggregationRule" json:"aggregation_rule,omitempty"`
+	// raw_aggregate is the aggregate before rounding to raw_data. It is stored
+	// for every result_format, which only selects the event attributes
+	RawAggregate string `protobuf:"bytes,7,opt,name=raw_aggregate,json=rawAggregate,proto3" json:"raw_aggregate,omitempty"`
 }
 
 func (m *DataSet) Reset()         { *m = DataSet{} }
 func (m *DataSet) String() string { return proto.CompactTextString(m) }
 func (*DataSet) ProtoMessage()    {}
 func (*DataSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f372f15f6da5f250, []int{3}
+	return fileDescriptor_f372f15f6da5f250, []int{4}
 }
 func (m *DataSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,70 +785,247 @@ func (m *DataSet) GetRawData() string {
 	return ""
 }
 
+func (m *DataSet) GetAggregationRule() AggregationRule {
+	if m != nil {
+		return m.AggregationRule
+	}
+	return AggregationRule_AGGREGATION_RULE_UNSPECIFIED
+}
+
+func (m *DataSet) GetRawAggregate() string {
+	if m != nil {
+		return m.RawAggregate
+	}
+	return ""
+}
+
+// RoundTiming tracks how long the rounds of a request take from starting, when the request was
+// registered or updated or the previous round finalized or was cancelled, to finalizing. It is
+// updated on-chain at every finalization and reset when the request document is updated.
+type RoundTiming struct {
+	// request_id represents the ID of the request the statistics belong to
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// samples is the number of finalized rounds folded into the averages
+	Samples uint64 `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`
+	// ema_duration_seconds is the exponential moving average of the round duration in seconds, as a decimal
+	EmaDurationSeconds string `protobuf:"bytes,3,opt,name=ema_duration_seconds,json=emaDurationSeconds,proto3" json:"ema_duration_seconds,omitempty"`
+	// ema_duration_blocks is the exponential moving average of the round duration in blocks, as a decimal
+	EmaDurationBlocks string `protobuf:"bytes,4,opt,name=ema_duration_blocks,json=emaDurationBlocks,proto3" json:"ema_duration_blocks,omitempty"`
+	// last_duration_seconds is the duration of the most recent round in seconds
+	LastDurationSeconds uint64 `protobuf:"varint,5,opt,name=last_duration_seconds,json=lastDurationSeconds,proto3" json:"last_duration_seconds,omitempty"`
+	// last_duration_blocks is the duration of the most recent round in blocks
+	LastDurationBlocks uint64 `protobuf:"varint,6,opt,name=last_duration_blocks,json=lastDurationBlocks,proto3" json:"last_duration_blocks,omitempty"`
+	// last_height is the block height at which the most recent round finalized
+	LastHeight uint64 `protobuf:"varint,7,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// round_start_height is the block height at which the current round started
+	RoundStartHeight uint64 `protobuf:"varint,8,opt,name=round_start_height,json=roundStartHeight,proto3" json:"round_start_height,omitempty"`
+	// round_start_time is the block time, in unix seconds, at which the current round started
+	RoundStartTime uint64 `protobuf:"varint,9,opt,name=round_start_time,json=roundStartTime,proto3" json:"round_start_time,omitempty"`
+}
+
+func (m *RoundTiming) Reset()         { *m = RoundTiming{} }
+func (m *RoundTiming) String() string { return proto.CompactTextString(m) }
+func (*RoundTiming) ProtoMessage()    {}
+func (*RoundTiming) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f372f15f6da5f250, []int{5}
+}
+func (m *RoundTiming) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoundTiming) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoundTiming.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoundTiming) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundTiming.Merge(m, src)
+}
+func (m *RoundTiming) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoundTiming) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundTiming.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoundTiming proto.InternalMessageInfo
+
+func (m *RoundTiming) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
+func (m *RoundTiming) GetSamples() uint64 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
+func (m *RoundTiming) GetEmaDurationSeconds() string {
+	if m != nil {
+		return m.EmaDurationSeconds
+	}
+	return ""
+}
+
+func (m *RoundTiming) GetEmaDurationBlocks() string {
+	if m != nil {
+		return m.EmaDurationBlocks
+	}
+	return ""
+}
+
+func (m *RoundTiming) GetLastDurationSeconds() uint64 {
+	if m != nil {
+		return m.LastDurationSeconds
+	}
+	return 0
+}
+
+func (m *RoundTiming) GetLastDurationBlocks() uint64 {
+	if m != nil {
+		return m.LastDurationBlocks
+	}
+	return 0
+}
+
+func (m *RoundTiming) GetLastHeight() uint64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+func (m *RoundTiming) GetRoundStartHeight() uint64 {
+	if m != nil {
+		return m.RoundStartHeight
+	}
+	return 0
+}
+
+func (m *RoundTiming) GetRoundStartTime() uint64 {
+	if m != nil {
+		return m.RoundStartTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("guru.oracle.v1.OracleType", OracleType_name, OracleType_value)
 	proto.RegisterEnum("guru.oracle.v1.RequestStatus", RequestStatus_name, RequestStatus_value)
+	proto.RegisterEnum("guru.oracle.v1.EndpointAssignment", EndpointAssignment_name, EndpointAssignment_value)
+	proto.RegisterEnum("guru.oracle.v1.QuorumMode", QuorumMode_name, QuorumMode_value)
+	proto.RegisterEnum("guru.oracle.v1.CoverageMode", CoverageMode_name, CoverageMode_value)
 	proto.RegisterEnum("guru.oracle.v1.AggregationRule", AggregationRule_name, AggregationRule_value)
+	proto.RegisterEnum("guru.oracle.v1.ResultFormat", ResultFormat_name, ResultFormat_value)
 	proto.RegisterType((*OracleRequestDoc)(nil), "guru.oracle.v1.OracleRequestDoc")
 	proto.RegisterType((*OracleEndpoint)(nil), "guru.oracle.v1.OracleEndpoint")
+	proto.RegisterType((*EndpointTransform)(nil), "guru.oracle.v1.EndpointTransform")
 	proto.RegisterType((*SubmitDataSet)(nil), "guru.oracle.v1.SubmitDataSet")
 	proto.RegisterType((*DataSet)(nil), "guru.oracle.v1.DataSet")
+	proto.RegisterType((*RoundTiming)(nil), "guru.oracle.v1.RoundTiming")
 }
 
 func init() { proto.RegisterFile("guru/oracle/v1/oracle.proto", fileDescriptor_f372f15f6da5f250) }
 
 var fileDescriptor_f372f15f6da5f250 = []byte{
-	// 799 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x73, 0xe3, 0x44,
-	0x10, 0x8d, 0x6c, 0xe7, 0xc3, 0x9d, 0x8f, 0x15, 0x53, 0xd9, 0xa0, 0x78, 0x37, 0x5a, 0x6f, 0x4e,
-	0xae, 0x1c, 0xac, 0x4a, 0x28, 0x4e, 0x70, 0xd1, 0xda, 0xc2, 0x08, 0xb2, 0xb6, 0x19, 0xc9, 0x14,
-	0xe1, 0xa2, 0x1a, 0xcb, 0xb3, 0x8a, 0x0a, 0x59, 0xa3, 0x1d, 0x8d, 0xb2, 0xec, 0x99, 0x3f, 0x00,
-	0x47, 0x8a, 0x2a, 0x7e, 0x0f, 0x27, 0x6a, 0x8f, 0x1c, 0xa9, 0xe4, 0x8f, 0x50, 0x33, 0x12, 0xf1,
-	0x47, 0x52, 0xc5, 0x81, 0x5b, 0xf7, 0x7b, 0xaf, 0x7b, 0xde, 0x4c, 0xcf, 0x0c, 0x3c, 0x8b, 0x0a,
-	0x5e, 0x58, 0x8c, 0x93, 0x30, 0xa1, 0xd6, 0xcd, 0x79, 0x15, 0x75, 0x33, 0xce, 0x04, 0x43, 0x07,
-	0x92, 0xec, 0x56, 0xd0, 0xcd, 0x79, 0xeb, 0x30, 0x62, 0x11, 0x53, 0x94, 0x25, 0xa3, 0x52, 0xd5,
-	0x7a, 0x11, 0x31, 0x16, 0x25, 0xd4, 0x52, 0xd9, 0xb4, 0x78, 0x63, 0x89, 0x78, 0x4e, 0x73, 0x41,
-	0xe6, 0x59, 0x25, 0x30, 0x43, 0x96, 0xcf, 0x59, 0x6e, 0x4d, 0x49, 0x2e, 0xd7, 0x98, 0x52, 0x41,
-	0xce, 0xad, 0x90, 0xc5, 0x69, 0xc9, 0x9f, 0xfe, 0x59, 0x07, 0x7d, 0xa4, 0x16, 0xc1, 0xf4, 0x6d,
-	0x41, 0x73, 0xd1, 0x67, 0x21, 0x3a, 0x01, 0xe0, 0x65, 0x16, 0xc4, 0x33, 0x43, 0x6b, 0x6b, 0x9d,
-	0x06, 0x6e, 0x56, 0x88, 0x3b, 0x43, 0x9f, 0xc1, 0x6e, 0xe9, 0x2b, 0x10, 0xef, 0x33, 0x6a, 0xd4,
-	0xda, 0x5a, 0xe7, 0xe0, 0xa2, 0xd5, 0x5d, 0x35, 0xdc, 0x2d, 0xbb, 0xfa, 0xef, 0x33, 0x8a, 0x81,
-	0xdd, 0xc7, 0x08, 0x41, 0x23, 0x25, 0x73, 0x6a, 0xd4, 0xdb, 0x5a, 0xa7, 0x89, 0x55, 0x8c, 0xda,
-	0xb0, 0x3b, 0xa3, 0x79, 0xc8, 0xe3, 0x4c, 0xc4, 0x2c, 0x35, 0x1a, 0x8a, 0x5a, 0x86, 0xd0, 0x11,
-	0x6c, 0x65, 0x94, 0xc7, 0x6c, 0x66, 0x6c, 0xb6, 0xb5, 0xce, 0x3e, 0xae, 0x32, 0xf4, 0x12, 0xf6,
-	0x48, 0x18, 0xb2, 0x22, 0x15, 0x41, 0x12, 0xe7, 0xc2, 0xd8, 0x6a, 0xd7, 0x65, 0x69, 0x85, 0x5d,
-	0xc6, 0xb9, 0x90, 0xa5, 0x6f, 0x0b, 0xc6, 0x8b, 0xb9, 0xb1, 0x5d, 0x96, 0x96, 0x19, 0xfa, 0x1c,
-	0x9a, 0x34, 0x9d, 0x65, 0x2c, 0x4e, 0x45, 0x6e, 0xec, 0xb4, 0xeb, 0x9d, 0xdd, 0x0b, 0xf3, 0xf1,
-	0x3d, 0x38, 0x95, 0x0c, 0x2f, 0x0a, 0xd0, 0x57, 0xa0, 0x93, 0x28, 0xe2, 0x34, 0x22, 0xd2, 0x5f,
-	0xc0, 0x8b, 0x84, 0x1a, 0x4d, 0x75, 0x10, 0x2f, 0xd6, 0x9b, 0xd8, 0x0b, 0x1d, 0x2e, 0x12, 0x8a,
-	0x9f, 0x90, 0x55, 0x00, 0x7d, 0x0a, 0x5b, 0xb9, 0x20, 0xa2, 0xc8, 0x0d, 0x50, 0x1d, 0x4e, 0xd6,
-	0x3b, 0x54, 0xa3, 0xf1, 0x94, 0x08, 0x57, 0x62, 0x74, 0x08, 0x9b, 0x29, 0x4b, 0x43, 0x6a, 0xec,
-	0xa9, 0x01, 0x95, 0xc9, 0xa9, 0x0d, 0x07, 0xab, 0xae, 0x91, 0x0e, 0xf5, 0x82, 0x27, 0x6a, 0x8c,
-	0x4d, 0x2c, 0x43, 0x39, 0xdf, 0x8c, 0xf0, 0x9c, 0x96, 0xb6, 0x6b, 0x8a, 0x68, 0x2a, 0x44, 0xfa,
-	0x39, 0xfd, 0x55, 0x83, 0x7d, 0xaf, 0x98, 0xce, 0x63, 0xd1, 0x27, 0x82, 0x78, 0x54, 0xfc, 0xd7,
-	0x85, 0xb8, 0x77, 0x52, 0x5b, 0x72, 0x82, 0x8e, 0x61, 0x87, 0x93, 0x77, 0xc1, 0x8c, 0x08, 0x52,
-	0x4d, 0x7b, 0x9b, 0x93, 0x77, 0xb2, 0x25, 0x6a, 0xc1, 0x4e, 0xc6, 0xd9, 0x4d, 0x3c, 0xa3, 0xbc,
-	0x9a, 0xf6, 0x7d, 0x8e, 0x9e, 0x43, 0x33, 0x8f, 0xa3, 0x94, 0x88, 0x82, 0x53, 0x35, 0xed, 0x3d,
-	0xbc, 0x00, 0x4e, 0x7f, 0xd3, 0x60, 0xfb, 0x7f, 0xb9, 0x7a, 0x09, 0x7b, 0xd3, 0x84, 0x85, 0x3f,
-	0x04, 0xd7, 0x34, 0x8e, 0xae, 0x85, 0x72, 0xd6, 0xc0, 0xbb, 0x0a, 0xfb, 0x52, 0x41, 0xb2, 0x6f,
-	0x29, 0x91, 0x8f, 0x49, 0xf9, 0x6b, 0xe0, 0xa6, 0x42, 0xfc, 0x78, 0xbe, 0xba, 0xaf, 0xcd, 0x95,
-	0x7d, 0x9d, 0xfd, 0xa2, 0x01, 0x2c, 0xee, 0x3d, 0x7a, 0x06, 0x1f, 0x8f, 0xb0, 0xdd, 0xbb, 0x74,
-	0x02, 0xff, 0x6a, 0xec, 0x04, 0x93, 0xa1, 0x37, 0x76, 0x7a, 0xee, 0x17, 0xae, 0xd3, 0xd7, 0x37,
-	0xd0, 0x09, 0x1c, 0x2f, 0x93, 0xaf, 0xdd, 0x61, 0x30, 0xb0, 0xbd, 0x60, 0x8c, 0xdd, 0x9e, 0xa3,
-	0x6b, 0xc8, 0x80, 0xc3, 0x65, 0xba, 0x37, 0xc1, 0xd8, 0x19, 0xf6, 0xae, 0xf4, 0x1a, 0x7a, 0x0a,
-	0x1f, 0x2d, 0x33, 0x9e, 0x3f, 0xea, 0x7d, 0xad, 0xd7, 0xd1, 0x11, 0xa0, 0x95, 0x02, 0x7c, 0x35,
-	0xf6, 0x47, 0x7a, 0xe3, 0xec, 0x27, 0x0d, 0xf6, 0x57, 0x2e, 0x10, 0x32, 0xa1, 0x85, 0x9d, 0x6f,
-	0x26, 0x8e, 0xe7, 0x07, 0x9e, 0x6f, 0xfb, 0x13, 0x6f, 0xcd, 0x59, 0x0b, 0x8e, 0xd6, 0x78, 0x67,
-	0x68, 0xbf, 0xba, 0x74, 0xfa, 0xba, 0x86, 0x8e, 0xe1, 0xe9, 0x1a, 0x37, 0xb6, 0x27, 0x9e, 0xd3,
-	0xd7, 0x6b, 0x72, 0xb7, 0x6b, 0x54, 0xdf, 0xf5, 0xca, 0xba, 0xfa, 0xd9, 0xef, 0x1a, 0x3c, 0x59,
-	0x7b, 0x08, 0xa8, 0x0d, 0xcf, 0xed, 0xc1, 0x00, 0x3b, 0x03, 0xdb, 0x77, 0x47, 0xc3, 0x00, 0x4f,
-	0x2e, 0xd7, 0xcf, 0xc8, 0x80, 0xc3, 0x07, 0x0a, 0xfb, 0xdb, 0x41, 0x79, 0x3c, 0x0f, 0x98, 0xd7,
-	0xee, 0x50, 0xaf, 0x3d, 0xce, 0xd8, 0xdf, 0xe9, 0x75, 0x69, 0xf0, 0x21, 0xe3, 0xf4, 0x5d, 0x7b,
-	0xa8, 0x37, 0x5e, 0xb9, 0x7f, 0xdc, 0x9a, 0xda, 0x87, 0x5b, 0x53, 0xfb, 0xfb, 0xd6, 0xd4, 0x7e,
-	0xbe, 0x33, 0x37, 0x3e, 0xdc, 0x99, 0x1b, 0x7f, 0xdd, 0x99, 0x1b, 0xdf, 0x5b, 0x51, 0x2c, 0xae,
-	0x8b, 0x69, 0x37, 0x64, 0x73, 0x4b, 0x3e, 0xcc, 0x37, 0x71, 0x1a, 0x25, 0x6c, 0x4a, 0x12, 0x95,
-	0x59, 0x37, 0x17, 0xd6, 0x8f, 0xff, 0x7e, 0xe2, 0xf2, 0x3f, 0xcc, 0xa7, 0x5b, 0xea, 0x6b, 0xfd,
-	0xe4, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x11, 0xf4, 0x55, 0x54, 0xe0, 0x05, 0x00, 0x00,
+	// 1382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0x25, 0xff, 0xd3, 0x58, 0x76, 0xe8, 0xb5, 0x9d, 0xc7, 0x38, 0x8e, 0xac, 0x38, 0xc0,
+	0x83, 0x21, 0x3c, 0x58, 0x89, 0x1f, 0xde, 0xe9, 0x15, 0x28, 0x68, 0x89, 0x56, 0xd8, 0xc8, 0x92,
+	0xb2, 0xa4, 0x92, 0x26, 0x17, 0x62, 0x45, 0xad, 0x19, 0x22, 0x22, 0xa9, 0x70, 0x49, 0xbb, 0xe9,
+	0xb1, 0x3d, 0xf4, 0xd2, 0x43, 0x7b, 0xec, 0x07, 0xe8, 0x77, 0x29, 0x7a, 0xca, 0xb1, 0xc7, 0xc2,
+	0xf9, 0x22, 0xc5, 0x2e, 0x29, 0x8b, 0x94, 0x54, 0xa4, 0x68, 0x4f, 0xe2, 0xcc, 0xef, 0x37, 0xb3,
+	0xf3, 0x6f, 0x67, 0x05, 0xf7, 0x9d, 0x38, 0x8c, 0xeb, 0x41, 0x48, 0xec, 0x11, 0xad, 0x5f, 0x3d,
+	0x49, 0xbf, 0x4e, 0xc6, 0x61, 0x10, 0x05, 0x68, 0x8b, 0x83, 0x27, 0xa9, 0xea, 0xea, 0xc9, 0xfe,
+	0xae, 0x13, 0x38, 0x81, 0x80, 0xea, 0xfc, 0x2b, 0x61, 0xed, 0x1f, 0x3a, 0x41, 0xe0, 0x8c, 0x68,
+	0x5d, 0x48, 0x83, 0xf8, 0xb2, 0x1e, 0xb9, 0x1e, 0x65, 0x11, 0xf1, 0xc6, 0x29, 0xa1, 0x62, 0x07,
+	0xcc, 0x0b, 0x58, 0x7d, 0x40, 0x18, 0x3f, 0x63, 0x40, 0x23, 0xf2, 0xa4, 0x6e, 0x07, 0xae, 0x9f,
+	0xe0, 0x47, 0x37, 0xab, 0x20, 0x77, 0xc5, 0x21, 0x98, 0xbe, 0x8b, 0x29, 0x8b, 0x9a, 0x81, 0x8d,
+	0x1e, 0x00, 0x84, 0x89, 0x64, 0xb9, 0x43, 0x45, 0xaa, 0x4a, 0xc7, 0xcb, 0xb8, 0x94, 0x6a, 0xf4,
+	0x21, 0xfa, 0x3f, 0x6c, 0x24, 0x71, 0x59, 0xd1, 0xfb, 0x31, 0x55, 0x0a, 0x55, 0xe9, 0x78, 0xeb,
+	0x74, 0xff, 0x24, 0x1f, 0xf0, 0x49, 0xe2, 0xd5, 0x7c, 0x3f, 0xa6, 0x18, 0x82, 0xdb, 0x6f, 0x84,
+	0x60, 0xd9, 0x27, 0x1e, 0x55, 0x8a, 0x55, 0xe9, 0xb8, 0x84, 0xc5, 0x37, 0xaa, 0xc2, 0xc6, 0x90,
+	0x32, 0x3b, 0x74, 0xc7, 0x91, 0x1b, 0xf8, 0xca, 0xb2, 0x80, 0xb2, 0x2a, 0x74, 0x17, 0x56, 0xc7,
+	0x34, 0x74, 0x83, 0xa1, 0xb2, 0x52, 0x95, 0x8e, 0x37, 0x71, 0x2a, 0xa1, 0x87, 0x50, 0x26, 0xb6,
+	0x1d, 0xc4, 0x7e, 0x64, 0x8d, 0x5c, 0x16, 0x29, 0xab, 0xd5, 0x22, 0x37, 0x4d, 0x75, 0x6d, 0x97,
+	0x45, 0xdc, 0xf4, 0x5d, 0x1c, 0x84, 0xb1, 0xa7, 0xac, 0x25, 0xa6, 0x89, 0x84, 0x3e, 0x83, 0x12,
+	0xf5, 0x87, 0xe3, 0xc0, 0xf5, 0x23, 0xa6, 0xac, 0x57, 0x8b, 0xc7, 0x1b, 0xa7, 0x95, 0xc5, 0x39,
+	0x68, 0x29, 0x0d, 0x4f, 0x0d, 0xd0, 0x17, 0x20, 0x13, 0xc7, 0x09, 0xa9, 0x43, 0x78, 0x7c, 0x56,
+	0x18, 0x8f, 0xa8, 0x52, 0x12, 0x85, 0x38, 0x9c, 0x75, 0xa2, 0x4e, 0x79, 0x38, 0x1e, 0x51, 0x7c,
+	0x87, 0xe4, 0x15, 0xe8, 0x7f, 0xb0, 0xca, 0x22, 0x12, 0xc5, 0x4c, 0x01, 0xe1, 0xe1, 0xc1, 0xac,
+	0x87, 0xb4, 0x35, 0x86, 0x20, 0xe1, 0x94, 0x8c, 0x76, 0x61, 0xc5, 0x0f, 0x7c, 0x9b, 0x2a, 0x65,
+	0xd1, 0xa0, 0x44, 0x40, 0x06, 0xec, 0x4c, 0xa2, 0xb4, 0x08, 0x63, 0xae, 0xe3, 0x7b, 0xd4, 0x8f,
+	0x94, 0x4d, 0xe1, 0xf9, 0x68, 0xd6, 0xf3, 0x24, 0x35, 0xf5, 0x96, 0x89, 0x11, 0x9d, 0xd3, 0xf1,
+	0x8e, 0x27, 0x55, 0xb3, 0xbc, 0x60, 0x48, 0x95, 0xad, 0xc5, 0x1d, 0x7f, 0x2e, 0x28, 0x17, 0xc1,
+	0x90, 0x62, 0x78, 0x77, 0xfb, 0x8d, 0x4e, 0x61, 0x2f, 0x35, 0x66, 0x11, 0x79, 0x4b, 0xad, 0xcb,
+	0x90, 0xd8, 0xa2, 0xcf, 0x77, 0x44, 0x9f, 0x77, 0x12, 0xd0, 0xe0, 0xd8, 0x79, 0x0a, 0xa1, 0x73,
+	0xd8, 0xba, 0x24, 0xa3, 0xd1, 0x80, 0xd8, 0x6f, 0x45, 0x6d, 0x99, 0x22, 0x57, 0x8b, 0x7f, 0xa5,
+	0xb8, 0x9b, 0x13, 0x33, 0x2e, 0x31, 0xa4, 0xc2, 0x66, 0x48, 0x59, 0x3c, 0x8a, 0xac, 0xcb, 0x20,
+	0xf4, 0x48, 0xa4, 0x6c, 0x8b, 0xd0, 0x0f, 0xe6, 0x2b, 0xcc, 0x49, 0xe7, 0x82, 0x83, 0xcb, 0x61,
+	0x46, 0xe2, 0x2e, 0xec, 0xe0, 0x8a, 0x86, 0xc4, 0xa1, 0x49, 0xf6, 0x68, 0xb1, 0x8b, 0x46, 0x4a,
+	0x12, 0xf9, 0x97, 0xed, 0x8c, 0x74, 0xf4, 0xb3, 0x04, 0x5b, 0xf9, 0x51, 0x42, 0x32, 0x14, 0xe3,
+	0x70, 0x24, 0xee, 0x56, 0x09, 0xf3, 0x4f, 0x7e, 0xe9, 0xc6, 0x24, 0x64, 0x34, 0x99, 0xa5, 0x82,
+	0x00, 0x4a, 0x42, 0x23, 0x86, 0xe4, 0x73, 0x28, 0x45, 0x21, 0xf1, 0x19, 0xcf, 0x43, 0x5c, 0x9e,
+	0x8d, 0xd3, 0x87, 0x7f, 0xd6, 0x4d, 0x73, 0x42, 0xc4, 0x53, 0x1b, 0x7e, 0x55, 0x3c, 0xca, 0x18,
+	0x71, 0xd2, 0x6b, 0x9b, 0xde, 0xb2, 0x54, 0xc7, 0xef, 0xe6, 0x91, 0x0a, 0xdb, 0x73, 0x2e, 0xf8,
+	0x98, 0x31, 0x9b, 0x8c, 0x68, 0x1a, 0x6b, 0x22, 0xf0, 0x5b, 0xe5, 0xfa, 0x57, 0x34, 0x8c, 0x44,
+	0xa4, 0xeb, 0x38, 0x95, 0x8e, 0x7e, 0x92, 0x60, 0xd3, 0x88, 0x07, 0x9e, 0x1b, 0x35, 0x49, 0x44,
+	0x0c, 0x1a, 0x7d, 0x6a, 0x99, 0xdc, 0x4e, 0x71, 0x21, 0x3b, 0xc5, 0xf7, 0x60, 0x3d, 0x24, 0xd7,
+	0xd6, 0x90, 0x44, 0x24, 0xdd, 0x14, 0x6b, 0x21, 0xb9, 0xe6, 0x2e, 0xd1, 0x3e, 0xac, 0x8f, 0xc3,
+	0xe0, 0xca, 0x1d, 0xd2, 0x30, 0xcd, 0xe1, 0x56, 0x46, 0x07, 0x50, 0xe2, 0x33, 0x4b, 0xa2, 0x38,
+	0xa4, 0x62, 0x53, 0x94, 0xf1, 0x54, 0x71, 0xf4, 0x7d, 0x01, 0xd6, 0xfe, 0x51, 0x54, 0x0f, 0xa1,
+	0x3c, 0x18, 0x05, 0xf6, 0x5b, 0xeb, 0x0d, 0x75, 0x9d, 0x37, 0x91, 0x88, 0x6c, 0x19, 0x6f, 0x08,
+	0xdd, 0x53, 0xa1, 0xe2, 0x7e, 0x13, 0x0a, 0x5f, 0xc4, 0x22, 0xbe, 0x65, 0x5c, 0x12, 0x1a, 0xd3,
+	0xf5, 0xf2, 0x79, 0xad, 0xe4, 0xf3, 0x5a, 0xb4, 0x51, 0x56, 0xff, 0xe6, 0x46, 0x79, 0x04, 0x9b,
+	0xfc, 0x98, 0x89, 0x9a, 0x8a, 0xd5, 0x57, 0xc2, 0xe5, 0x90, 0x5c, 0x4f, 0x6c, 0xe9, 0xd1, 0x77,
+	0x45, 0xd8, 0xc0, 0x41, 0xec, 0x0f, 0x4d, 0xd7, 0x73, 0x7d, 0xe7, 0x53, 0x25, 0x51, 0x60, 0x8d,
+	0x11, 0x6f, 0xcc, 0xef, 0x62, 0x52, 0x94, 0x89, 0x88, 0x1e, 0xc3, 0x2e, 0xf5, 0x88, 0x35, 0x8c,
+	0xc3, 0x24, 0x74, 0x46, 0xed, 0xc0, 0x1f, 0xb2, 0xb4, 0x71, 0x88, 0x7a, 0xa4, 0x99, 0x42, 0x46,
+	0x82, 0xa0, 0x13, 0xd8, 0xc9, 0x59, 0x88, 0x02, 0xb1, 0xb4, 0x9d, 0xdb, 0x19, 0x83, 0x33, 0x01,
+	0xf0, 0x15, 0x32, 0x22, 0x2c, 0x9a, 0x3f, 0x62, 0x45, 0x44, 0xb2, 0xc3, 0xc1, 0xd9, 0x33, 0x1e,
+	0xc3, 0x6e, 0xde, 0x26, 0x3d, 0x64, 0x55, 0x98, 0xa0, 0xac, 0x49, 0x7a, 0xca, 0x21, 0x6c, 0x08,
+	0x8b, 0xb4, 0xbb, 0x6b, 0x82, 0x08, 0x5c, 0x95, 0x36, 0xf7, 0x3f, 0x80, 0x42, 0x5e, 0x30, 0xbe,
+	0xc8, 0xc2, 0x5b, 0xde, 0xba, 0xe0, 0xc9, 0x02, 0x31, 0x38, 0x90, 0xb2, 0x8f, 0x41, 0xce, 0xb2,
+	0xc5, 0x40, 0x94, 0x04, 0x77, 0x6b, 0xca, 0xe5, 0x53, 0x51, 0xfb, 0x51, 0x02, 0x98, 0x3e, 0x97,
+	0xe8, 0x3e, 0xfc, 0xab, 0x8b, 0xd5, 0x46, 0x5b, 0xb3, 0xcc, 0x57, 0x3d, 0xcd, 0xea, 0x77, 0x8c,
+	0x9e, 0xd6, 0xd0, 0xcf, 0x75, 0xad, 0x29, 0x2f, 0xa1, 0x07, 0x70, 0x2f, 0x0b, 0x5e, 0xe8, 0x1d,
+	0xab, 0xa5, 0x1a, 0x56, 0x0f, 0xeb, 0x0d, 0x4d, 0x96, 0x90, 0x02, 0xbb, 0x59, 0xb8, 0xd1, 0xc7,
+	0x58, 0xeb, 0x34, 0x5e, 0xc9, 0x05, 0xb4, 0x07, 0xdb, 0x59, 0xc4, 0x30, 0xbb, 0x8d, 0x67, 0x72,
+	0x11, 0xdd, 0x05, 0x94, 0x33, 0xc0, 0xaf, 0x7a, 0x66, 0x57, 0x5e, 0xae, 0x7d, 0x2b, 0xc1, 0x66,
+	0xee, 0xdd, 0x41, 0x15, 0xd8, 0xc7, 0xda, 0xf3, 0xbe, 0x66, 0x98, 0x96, 0x61, 0xaa, 0x66, 0xdf,
+	0x98, 0x89, 0x6c, 0x1f, 0xee, 0xce, 0xe0, 0x5a, 0x47, 0x3d, 0x6b, 0x6b, 0x4d, 0x59, 0x42, 0xf7,
+	0x60, 0x6f, 0x06, 0xeb, 0xa9, 0x7d, 0x43, 0x6b, 0xca, 0x05, 0x9e, 0xed, 0x0c, 0xd4, 0xd4, 0x8d,
+	0xc4, 0xae, 0x58, 0xbb, 0x06, 0x34, 0xff, 0x44, 0xa1, 0x47, 0x70, 0xa8, 0x75, 0x9a, 0xbd, 0xae,
+	0xde, 0x31, 0x2d, 0xd5, 0x30, 0xf4, 0x56, 0xe7, 0x42, 0xeb, 0x98, 0x33, 0xe1, 0x1c, 0x80, 0xb2,
+	0x88, 0xf4, 0x12, 0xab, 0x3d, 0x59, 0xe2, 0xc9, 0x2c, 0x42, 0x0d, 0x13, 0xeb, 0x0d, 0x53, 0x2e,
+	0xd4, 0xbe, 0x91, 0x00, 0xa6, 0xef, 0x19, 0x0f, 0xf2, 0x79, 0xbf, 0x8b, 0xfb, 0x17, 0xd6, 0x45,
+	0xb7, 0x39, 0xdb, 0x92, 0x3d, 0xd8, 0xce, 0x82, 0x8d, 0x6e, 0xbf, 0x63, 0x26, 0x47, 0x64, 0xd5,
+	0x86, 0xa9, 0x3e, 0xd3, 0xac, 0x97, 0x9a, 0xde, 0x7a, 0x6a, 0x8a, 0xc4, 0xab, 0x70, 0x90, 0xc5,
+	0x4d, 0xdc, 0x37, 0x4c, 0xad, 0x69, 0xf5, 0x70, 0xf7, 0x85, 0xde, 0xd4, 0xb0, 0x5c, 0xac, 0x59,
+	0x50, 0xce, 0xbe, 0x2a, 0xbc, 0xf7, 0x8d, 0xee, 0x0b, 0x0d, 0xab, 0x2d, 0x6d, 0x51, 0x1c, 0x0a,
+	0xec, 0xe6, 0xe1, 0xc4, 0xbd, 0x2c, 0xf1, 0x26, 0xe7, 0x91, 0xf3, 0x7e, 0xbb, 0x2d, 0x17, 0x6a,
+	0xbf, 0x4a, 0x70, 0x67, 0x66, 0x99, 0xf0, 0xb0, 0xd4, 0x56, 0x0b, 0x6b, 0x2d, 0xd5, 0xd4, 0xbb,
+	0x1d, 0x0b, 0xf7, 0xdb, 0x0b, 0xce, 0x99, 0x63, 0xa8, 0x2f, 0x5a, 0xb2, 0xb4, 0x10, 0xb9, 0xd0,
+	0x3b, 0x72, 0x61, 0x31, 0xa2, 0x7e, 0x29, 0x17, 0x79, 0x69, 0xe7, 0x11, 0xad, 0xa9, 0xab, 0x1d,
+	0x79, 0x19, 0xd5, 0xe0, 0xdf, 0x73, 0x60, 0xbe, 0x90, 0x13, 0xee, 0x4a, 0xed, 0x6b, 0x28, 0x67,
+	0x9f, 0x71, 0x5e, 0x2d, 0xac, 0x19, 0xfd, 0xb6, 0x69, 0x9d, 0x77, 0xf1, 0x85, 0xba, 0x60, 0x3e,
+	0xf2, 0x70, 0x87, 0xff, 0xb4, 0xf5, 0xd7, 0x62, 0x60, 0xf7, 0x60, 0x3b, 0x8f, 0x62, 0xf5, 0xa5,
+	0x5c, 0xe0, 0x85, 0xcc, 0xab, 0xcf, 0xba, 0xe6, 0x53, 0xb9, 0x78, 0xa6, 0xbf, 0xae, 0x3b, 0x6e,
+	0xf4, 0x26, 0x1e, 0x9c, 0xd8, 0x81, 0x57, 0xe7, 0xeb, 0xfa, 0xd2, 0xf5, 0x9d, 0x51, 0x30, 0x20,
+	0x23, 0x21, 0xd5, 0xaf, 0x4e, 0xeb, 0x5f, 0x4d, 0xfe, 0xea, 0xf3, 0xe7, 0x97, 0xfd, 0x72, 0x53,
+	0x91, 0x3e, 0xdc, 0x54, 0xa4, 0xdf, 0x6f, 0x2a, 0xd2, 0x0f, 0x1f, 0x2b, 0x4b, 0x1f, 0x3e, 0x56,
+	0x96, 0x7e, 0xfb, 0x58, 0x59, 0x1a, 0xac, 0x8a, 0x3f, 0xe6, 0xff, 0xfd, 0x63, 0x00, 0x5a, 0xeb,
+	0xe8, 0xbd, 0x1e, 0x0c, 0x00, 0x00,
 }
 
 func (m *OracleRequestDoc) Marshal() (dAtA []byte, err error) {
@@ -586,6 +1048,57 @@ func (m *OracleRequestDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CoverageMode != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.CoverageMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.ResultFormat != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResultFormat))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.FallbackRules) > 0 {
+		dAtA2 := make([]byte, len(m.FallbackRules)*10)
+		var j1 int
+		for _, num := range m.FallbackRules {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintOracle(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.QuorumStakeFraction) > 0 {
+		i -= len(m.QuorumStakeFraction)
+		copy(dAtA[i:], m.QuorumStakeFraction)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.QuorumStakeFraction)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.QuorumMode != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.QuorumMode))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.EndpointAssignment != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EndpointAssignment))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.Nonce != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.Nonce))
 		i--
@@ -681,6 +1194,25 @@ func (m *OracleEndpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessageType) > 0 {
+		i -= len(m.MessageType)
+		copy(dAtA[i:], m.MessageType)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.MessageType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.ParseRule) > 0 {
 		i -= len(m.ParseRule)
 		copy(dAtA[i:], m.ParseRule)
@@ -698,6 +1230,46 @@ func (m *OracleEndpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EndpointTransform) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndpointTransform) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndpointTransform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Invert {
+		i--
+		if m.Invert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Scale) > 0 {
+		i -= len(m.Scale)
+		copy(dAtA[i:], m.Scale)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Scale)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SubmitDataSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -772,6 +1344,18 @@ func (m *DataSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RawAggregate) > 0 {
+		i -= len(m.RawAggregate)
+		copy(dAtA[i:], m.RawAggregate)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.RawAggregate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AggregationRule != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AggregationRule))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.RawData) > 0 {
 		i -= len(m.RawData)
 		copy(dAtA[i:], m.RawData)
@@ -802,7 +1386,79 @@ func (m *DataSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+func (m *RoundTiming) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoundTiming) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoundTiming) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RoundStartTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RoundStartTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RoundStartHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RoundStartHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LastHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastDurationBlocks != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastDurationBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastDurationSeconds != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastDurationSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EmaDurationBlocks) > 0 {
+		i -= len(m.EmaDurationBlocks)
+		copy(dAtA[i:], m.EmaDurationBlocks)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.EmaDurationBlocks)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EmaDurationSeconds) > 0 {
+		i -= len(m.EmaDurationSeconds)
+		copy(dAtA[i:], m.EmaDurationSeconds)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.EmaDurationSeconds)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Samples != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestId != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOracle(v)
 	base := offset
 	for v >= 1<<7 {
@@ -860,6 +1516,29 @@ func (m *OracleRequestDoc) Size() (n int) {
 	if m.Nonce != 0 {
 		n += 1 + sovOracle(uint64(m.Nonce))
 	}
+	if m.EndpointAssignment != 0 {
+		n += 1 + sovOracle(uint64(m.EndpointAssignment))
+	}
+	if m.QuorumMode != 0 {
+		n += 1 + sovOracle(uint64(m.QuorumMode))
+	}
+	l = len(m.QuorumStakeFraction)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.FallbackRules) > 0 {
+		l = 0
+		for _, e := range m.FallbackRules {
+			l += sovOracle(uint64(e))
+		}
+		n += 2 + sovOracle(uint64(l)) + l
+	}
+	if m.ResultFormat != 0 {
+		n += 2 + sovOracle(uint64(m.ResultFormat))
+	}
+	if m.CoverageMode != 0 {
+		n += 2 + sovOracle(uint64(m.CoverageMode))
+	}
 	return n
 }
 
@@ -877,6 +1556,30 @@ func (m *OracleEndpoint) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.MessageType)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *EndpointTransform) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Scale)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Invert {
+		n += 2
+	}
 	return n
 }
 
@@ -929,6 +1632,51 @@ func (m *DataSet) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if m.AggregationRule != 0 {
+		n += 1 + sovOracle(uint64(m.AggregationRule))
+	}
+	l = len(m.RawAggregate)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *RoundTiming) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovOracle(uint64(m.RequestId))
+	}
+	if m.Samples != 0 {
+		n += 1 + sovOracle(uint64(m.Samples))
+	}
+	l = len(m.EmaDurationSeconds)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.EmaDurationBlocks)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.LastDurationSeconds != 0 {
+		n += 1 + sovOracle(uint64(m.LastDurationSeconds))
+	}
+	if m.LastDurationBlocks != 0 {
+		n += 1 + sovOracle(uint64(m.LastDurationBlocks))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovOracle(uint64(m.LastHeight))
+	}
+	if m.RoundStartHeight != 0 {
+		n += 1 + sovOracle(uint64(m.RoundStartHeight))
+	}
+	if m.RoundStartTime != 0 {
+		n += 1 + sovOracle(uint64(m.RoundStartTime))
+	}
 	return n
 }
 
@@ -1230,59 +1978,47 @@ func (m *OracleRequestDoc) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointAssignment", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OracleEndpoint) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			m.EndpointAssignment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndpointAssignment |= EndpointAssignment(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumMode", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.QuorumMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumMode |= QuorumMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OracleEndpoint: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumStakeFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1310,13 +2046,82 @@ func (m *OracleEndpoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.QuorumStakeFraction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParseRule", wireType)
+		case 16:
+			if wireType == 0 {
+				var v AggregationRule
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= AggregationRule(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FallbackRules = append(m.FallbackRules, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOracle
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOracle
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.FallbackRules) == 0 {
+					m.FallbackRules = make([]AggregationRule, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v AggregationRule
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= AggregationRule(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FallbackRules = append(m.FallbackRules, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRules", wireType)
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultFormat", wireType)
 			}
-			var stringLen uint64
+			m.ResultFormat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1326,24 +2131,30 @@ func (m *OracleEndpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ResultFormat |= ResultFormat(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoverageMode", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CoverageMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoverageMode |= CoverageMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ParseRule = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1365,7 +2176,7 @@ func (m *OracleEndpoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmitDataSet) Unmarshal(dAtA []byte) error {
+func (m *OracleEndpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1388,17 +2199,17 @@ func (m *SubmitDataSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmitDataSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleEndpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmitDataSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			m.RequestId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1408,33 +2219,27 @@ func (m *SubmitDataSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParseRule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1462,11 +2267,47 @@ func (m *SubmitDataSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawData = string(dAtA[iNdEx:postIndex])
+			m.ParseRule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &EndpointTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1494,13 +2335,63 @@ func (m *SubmitDataSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			m.MessageType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndpointTransform) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndpointTransform: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndpointTransform: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1510,26 +2401,44 @@ func (m *SubmitDataSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
+			m.Scale = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invert = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1551,7 +2460,7 @@ func (m *SubmitDataSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataSet) Unmarshal(dAtA []byte) error {
+func (m *SubmitDataSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1574,10 +2483,10 @@ func (m *DataSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubmitDataSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubmitDataSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1619,10 +2528,10 @@ func (m *DataSet) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawData", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1632,16 +2541,29 @@ func (m *DataSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			m.BlockTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1651,16 +2573,29 @@ func (m *DataSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockTime |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1670,23 +2605,25 @@ func (m *DataSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawData = string(dAtA[iNdEx:postIndex])
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1709,6 +2646,462 @@ func (m *DataSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DataSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			m.BlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationRule", wireType)
+			}
+			m.AggregationRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregationRule |= AggregationRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawAggregate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawAggregate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoundTiming) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundTiming: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundTiming: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaDurationSeconds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmaDurationSeconds = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaDurationBlocks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmaDurationBlocks = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDurationSeconds", wireType)
+			}
+			m.LastDurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDurationSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDurationBlocks", wireType)
+			}
+			m.LastDurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDurationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundStartHeight", wireType)
+			}
+			m.RoundStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundStartTime", wireType)
+			}
+			m.RoundStartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundStartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipOracle(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,6 +14,10 @@ func DefaultParams() Params {
 		MinSubmitPerWindow:    sdkmath.LegacyNewDec(1),
 		SlashFractionDowntime: sdkmath.LegacyNewDecWithPrec(1, 2), // 1%
 		MaxAccountListSize:    1000,                               // Maximum 1000 accounts in account list (also max submissions) - for client validation
+		MaxMagnitudeRatio:     sdkmath.LegacyNewDec(10),           // Exclude submissions an order of magnitude away from the median
+		MaxRawDataBytes:       4096,                               // Room for long decimals and small JSON fragments
+		MinRawValue:           sdkmath.LegacyZeroDec(),            // No bound beyond a positive value
+		MaxRawValue:           sdkmath.LegacyZeroDec(),            // No upper bound
 	}
 }
 
@@ -39,5 +43,52 @@ func (p Params) Validate() error {
 		return fmt.Errorf("max account list size cannot exceed 1000")
 	}
 
+	if p.MaxRawDataBytes == 0 {
+		return fmt.Errorf("max raw data bytes cannot be zero")
+	}
+
+	// A nil ratio disables the check like zero; the consensus version 3 to 4 migration sets the default on params stored before the field existed
+	if !p.MaxMagnitudeRatio.IsNil() && !p.MaxMagnitudeRatio.IsZero() && p.MaxMagnitudeRatio.LTE(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("max magnitude ratio must be zero (disabled) or greater than one")
+	}
+
+	// Nil bounds come from params stored before the fields existed and disable the check like zero
+	if !p.MinRawValue.IsNil() && p.MinRawValue.IsNegative() {
+		return fmt.Errorf("min raw value cannot be negative")
+	}
+	if !p.MaxRawValue.IsNil() && p.MaxRawValue.IsNegative() {
+		return fmt.Errorf("max raw value cannot be negative")
+	}
+	if lower, upper := p.RawValueBounds(); lower.IsPositive() && upper.IsPositive() && lower.GT(upper) {
+		return fmt.Errorf("min raw value %s cannot exceed max raw value %s", lower, upper)
+	}
+
+	seen := make(map[OracleType]bool, len(p.TrustedProviderTypes))
+	for _, oracleType := range p.TrustedProviderTypes {
+		if _, ok := OracleType_name[int32(oracleType)]; !ok || oracleType == OracleType_ORACLE_TYPE_UNSPECIFIED {
+			return fmt.Errorf("invalid trusted provider type: %s", oracleType)
+		}
+		if oracleType.IsPrice() {
+			return fmt.Errorf("price oracle type %s cannot use trusted provider mode", oracleType)
+		}
+		if seen[oracleType] {
+			return fmt.Errorf("trusted provider type %s is listed more than once", oracleType)
+		}
+		seen[oracleType] = true
+	}
+
 	return nil
 }
+
+// RawValueBounds returns the bounds on submitted values of numeric oracle types. A zero bound is disabled;
+// a nil one, from params stored before the bounds existed, is returned as zero.
+func (p Params) RawValueBounds() (lower, upper sdkmath.LegacyDec) {
+	lower, upper = sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()
+	if !p.MinRawValue.IsNil() {
+		lower = p.MinRawValue
+	}
+	if !p.MaxRawValue.IsNil() {
+		upper = p.MaxRawValue
+	}
+	return lower, upper
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -118,6 +119,8 @@ type QueryOracleSubmitDataRequest struct {
 	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	Nonce     uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	Provider  string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	// pagination defines an optional pagination for the request; ignored when provider is set
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryOracleSubmitDataRequest) Reset()         { *m = QueryOracleSubmitDataRequest{} }
@@ -174,10 +177,19 @@ func (m *QueryOracleSubmitDataRequest) GetProvider() string {
 	return ""
 }
 
+func (m *QueryOracleSubmitDataRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryOracleSubmitDataResponse is response type for the Query/OracleSubmitData RPC method
 type QueryOracleSubmitDataResponse struct {
 	// submit_datas is the list of oracle submit data for the requested ID, nonce and provider
 	SubmitDatas []*SubmitDataSet `protobuf:"bytes,1,rep,name=submit_datas,json=submitDatas,proto3" json:"submit_datas,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryOracleSubmitDataResponse) Reset()         { *m = QueryOracleSubmitDataResponse{} }
@@ -220,6 +232,13 @@ func (m *QueryOracleSubmitDataResponse) GetSubmitDatas() []*SubmitDataSet {
 	return nil
 }
 
+func (m *QueryOracleSubmitDataResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryOracleDataRequest is request type for the Query/OracleData RPC method
 type QueryOracleDataRequest struct {
 	// request_id is the unique identifier of the oracle request
@@ -577,889 +596,3223 @@ func (m *QueryModeratorAddressResponse) GetModeratorAddress() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "guru.oracle.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "guru.oracle.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryOracleSubmitDataRequest)(nil), "guru.oracle.v1.QueryOracleSubmitDataRequest")
-	proto.RegisterType((*QueryOracleSubmitDataResponse)(nil), "guru.oracle.v1.QueryOracleSubmitDataResponse")
-	proto.RegisterType((*QueryOracleDataRequest)(nil), "guru.oracle.v1.QueryOracleDataRequest")
-	proto.RegisterType((*QueryOracleDataResponse)(nil), "guru.oracle.v1.QueryOracleDataResponse")
-	proto.RegisterType((*QueryOracleRequestDocRequest)(nil), "guru.oracle.v1.QueryOracleRequestDocRequest")
-	proto.RegisterType((*QueryOracleRequestDocResponse)(nil), "guru.oracle.v1.QueryOracleRequestDocResponse")
-	proto.RegisterType((*QueryOracleRequestDocsRequest)(nil), "guru.oracle.v1.QueryOracleRequestDocsRequest")
-	proto.RegisterType((*QueryOracleRequestDocsResponse)(nil), "guru.oracle.v1.QueryOracleRequestDocsResponse")
-	proto.RegisterType((*QueryModeratorAddressRequest)(nil), "guru.oracle.v1.QueryModeratorAddressRequest")
-	proto.RegisterType((*QueryModeratorAddressResponse)(nil), "guru.oracle.v1.QueryModeratorAddressResponse")
+// QueryRequestsByAccountRequest is request type for the Query/RequestsByAccount RPC method
+type QueryRequestsByAccountRequest struct {
+	// account is the provider address to look up
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("guru/oracle/v1/query.proto", fileDescriptor_9ff70f57bb718019) }
-
-var fileDescriptor_9ff70f57bb718019 = []byte{
-	// 725 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcd, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0xbb, 0xbc, 0x14, 0x78, 0xfa, 0x0b, 0x81, 0x81, 0x40, 0x7f, 0x05, 0x56, 0x18, 0x8c,
-	0xd6, 0xb7, 0xae, 0x54, 0x8d, 0x07, 0x43, 0xa2, 0x48, 0x62, 0x88, 0x12, 0x71, 0x49, 0x3c, 0x78,
-	0x69, 0xa6, 0xdd, 0x71, 0xd9, 0xa4, 0xdd, 0x29, 0x3b, 0xb3, 0x8d, 0x84, 0x70, 0xf1, 0xe4, 0x91,
-	0xe8, 0xc5, 0xab, 0xff, 0x83, 0x7f, 0x04, 0x47, 0x12, 0x2f, 0x9e, 0x8c, 0x01, 0xff, 0x10, 0xd3,
-	0xd9, 0xd9, 0xbe, 0xcc, 0xba, 0xa5, 0xb7, 0x9d, 0x79, 0xde, 0x3e, 0xcf, 0xd3, 0xe7, 0x3b, 0x85,
-	0x82, 0x1b, 0x06, 0xa1, 0xc5, 0x02, 0x52, 0xab, 0x53, 0xab, 0xb5, 0x61, 0x1d, 0x86, 0x34, 0x38,
-	0x2a, 0x35, 0x03, 0x26, 0x18, 0x9a, 0x6e, 0xdb, 0x4a, 0x91, 0xad, 0xd4, 0xda, 0x28, 0xcc, 0xbb,
-	0xcc, 0x65, 0xd2, 0x64, 0xb5, 0xbf, 0x22, 0xaf, 0xc2, 0xb2, 0xcb, 0x98, 0x5b, 0xa7, 0x16, 0x69,
-	0x7a, 0x16, 0xf1, 0x7d, 0x26, 0x88, 0xf0, 0x98, 0xcf, 0x95, 0x75, 0x49, 0xcb, 0xaf, 0xb2, 0xc5,
-	0xa1, 0xfd, 0x46, 0x97, 0xfa, 0x94, 0x7b, 0x2a, 0x14, 0xcf, 0x03, 0x7a, 0xd3, 0xa6, 0xd9, 0x23,
-	0x01, 0x69, 0x70, 0x9b, 0x1e, 0x86, 0x94, 0x0b, 0xfc, 0x12, 0xe6, 0xfa, 0x6e, 0x79, 0x93, 0xf9,
-	0x9c, 0xa2, 0x87, 0x90, 0x6d, 0xca, 0x9b, 0xbc, 0xb1, 0x6a, 0x14, 0x73, 0xe5, 0x85, 0x52, 0x3f,
-	0x7c, 0x29, 0xf2, 0xdf, 0x1a, 0x3b, 0xfb, 0x75, 0x2d, 0x63, 0x2b, 0x5f, 0xcc, 0x60, 0x59, 0x26,
-	0x7b, 0x2d, 0xdd, 0xf6, 0xc3, 0x6a, 0xc3, 0x13, 0xdb, 0x44, 0x10, 0x55, 0x0c, 0xad, 0x00, 0x04,
-	0xd1, 0x67, 0xc5, 0x73, 0x64, 0xe6, 0x31, 0x7b, 0x4a, 0xdd, 0xec, 0x38, 0x68, 0x1e, 0xc6, 0x7d,
-	0xe6, 0xd7, 0x68, 0x7e, 0x44, 0x5a, 0xa2, 0x03, 0x2a, 0xc0, 0x64, 0x33, 0x60, 0x2d, 0xcf, 0xa1,
-	0x41, 0x7e, 0x74, 0xd5, 0x28, 0x4e, 0xd9, 0x9d, 0x33, 0x26, 0xb0, 0x92, 0x52, 0x50, 0xf5, 0xf1,
-	0x14, 0xfe, 0xe3, 0xf2, 0xb6, 0xe2, 0x10, 0x41, 0xda, 0xdd, 0x8c, 0x16, 0x73, 0xe5, 0x15, 0xbd,
-	0x9b, 0x6e, 0xe4, 0x3e, 0x15, 0x76, 0x8e, 0x77, 0x8e, 0x1c, 0x3f, 0x86, 0x85, 0x9e, 0x12, 0xc3,
-	0x77, 0x83, 0x77, 0x61, 0x31, 0x11, 0xa8, 0xa8, 0xca, 0x30, 0xd9, 0xc6, 0xa9, 0x70, 0x2a, 0xd4,
-	0x7c, 0x17, 0x75, 0xa2, 0x98, 0x65, 0xc2, 0x89, 0x3e, 0xf0, 0x66, 0xdf, 0x6c, 0x15, 0xc3, 0x36,
-	0xab, 0x0d, 0x49, 0x73, 0xd0, 0x37, 0xa9, 0xde, 0x70, 0xc5, 0xf4, 0x02, 0x72, 0x71, 0xbc, 0xc3,
-	0x6a, 0x0a, 0x6b, 0x55, 0xc7, 0xd2, 0xc3, 0xd5, 0x02, 0xc4, 0xa5, 0xb7, 0x59, 0x0d, 0xbf, 0x4d,
-	0xa9, 0x14, 0xaf, 0x1c, 0x7a, 0x04, 0x59, 0x2e, 0x88, 0x08, 0xa3, 0xdd, 0x9a, 0x4e, 0xfe, 0x1a,
-	0xca, 0x71, 0x5f, 0x3a, 0xd9, 0xca, 0x19, 0x07, 0x60, 0xa6, 0xe5, 0x55, 0x2d, 0xec, 0xc1, 0x5c,
-	0x94, 0xa4, 0xd2, 0xd3, 0x49, 0xfc, 0x9b, 0x5f, 0xd9, 0x8a, 0x3d, 0xcb, 0xf4, 0xcc, 0xd8, 0x54,
-	0x43, 0xdf, 0x65, 0x0e, 0x0d, 0x88, 0x60, 0xc1, 0x33, 0xc7, 0x09, 0x28, 0xef, 0xa8, 0xe7, 0x95,
-	0xea, 0x35, 0x69, 0x57, 0x48, 0x77, 0x60, 0xb6, 0x11, 0xdb, 0x2a, 0x24, 0x32, 0xca, 0xb6, 0xa7,
-	0xec, 0x99, 0x86, 0x16, 0x54, 0x3e, 0x9d, 0x80, 0x71, 0x99, 0x0e, 0x1d, 0x42, 0x36, 0x12, 0x18,
-	0xc2, 0x3a, 0x76, 0x52, 0xc3, 0x85, 0xf5, 0x81, 0x3e, 0x11, 0x09, 0x36, 0x3f, 0xfe, 0xf8, 0xf3,
-	0x65, 0x24, 0x8f, 0x16, 0x2c, 0xed, 0x95, 0x88, 0xb4, 0x8b, 0xbe, 0x1b, 0x30, 0xa3, 0xcb, 0x08,
-	0xdd, 0xfd, 0x67, 0xe6, 0x14, 0x79, 0x17, 0xee, 0x0d, 0xe9, 0xad, 0x88, 0x9e, 0x4b, 0xa2, 0x4d,
-	0xf4, 0x44, 0x27, 0xea, 0x51, 0xac, 0x75, 0xdc, 0x5d, 0xea, 0x13, 0xeb, 0x58, 0xbe, 0x08, 0x27,
-	0xd6, 0x71, 0xfc, 0x00, 0x9c, 0xa0, 0x4f, 0x06, 0x40, 0x57, 0x61, 0xe8, 0xc6, 0x00, 0x84, 0x5e,
-	0xd4, 0x9b, 0x57, 0xfa, 0x29, 0xc8, 0x5b, 0x12, 0x72, 0x1d, 0xad, 0xe9, 0x90, 0x09, 0x3a, 0xf4,
-	0xad, 0x33, 0xc1, 0xee, 0x0a, 0x0d, 0x9c, 0x60, 0x42, 0xc4, 0x03, 0x27, 0x98, 0xd4, 0x2c, 0xbe,
-	0x2f, 0xe1, 0x6e, 0xa3, 0xa2, 0x0e, 0xd7, 0xb3, 0xff, 0xfd, 0x8c, 0x5f, 0x0d, 0x98, 0x4d, 0x08,
-	0x08, 0x0d, 0x57, 0xb6, 0xb3, 0x6f, 0xa5, 0x61, 0xdd, 0x15, 0xe6, 0x75, 0x89, 0x69, 0xa2, 0xe5,
-	0x01, 0x98, 0x1c, 0x7d, 0x36, 0x60, 0x46, 0xd7, 0x51, 0xca, 0xf8, 0x52, 0xe4, 0x98, 0x32, 0xbe,
-	0x34, 0x71, 0xe2, 0x35, 0xc9, 0xb5, 0x84, 0xfe, 0xd7, 0xb9, 0x3a, 0xca, 0xdc, 0xda, 0x39, 0xbb,
-	0x30, 0x8d, 0xf3, 0x0b, 0xd3, 0xf8, 0x7d, 0x61, 0x1a, 0xa7, 0x97, 0x66, 0xe6, 0xfc, 0xd2, 0xcc,
-	0xfc, 0xbc, 0x34, 0x33, 0xef, 0x2c, 0xd7, 0x13, 0x07, 0x61, 0xb5, 0x54, 0x63, 0x0d, 0x19, 0xfe,
-	0xde, 0xf3, 0xdd, 0x3a, 0xab, 0x92, 0x7a, 0x94, 0xac, 0x55, 0xb6, 0x3e, 0xc4, 0x19, 0xc5, 0x51,
-	0x93, 0xf2, 0x6a, 0x56, 0xfe, 0x0d, 0x3f, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xc1, 0xb0, 0x54,
-	0x09, 0x23, 0x08, 0x00, 0x00,
+func (m *QueryRequestsByAccountRequest) Reset()         { *m = QueryRequestsByAccountRequest{} }
+func (m *QueryRequestsByAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequestsByAccountRequest) ProtoMessage()    {}
+func (*QueryRequestsByAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff70f57bb718019, []int{12}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// OracleSubmitData queries oracle data by ID
-	OracleSubmitData(ctx context.Context, in *QueryOracleSubmitDataRequest, opts ...grpc.CallOption) (*QueryOracleSubmitDataResponse, error)
-	// OracleData queries oracle data by ID
-	OracleData(ctx context.Context, in *QueryOracleDataRequest, opts ...grpc.CallOption) (*QueryOracleDataResponse, error)
-	// OracleRequestDoc queries oracle request doc by ID
-	OracleRequestDoc(ctx context.Context, in *QueryOracleRequestDocRequest, opts ...grpc.CallOption) (*QueryOracleRequestDocResponse, error)
-	// OracleRequestDocs queries an oracle request document list
-	OracleRequestDocs(ctx context.Context, in *QueryOracleRequestDocsRequest, opts ...grpc.CallOption) (*QueryOracleRequestDocsResponse, error)
-	// ModeratorAddress queries the moderator address
-	ModeratorAddress(ctx context.Context, in *QueryModeratorAddressRequest, opts ...grpc.CallOption) (*QueryModeratorAddressResponse, error)
+func (m *QueryRequestsByAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryRequestsByAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequestsByAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRequestsByAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequestsByAccountRequest.Merge(m, src)
+}
+func (m *QueryRequestsByAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequestsByAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequestsByAccountRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRequestsByAccountRequest proto.InternalMessageInfo
+
+func (m *QueryRequestsByAccountRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) OracleSubmitData(ctx context.Context, in *QueryOracleSubmitDataRequest, opts ...grpc.CallOption) (*QueryOracleSubmitDataResponse, error) {
-	out := new(QueryOracleSubmitDataResponse)
-	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/OracleSubmitData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRequestsByAccountRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OracleData(ctx context.Context, in *QueryOracleDataRequest, opts ...grpc.CallOption) (*QueryOracleDataResponse, error) {
-	out := new(QueryOracleDataResponse)
-	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/OracleData", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRequestsByAccountResponse is response type for the Query/RequestsByAccount RPC method
+type QueryRequestsByAccountResponse struct {
+	// request_docs are the request documents whose account list contains the account, ordered by request id
+	RequestDocs []OracleRequestDoc `protobuf:"bytes,1,rep,name=request_docs,json=requestDocs,proto3" json:"request_docs"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) OracleRequestDoc(ctx context.Context, in *QueryOracleRequestDocRequest, opts ...grpc.CallOption) (*QueryOracleRequestDocResponse, error) {
-	out := new(QueryOracleRequestDocResponse)
-	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/OracleRequestDoc", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRequestsByAccountResponse) Reset()         { *m = QueryRequestsByAccountResponse{} }
+func (m *QueryRequestsByAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRequestsByAccountResponse) ProtoMessage()    {}
+func (*QueryRequestsByAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff70f57bb718019, []int{13}
+}
+func (m *QueryRequestsByAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequestsByAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequestsByAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRequestsByAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequestsByAccountResponse.Merge(m, src)
+}
+func (m *QueryRequestsByAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequestsByAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequestsByAccountResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) OracleRequestDocs(ctx context.Context, in *QueryOracleRequestDocsRequest, opts ...grpc.CallOption) (*QueryOracleRequestDocsResponse, error) {
-	out := new(QueryOracleRequestDocsResponse)
-	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/OracleRequestDocs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRequestsByAccountResponse proto.InternalMessageInfo
+
+func (m *QueryRequestsByAccountResponse) GetRequestDocs() []OracleRequestDoc {
+	if m != nil {
+		return m.RequestDocs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ModeratorAddress(ctx context.Context, in *QueryModeratorAddressRequest, opts ...grpc.CallOption) (*QueryModeratorAddressResponse, error) {
-	out := new(QueryModeratorAddressResponse)
-	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/ModeratorAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRequestsByAccountResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// OracleSubmitData queries oracle data by ID
-	OracleSubmitData(context.Context, *QueryOracleSubmitDataRequest) (*QueryOracleSubmitDataResponse, error)
-	// OracleData queries oracle data by ID
-	OracleData(context.Context, *QueryOracleDataRequest) (*QueryOracleDataResponse, error)
-	// OracleRequestDoc queries oracle request doc by ID
-	OracleRequestDoc(context.Context, *QueryOracleRequestDocRequest) (*QueryOracleRequestDocResponse, error)
-	// OracleRequestDocs queries an oracle request document list
-	OracleRequestDocs(context.Context, *QueryOracleRequestDocsRequest) (*QueryOracleRequestDocsResponse, error)
-	// ModeratorAddress queries the moderator address
-	ModeratorAddress(context.Context, *QueryModeratorAddressRequest) (*QueryModeratorAddressResponse, error)
+// QueryPendingRoundRequest is request type for the Query/PendingRound RPC method
+type QueryPendingRoundRequest struct {
+	// request_id is the unique identifier of the oracle request
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// nonce is the round to inspect; zero selects the round currently collecting reports
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryPendingRoundRequest) Reset()         { *m = QueryPendingRoundRequest{} }
+func (m *QueryPendingRoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRoundRequest) ProtoMessage()    {}
+func (*QueryPendingRoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff70f57bb718019, []int{14}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPendingRoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) OracleSubmitData(ctx context.Context, req *QueryOracleSubmitDataRequest) (*QueryOracleSubmitDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OracleSubmitData not implemented")
+func (m *QueryPendingRoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) OracleData(ctx context.Context, req *QueryOracleDataRequest) (*QueryOracleDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OracleData not implemented")
+func (m *QueryPendingRoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRoundRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OracleRequestDoc(ctx context.Context, req *QueryOracleRequestDocRequest) (*QueryOracleRequestDocResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OracleRequestDoc not implemented")
+func (m *QueryPendingRoundRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) OracleRequestDocs(ctx context.Context, req *QueryOracleRequestDocsRequest) (*QueryOracleRequestDocsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OracleRequestDocs not implemented")
+func (m *QueryPendingRoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRoundRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ModeratorAddress(ctx context.Context, req *QueryModeratorAddressRequest) (*QueryModeratorAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModeratorAddress not implemented")
+
+var xxx_messageInfo_QueryPendingRoundRequest proto.InternalMessageInfo
+
+func (m *QueryPendingRoundRequest) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryPendingRoundRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// QueryPendingRoundResponse is response type for the Query/PendingRound RPC method
+type QueryPendingRoundResponse struct {
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Nonce     uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// reports is the number of submissions received for the round
+	Reports uint32 `protobuf:"varint,3,opt,name=reports,proto3" json:"reports,omitempty"`
+	// accepted is the number of reports left after excluding magnitude outliers
+	Accepted uint32 `protobuf:"varint,4,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// quorum is the number of accepted reports the round needs to finalize
+	Quorum uint32 `protobuf:"varint,5,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// provisional_value is the aggregate of the accepted reports so far; empty without reports
+	ProvisionalValue string `protobuf:"bytes,6,opt,name=provisional_value,json=provisionalValue,proto3" json:"provisional_value,omitempty"`
+	// quorum_met reports whether the round is ready to finalize: the quorum, including
+	// stake-weighted quorums, is met and a full coverage request has every provider's report
+	QuorumMet bool `protobuf:"varint,7,opt,name=quorum_met,json=quorumMet,proto3" json:"quorum_met,omitempty"`
+}
+
+func (m *QueryPendingRoundResponse) Reset()         { *m = QueryPendingRoundResponse{} }
+func (m *QueryPendingRoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRoundResponse) ProtoMessage()    {}
+func (*QueryPendingRoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff70f57bb718019, []int{15}
+}
+func (m *QueryPendingRoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+}
+func (m *QueryPendingRoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRoundResponse.Merge(m, src)
+}
+func (m *QueryPendingRoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRoundResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRoundResponse) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/guru.oracle.v1.Query/Params",
+	return 0
+}
+
+func (m *QueryPendingRoundResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return 0
+}
+
+func (m *QueryPendingRoundResponse) GetReports() uint32 {
+	if m != nil {
+		return m.Reports
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_OracleSubmitData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOracleSubmitDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryPendingRoundResponse) GetAccepted() uint32 {
+	if m != nil {
+		return m.Accepted
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).OracleSubmitData(ctx, in)
+	return 0
+}
+
+func (m *QueryPendingRoundResponse) GetQuorum() uint32 {
+	if m != nil {
+		return m.Quorum
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/guru.oracle.v1.Query/OracleSubmitData",
+	return 0
+}
+
+func (m *QueryPendingRoundResponse) GetProvisionalValue() string {
+	if m != nil {
+		return m.ProvisionalValue
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OracleSubmitData(ctx, req.(*QueryOracleSubmitDataRequest))
+	return ""
+}
+
+func (m *QueryPendingRoundResponse) GetQuorumMet() bool {
+	if m != nil {
+		return m.QuorumMet
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_OracleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOracleDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OracleData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/guru.oracle.v1.Query/OracleData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OracleData(ctx, req.(*QueryOracleDataRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryLatestResultsRequest is request type for the Query/LatestResults RPC method
+type QueryLatestResultsRequest struct {
+	// request_ids are the requests to look up, at most MaxLatestResultsRequestIds per query
+	RequestIds []uint64 `protobuf:"varint,1,rep,packed,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
 }
 
-func _Query_OracleRequestDoc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOracleRequestDocRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OracleRequestDoc(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/guru.oracle.v1.Query/OracleRequestDoc",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OracleRequestDoc(ctx, req.(*QueryOracleRequestDocRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryLatestResultsRequest) Reset()         { *m = QueryLatestResultsRequest{} }
+func (m *QueryLatestResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestResultsRequest) ProtoMessage()    {}
+func (*QueryLatestResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff70f57bb718019, []int{16}
 }
-
-func _Query_OracleRequestDocs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOracleRequestDocsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OracleRequestDocs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/guru.oracle.v1.Query/OracleRequestDocs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OracleRequestDocs(ctx, req.(*QueryOracleRequestDocsRequest))
+func (m *QueryLatestResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLatestResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestResultsRequest.Merge(m, src)
+}
+func (m *QueryLatestResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestResultsRequest.DiscardUnknown(m)
 }
 
-func _Query_ModeratorAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryModeratorAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ModeratorAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/guru.oracle.v1.Query/ModeratorAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ModeratorAddress(ctx, req.(*QueryModeratorAddressRequest))
+var xxx_messageInfo_QueryLatestResultsRequest proto.InternalMessageInfo
+
+func (m *QueryLatestResultsRequest) GetRequestIds() []uint64 {
+	if m != nil {
+		return m.RequestIds
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "guru.oracle.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "OracleSubmitData",
-			Handler:    _Query_OracleSubmitData_Handler,
-		},
-		{
-			MethodName: "OracleData",
-			Handler:    _Query_OracleData_Handler,
-		},
-		{
-			MethodName: "OracleRequestDoc",
-			Handler:    _Query_OracleRequestDoc_Handler,
-		},
-		{
-			MethodName: "OracleRequestDocs",
-			Handler:    _Query_OracleRequestDocs_Handler,
-		},
-		{
-			MethodName: "ModeratorAddress",
-			Handler:    _Query_ModeratorAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "guru/oracle/v1/query.proto",
+// QueryLatestResultsResponse is response type for the Query/LatestResults RPC method
+type QueryLatestResultsResponse struct {
+	// results maps each request id to its latest data set; requests without a finalized round are omitted
+	Results map[uint64]*DataSet `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLatestResultsResponse) Reset()         { *m = QueryLatestResultsResponse{} }
+func (m *QueryLatestResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestResultsResponse) ProtoMessage()    {}
+func (*QueryLatestResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff70f57bb718019, []int{17}
+}
+func (m *QueryLatestResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryLatestResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestResultsResponse.Merge(m, src)
+}
+func (m *QueryLatestResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestResultsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryLatestResultsResponse proto.InternalMessageInfo
+
+func (m *QueryLatestResultsResponse) GetResults() map[uint64]*DataSet {
+	if m != nil {
+		return m.Results
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryModuleStateRequest is request type for the Query/ModuleState RPC method
+type QueryModuleStateRequest struct {
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryModuleStateRequest) Reset()         { *m = QueryModuleStateRequest{} }
+func (m *QueryModuleStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleStateRequest) ProtoMessage()    {}
+func (*QueryModuleStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff70f57bb718019, []int{18}
+}
+func (m *QueryModuleStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryModuleStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleStateRequest.Merge(m, src)
+}
+func (m *QueryModuleStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleStateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryModuleStateRequest proto.InternalMessageInfo
+
+// QueryModuleStateResponse is response type for the Query/ModuleState RPC method
+type QueryModuleStateResponse struct {
+	// params holds all the parameters of this module
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// moderator_address is the address of the moderator
+	ModeratorAddress string `protobuf:"bytes,2,opt,name=moderator_address,json=moderatorAddress,proto3" json:"moderator_address,omitempty"`
+	// request_count is the number of registered request documents
+	RequestCount uint64 `protobuf:"varint,3,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	// enabled_request_count is the number of request documents that are enabled
+	EnabledRequestCount uint64 `protobuf:"varint,4,opt,name=enabled_request_count,json=enabledRequestCount,proto3" json:"enabled_request_count,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryModuleStateResponse) Reset()         { *m = QueryModuleStateResponse{} }
+func (m *QueryModuleStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleStateResponse) ProtoMessage()    {}
+func (*QueryModuleStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff70f57bb718019, []int{19}
+}
+func (m *QueryModuleStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryModuleStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleStateResponse.Merge(m, src)
+}
+func (m *QueryModuleStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleStateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOracleSubmitDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryModuleStateResponse proto.InternalMessageInfo
+
+func (m *QueryModuleStateResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *QueryOracleSubmitDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryModuleStateResponse) GetModeratorAddress() string {
+	if m != nil {
+		return m.ModeratorAddress
+	}
+	return ""
 }
 
-func (m *QueryOracleSubmitDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.RequestId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryModuleStateResponse) GetRequestCount() uint64 {
+	if m != nil {
+		return m.RequestCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryOracleSubmitDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryModuleStateResponse) GetEnabledRequestCount() uint64 {
+	if m != nil {
+		return m.EnabledRequestCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryOracleSubmitDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRoundTimingRequest is request type for the Query/RoundTiming RPC method
+type QueryRoundTimingRequest struct {
+	// request_id is the unique identifier of the oracle request
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func (m *QueryOracleSubmitDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SubmitDatas) > 0 {
-		for iNdEx := len(m.SubmitDatas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SubmitDatas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRoundTimingRequest) Reset()         { *m = QueryRoundTimingRequest{} }
+func (m *QueryRoundTimingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoundTimingRequest) ProtoMessage()    {}
+func (*QueryRoundTimingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff70f57bb718019, []int{20}
+}
+func (m *QueryRoundTimingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoundTimingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoundTimingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRoundTimingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoundTimingRequest.Merge(m, src)
+}
+func (m *QueryRoundTimingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoundTimingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoundTimingRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOracleDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRoundTimingRequest proto.InternalMessageInfo
+
+func (m *QueryRoundTimingRequest) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryOracleDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRoundTimingResponse is response type for the Query/RoundTiming RPC method
+type QueryRoundTimingResponse struct {
+	// round_timing holds the statistics; samples is zero until a round finalizes after the
+	// request was registered or last updated
+	RoundTiming RoundTiming `protobuf:"bytes,1,opt,name=round_timing,json=roundTiming,proto3" json:"round_timing"`
 }
 
-func (m *QueryOracleDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryRoundTimingResponse) Reset()         { *m = QueryRoundTimingResponse{} }
+func (m *QueryRoundTimingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoundTimingResponse) ProtoMessage()    {}
+func (*QueryRoundTimingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff70f57bb718019, []int{21}
+}
+func (m *QueryRoundTimingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoundTimingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoundTimingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRoundTimingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoundTimingResponse.Merge(m, src)
+}
+func (m *QueryRoundTimingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoundTimingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoundTimingResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOracleDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRoundTimingResponse proto.InternalMessageInfo
+
+func (m *QueryRoundTimingResponse) GetRoundTiming() RoundTiming {
+	if m != nil {
+		return m.RoundTiming
 	}
-	return dAtA[:n], nil
+	return RoundTiming{}
 }
 
-func (m *QueryOracleDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "guru.oracle.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "guru.oracle.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryOracleSubmitDataRequest)(nil), "guru.oracle.v1.QueryOracleSubmitDataRequest")
+	proto.RegisterType((*QueryOracleSubmitDataResponse)(nil), "guru.oracle.v1.QueryOracleSubmitDataResponse")
+	proto.RegisterType((*QueryOracleDataRequest)(nil), "guru.oracle.v1.QueryOracleDataRequest")
+	proto.RegisterType((*QueryOracleDataResponse)(nil), "guru.oracle.v1.QueryOracleDataResponse")
+	proto.RegisterType((*QueryOracleRequestDocRequest)(nil), "guru.oracle.v1.QueryOracleRequestDocRequest")
+	proto.RegisterType((*QueryOracleRequestDocResponse)(nil), "guru.oracle.v1.QueryOracleRequestDocResponse")
+	proto.RegisterType((*QueryOracleRequestDocsRequest)(nil), "guru.oracle.v1.QueryOracleRequestDocsRequest")
+	proto.RegisterType((*QueryOracleRequestDocsResponse)(nil), "guru.oracle.v1.QueryOracleRequestDocsResponse")
+	proto.RegisterType((*QueryModeratorAddressRequest)(nil), "guru.oracle.v1.QueryModeratorAddressRequest")
+	proto.RegisterType((*QueryModeratorAddressResponse)(nil), "guru.oracle.v1.QueryModeratorAddressResponse")
+	proto.RegisterType((*QueryRequestsByAccountRequest)(nil), "guru.oracle.v1.QueryRequestsByAccountRequest")
+	proto.RegisterType((*QueryRequestsByAccountResponse)(nil), "guru.oracle.v1.QueryRequestsByAccountResponse")
+	proto.RegisterType((*QueryPendingRoundRequest)(nil), "guru.oracle.v1.QueryPendingRoundRequest")
+	proto.RegisterType((*QueryPendingRoundResponse)(nil), "guru.oracle.v1.QueryPendingRoundResponse")
+	proto.RegisterType((*QueryLatestResultsRequest)(nil), "guru.oracle.v1.QueryLatestResultsRequest")
+	proto.RegisterType((*QueryLatestResultsResponse)(nil), "guru.oracle.v1.QueryLatestResultsResponse")
+	proto.RegisterMapType((map[uint64]*DataSet)(nil), "guru.oracle.v1.QueryLatestResultsResponse.ResultsEntry")
+	proto.RegisterType((*QueryModuleStateRequest)(nil), "guru.oracle.v1.QueryModuleStateRequest")
+	proto.RegisterType((*QueryModuleStateResponse)(nil), "guru.oracle.v1.QueryModuleStateResponse")
+	proto.RegisterType((*QueryRoundTimingRequest)(nil), "guru.oracle.v1.QueryRoundTimingRequest")
+	proto.RegisterType((*QueryRoundTimingResponse)(nil), "guru.oracle.v1.QueryRoundTimingResponse")
 }
 
-func (m *QueryOracleDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DataSet != nil {
-		{
-			size, err := m.DataSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("guru/oracle/v1/query.proto", fileDescriptor_9ff70f57bb718019) }
+
+var fileDescriptor_9ff70f57bb718019 = []byte{
+	// 1347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0x89, 0xd3, 0x8c, 0x93, 0x2a, 0x99, 0x96, 0xd4, 0x75, 0x5b, 0x37, 0xdd, 0xa2,
+	0xd6, 0x49, 0xdb, 0xdd, 0xc6, 0x50, 0xb5, 0xe2, 0x43, 0xa2, 0x25, 0x50, 0x45, 0x34, 0x6a, 0xbb,
+	0x41, 0x3d, 0xf4, 0xb2, 0x8c, 0xbd, 0x83, 0xbb, 0xc2, 0xde, 0x71, 0x76, 0x66, 0x0d, 0x56, 0x94,
+	0x03, 0x1c, 0x10, 0x27, 0x84, 0xe8, 0x85, 0x2b, 0x47, 0x38, 0x23, 0xf1, 0x0f, 0x70, 0xe8, 0x05,
+	0xa9, 0x12, 0x12, 0xe2, 0x84, 0x50, 0xcb, 0x01, 0x71, 0xe2, 0x4f, 0x40, 0x3b, 0xf3, 0xd6, 0xde,
+	0x0f, 0xaf, 0x63, 0x52, 0x6e, 0x3b, 0xf3, 0x3e, 0xe6, 0xf7, 0x7e, 0xf3, 0xe6, 0xbd, 0xa7, 0x45,
+	0x95, 0x56, 0xe0, 0x07, 0x26, 0xf3, 0x49, 0xb3, 0x4d, 0xcd, 0xde, 0x86, 0xb9, 0x1b, 0x50, 0xbf,
+	0x6f, 0x74, 0x7d, 0x26, 0x18, 0x3e, 0x1a, 0xca, 0x0c, 0x25, 0x33, 0x7a, 0x1b, 0x95, 0xe3, 0x2d,
+	0xd6, 0x62, 0x52, 0x64, 0x86, 0x5f, 0x4a, 0xab, 0x72, 0xba, 0xc5, 0x58, 0xab, 0x4d, 0x4d, 0xd2,
+	0x75, 0x4d, 0xe2, 0x79, 0x4c, 0x10, 0xe1, 0x32, 0x8f, 0x83, 0x74, 0xbd, 0xc9, 0x78, 0x87, 0x71,
+	0xb3, 0x41, 0x38, 0x55, 0xce, 0xcd, 0xde, 0x46, 0x83, 0x0a, 0xb2, 0x61, 0x76, 0x49, 0xcb, 0xf5,
+	0xa4, 0x32, 0xe8, 0x9e, 0x4a, 0x61, 0x81, 0x93, 0xa3, 0x63, 0x92, 0xc2, 0x16, 0xf5, 0x28, 0x77,
+	0xe1, 0x18, 0xfd, 0x38, 0xc2, 0xf7, 0x43, 0xe7, 0xf7, 0x88, 0x4f, 0x3a, 0xdc, 0xa2, 0xbb, 0x01,
+	0xe5, 0x42, 0x7f, 0x0f, 0x1d, 0x4b, 0xec, 0xf2, 0x2e, 0xf3, 0x38, 0xc5, 0xaf, 0xa2, 0x62, 0x57,
+	0xee, 0x94, 0xb5, 0x55, 0xad, 0x56, 0xaa, 0xaf, 0x18, 0xc9, 0x40, 0x0d, 0xa5, 0x7f, 0x6b, 0xe6,
+	0xc9, 0xef, 0x67, 0xa7, 0x2c, 0xd0, 0xd5, 0x7f, 0xd4, 0xd0, 0x69, 0xe9, 0xed, 0xae, 0xd4, 0xdb,
+	0x09, 0x1a, 0x1d, 0x57, 0x6c, 0x12, 0x41, 0xe0, 0x34, 0x7c, 0x06, 0x21, 0x5f, 0x7d, 0xda, 0xae,
+	0x23, 0x5d, 0xcf, 0x58, 0xf3, 0xb0, 0xb3, 0xe5, 0xe0, 0xe3, 0x68, 0xd6, 0x63, 0x5e, 0x93, 0x96,
+	0x0b, 0x52, 0xa2, 0x16, 0xb8, 0x82, 0x8e, 0x74, 0x7d, 0xd6, 0x73, 0x1d, 0xea, 0x97, 0xa7, 0x57,
+	0xb5, 0xda, 0xbc, 0x35, 0x58, 0xe3, 0x77, 0x11, 0x1a, 0x72, 0x54, 0x9e, 0x91, 0x58, 0x2f, 0x18,
+	0x8a, 0x50, 0x23, 0x24, 0xd4, 0x50, 0xb7, 0x05, 0x84, 0x1a, 0xf7, 0x48, 0x8b, 0x02, 0x18, 0x2b,
+	0x66, 0xa9, 0x7f, 0xaf, 0xa1, 0x33, 0x39, 0xc8, 0x81, 0x91, 0xb7, 0xd0, 0x02, 0x97, 0xbb, 0xb6,
+	0x43, 0x04, 0x09, 0x79, 0x99, 0xae, 0x95, 0xea, 0x67, 0xd2, 0xbc, 0x0c, 0x2d, 0x77, 0xa8, 0xb0,
+	0x4a, 0x7c, 0xb0, 0xe4, 0xf8, 0x76, 0x02, 0x6b, 0x41, 0x62, 0xbd, 0x78, 0x20, 0x56, 0x75, 0x7c,
+	0x02, 0xec, 0x75, 0xb4, 0x12, 0xc3, 0x3a, 0x39, 0xbf, 0xfa, 0x36, 0x3a, 0x91, 0x31, 0x84, 0xf0,
+	0xea, 0xe8, 0x48, 0x18, 0x97, 0xcd, 0xa9, 0x80, 0x2b, 0x3f, 0x91, 0x0e, 0x2d, 0x0a, 0x6a, 0xce,
+	0x51, 0x1f, 0xfa, 0x9b, 0x89, 0xdb, 0x06, 0x0c, 0x9b, 0xac, 0x39, 0x21, 0x9a, 0x47, 0x09, 0xca,
+	0xe3, 0xe6, 0x80, 0xe9, 0x36, 0x2a, 0x45, 0xf6, 0x0e, 0x6b, 0x02, 0xac, 0xd5, 0x34, 0xac, 0xb4,
+	0x39, 0xe4, 0x64, 0x74, 0xf4, 0x26, 0x6b, 0xea, 0x0f, 0x72, 0x4e, 0x8a, 0x5e, 0x01, 0xbe, 0x86,
+	0x8a, 0x5c, 0x10, 0x11, 0xa8, 0x74, 0x3f, 0x9a, 0xbd, 0x56, 0x50, 0xdc, 0x91, 0x4a, 0x16, 0x28,
+	0xeb, 0x3e, 0xaa, 0xe6, 0xf9, 0x85, 0x10, 0xee, 0xa1, 0x63, 0xca, 0x89, 0x1d, 0x8b, 0x24, 0x4a,
+	0x9e, 0x03, 0x43, 0xb1, 0x96, 0x59, 0xda, 0xb3, 0x5e, 0x05, 0xd2, 0xb7, 0x99, 0x43, 0x7d, 0x22,
+	0x98, 0x7f, 0xd3, 0x71, 0x7c, 0xca, 0x07, 0x0f, 0xfa, 0x0e, 0xc4, 0x9a, 0x95, 0x03, 0xa4, 0x4b,
+	0x68, 0xb9, 0x13, 0xc9, 0x6c, 0xa2, 0x84, 0x32, 0xec, 0x79, 0x6b, 0xa9, 0x93, 0x32, 0xd2, 0x3f,
+	0x8d, 0xde, 0x05, 0xb8, 0xe7, 0xb7, 0xfa, 0x37, 0x9b, 0x4d, 0x16, 0x78, 0x22, 0xa2, 0xae, 0x8c,
+	0xe6, 0x88, 0xda, 0x01, 0x27, 0xd1, 0x32, 0xf5, 0x36, 0x0b, 0x87, 0x7e, 0x9b, 0x3f, 0x68, 0x40,
+	0xf3, 0x08, 0x0c, 0x10, 0xd3, 0x16, 0x5a, 0x38, 0x0c, 0xbf, 0x90, 0x2a, 0xa5, 0x61, 0xaa, 0xfc,
+	0x8f, 0xaf, 0xf4, 0x2e, 0x2a, 0xab, 0xca, 0x4a, 0x3d, 0xc7, 0xf5, 0x5a, 0x16, 0x0b, 0x3c, 0xe7,
+	0x45, 0xea, 0xa0, 0xfe, 0xb7, 0x86, 0x4e, 0x8e, 0xf0, 0x08, 0x14, 0x1c, 0xaa, 0xb4, 0x96, 0xd1,
+	0x9c, 0x4f, 0xbb, 0xcc, 0x17, 0x5c, 0x56, 0xd6, 0x45, 0x2b, 0x5a, 0x86, 0x45, 0x97, 0x34, 0x9b,
+	0xb4, 0x2b, 0xa8, 0x23, 0xcb, 0xea, 0xa2, 0x35, 0x58, 0xe3, 0x15, 0x54, 0xdc, 0x0d, 0x98, 0x1f,
+	0x74, 0xca, 0xb3, 0x52, 0x02, 0xab, 0x30, 0xb3, 0x64, 0x61, 0xe6, 0x2e, 0xf3, 0x48, 0xdb, 0xee,
+	0x91, 0x76, 0x40, 0xcb, 0x45, 0x95, 0x59, 0x31, 0xc1, 0x83, 0x70, 0x3f, 0xc4, 0xab, 0xcc, 0xec,
+	0x0e, 0x15, 0xe5, 0xb9, 0x55, 0xad, 0x76, 0xc4, 0x9a, 0x57, 0x3b, 0xdb, 0x54, 0xe8, 0x6f, 0x40,
+	0xac, 0x77, 0x88, 0x08, 0xf3, 0x81, 0xf2, 0xa0, 0x2d, 0x06, 0xcf, 0xf5, 0xec, 0xb0, 0x30, 0xb8,
+	0x8e, 0xba, 0xed, 0x99, 0xc1, 0x83, 0xdf, 0x72, 0xb8, 0xfe, 0x93, 0x86, 0x2a, 0xa3, 0xcc, 0x81,
+	0xab, 0xfb, 0x61, 0xd8, 0x72, 0x0b, 0x32, 0xe5, 0x7a, 0x3a, 0x53, 0xf2, 0x8d, 0x0d, 0x58, 0xbf,
+	0xe3, 0x09, 0xbf, 0x6f, 0x45, 0x7e, 0x2a, 0x3b, 0x68, 0x21, 0x2e, 0xc0, 0x4b, 0x68, 0xfa, 0x23,
+	0xda, 0x87, 0x7b, 0x08, 0x3f, 0xf1, 0x15, 0x34, 0xab, 0x18, 0x29, 0x8c, 0x2f, 0xaf, 0x4a, 0xeb,
+	0xb5, 0xc2, 0x0d, 0x4d, 0x3f, 0x09, 0xf5, 0x7a, 0x9b, 0x39, 0x41, 0x9b, 0x86, 0xc5, 0x27, 0x4a,
+	0x5e, 0xfd, 0x57, 0x0d, 0xd2, 0x2b, 0x21, 0x7b, 0x91, 0xee, 0x3d, 0xba, 0x30, 0x14, 0x46, 0x17,
+	0x06, 0x7c, 0x1e, 0x2d, 0x46, 0x57, 0xa0, 0x1e, 0xff, 0xb4, 0x8c, 0x34, 0x7a, 0x86, 0x6f, 0xcb,
+	0x0a, 0x50, 0x47, 0x2f, 0x51, 0x8f, 0x34, 0xda, 0xd4, 0xb1, 0x93, 0xca, 0x33, 0x52, 0xf9, 0x18,
+	0x08, 0xad, 0x98, 0x8d, 0x7e, 0x03, 0x62, 0x96, 0xd9, 0xfd, 0xbe, 0xdb, 0x09, 0x13, 0x7d, 0xb2,
+	0x7e, 0xf2, 0x01, 0x30, 0x92, 0xb0, 0x04, 0x46, 0x36, 0xd1, 0x82, 0x1f, 0x6e, 0xdb, 0x42, 0xee,
+	0x03, 0x2f, 0xa7, 0x32, 0x65, 0x7e, 0x68, 0x3a, 0xa8, 0x0d, 0xc3, 0xad, 0xfa, 0x3f, 0x8b, 0x68,
+	0x56, 0x1e, 0x81, 0x77, 0x51, 0x51, 0x71, 0x88, 0xf5, 0x91, 0xa9, 0x93, 0x18, 0xb2, 0x2a, 0xe7,
+	0xc7, 0xea, 0x28, 0x88, 0x7a, 0xf5, 0xb3, 0x5f, 0xfe, 0x7c, 0x5c, 0x28, 0xe3, 0x15, 0x33, 0x35,
+	0xc6, 0xc1, 0xf5, 0xfc, 0xa5, 0xa1, 0xa5, 0xf4, 0x74, 0x82, 0x2f, 0x8f, 0xf4, 0x9c, 0x33, 0x7e,
+	0x55, 0xae, 0x4c, 0xa8, 0x0d, 0x88, 0x3e, 0x96, 0x88, 0x76, 0x1f, 0xd6, 0xf1, 0xd5, 0x34, 0xa6,
+	0xd8, 0x28, 0x64, 0xee, 0x0d, 0x2f, 0x65, 0xdf, 0xdc, 0x93, 0x85, 0x65, 0x1f, 0xbf, 0xfe, 0x5f,
+	0x2d, 0xcc, 0xbd, 0x68, 0xa8, 0xdb, 0xc7, 0x5f, 0x68, 0x08, 0x0d, 0x67, 0x14, 0x7c, 0x61, 0x0c,
+	0xec, 0x78, 0x78, 0x17, 0x0f, 0xd4, 0x83, 0xc0, 0xd6, 0x64, 0x60, 0xe7, 0xf1, 0xb9, 0x34, 0xc8,
+	0x0c, 0x3a, 0xfc, 0xed, 0x80, 0xf5, 0x61, 0xdb, 0x18, 0xcb, 0x7a, 0x66, 0x0c, 0x1a, 0xcb, 0x7a,
+	0x76, 0xea, 0xd1, 0xaf, 0x4a, 0x70, 0xeb, 0xb8, 0x96, 0x06, 0x17, 0xeb, 0x70, 0x49, 0x8c, 0xdf,
+	0x68, 0x68, 0x39, 0x33, 0x82, 0xe0, 0xc9, 0x8e, 0x1d, 0xe4, 0xa8, 0x31, 0xa9, 0x3a, 0xc0, 0x7c,
+	0x59, 0xc2, 0xac, 0xe2, 0xd3, 0x63, 0x60, 0x72, 0xfc, 0xb5, 0x86, 0x96, 0xd2, 0x93, 0x48, 0x0e,
+	0x7d, 0x39, 0x03, 0x4d, 0x0e, 0x7d, 0x79, 0xe3, 0x8d, 0x7e, 0x4e, 0xe2, 0x3a, 0x85, 0x4f, 0xa6,
+	0x71, 0x0d, 0x4a, 0x18, 0xfe, 0x4e, 0x43, 0xcb, 0x99, 0x59, 0x22, 0x87, 0xaf, 0xbc, 0xb9, 0x27,
+	0x87, 0xaf, 0xdc, 0x11, 0x45, 0xbf, 0x26, 0x71, 0x99, 0xf8, 0x4a, 0x0e, 0x5f, 0xdc, 0x6e, 0xf4,
+	0x6d, 0x18, 0x9d, 0xcc, 0x3d, 0xf8, 0xd8, 0xc7, 0x3f, 0x6b, 0x68, 0x21, 0xde, 0xef, 0x71, 0x6d,
+	0x74, 0x2d, 0xc9, 0x0e, 0x19, 0x95, 0xb5, 0x09, 0x34, 0x01, 0xdc, 0x23, 0x09, 0xae, 0xf1, 0xf0,
+	0x32, 0x5e, 0xcf, 0x54, 0x1f, 0xa5, 0x6f, 0xcb, 0x3a, 0x98, 0xcc, 0xbb, 0xfa, 0xe4, 0xba, 0x83,
+	0xba, 0xf0, 0xa5, 0x86, 0x16, 0x13, 0x7d, 0x15, 0xaf, 0x4d, 0xd2, 0x7b, 0x55, 0x44, 0xeb, 0x93,
+	0xb7, 0x69, 0xfd, 0x82, 0x0c, 0x69, 0x15, 0x57, 0xd3, 0x20, 0xdb, 0x52, 0xdd, 0x86, 0xc6, 0x8d,
+	0x3f, 0xd7, 0x50, 0x29, 0xd6, 0x43, 0xf1, 0xc5, 0xbc, 0x74, 0x4b, 0x75, 0xe0, 0x4a, 0xed, 0x60,
+	0xc5, 0x83, 0x9e, 0x4a, 0x47, 0x2a, 0xdb, 0x5c, 0x1e, 0xfc, 0x58, 0x43, 0xa5, 0x58, 0xff, 0xc9,
+	0x01, 0x92, 0x6d, 0x8b, 0x39, 0x40, 0x46, 0x74, 0x41, 0x7d, 0x43, 0x02, 0xb9, 0x84, 0xd7, 0x32,
+	0x39, 0x18, 0xeb, 0x8d, 0x89, 0x7b, 0xbb, 0xb5, 0xf5, 0xe4, 0x59, 0x55, 0x7b, 0xfa, 0xac, 0xaa,
+	0xfd, 0xf1, 0xac, 0xaa, 0x7d, 0xf5, 0xbc, 0x3a, 0xf5, 0xf4, 0x79, 0x75, 0xea, 0xb7, 0xe7, 0xd5,
+	0xa9, 0x87, 0x66, 0xcb, 0x15, 0x8f, 0x82, 0x86, 0xd1, 0x64, 0x1d, 0xe9, 0xee, 0x43, 0xd7, 0x6b,
+	0xb5, 0x59, 0x83, 0xb4, 0x95, 0xf3, 0x5e, 0xdd, 0xfc, 0x24, 0x3a, 0x41, 0xf4, 0xbb, 0x94, 0x37,
+	0x8a, 0xf2, 0x3f, 0xc4, 0x2b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x73, 0xd3, 0x9a, 0xbd, 0x50,
+	0x11, 0x00, 0x00,
 }
 
-func (m *QueryOracleRequestDocRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// OracleSubmitData queries oracle data by ID
+	OracleSubmitData(ctx context.Context, in *QueryOracleSubmitDataRequest, opts ...grpc.CallOption) (*QueryOracleSubmitDataResponse, error)
+	// OracleData queries oracle data by ID
+	OracleData(ctx context.Context, in *QueryOracleDataRequest, opts ...grpc.CallOption) (*QueryOracleDataResponse, error)
+	// OracleRequestDoc queries oracle request doc by ID
+	OracleRequestDoc(ctx context.Context, in *QueryOracleRequestDocRequest, opts ...grpc.CallOption) (*QueryOracleRequestDocResponse, error)
+	// OracleRequestDocs queries an oracle request document list
+	OracleRequestDocs(ctx context.Context, in *QueryOracleRequestDocsRequest, opts ...grpc.CallOption) (*QueryOracleRequestDocsResponse, error)
+	// ModeratorAddress queries the moderator address
+	ModeratorAddress(ctx context.Context, in *QueryModeratorAddressRequest, opts ...grpc.CallOption) (*QueryModeratorAddressResponse, error)
+	// RequestsByAccount queries the oracle request documents that list an account as provider
+	RequestsByAccount(ctx context.Context, in *QueryRequestsByAccountRequest, opts ...grpc.CallOption) (*QueryRequestsByAccountResponse, error)
+	// PendingRound queries the reports received so far for a round that has not finalized
+	PendingRound(ctx context.Context, in *QueryPendingRoundRequest, opts ...grpc.CallOption) (*QueryPendingRoundResponse, error)
+	// LatestResults queries the latest finalized result of several requests in one call
+	LatestResults(ctx context.Context, in *QueryLatestResultsRequest, opts ...grpc.CallOption) (*QueryLatestResultsResponse, error)
+	// ModuleState queries the params, the moderator address and the request counts in one call
+	ModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
+	// RoundTiming queries the moving averages of how long the rounds of a request take to finalize
+	RoundTiming(ctx context.Context, in *QueryRoundTimingRequest, opts ...grpc.CallOption) (*QueryRoundTimingResponse, error)
 }
 
-func (m *QueryOracleRequestDocRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryOracleRequestDocRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryOracleRequestDocResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOracleRequestDocResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OracleSubmitData(ctx context.Context, in *QueryOracleSubmitDataRequest, opts ...grpc.CallOption) (*QueryOracleSubmitDataResponse, error) {
+	out := new(QueryOracleSubmitDataResponse)
+	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/OracleSubmitData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOracleRequestDocResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.RequestDoc.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) OracleData(ctx context.Context, in *QueryOracleDataRequest, opts ...grpc.CallOption) (*QueryOracleDataResponse, error) {
+	out := new(QueryOracleDataResponse)
+	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/OracleData", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOracleRequestDocsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OracleRequestDoc(ctx context.Context, in *QueryOracleRequestDocRequest, opts ...grpc.CallOption) (*QueryOracleRequestDocResponse, error) {
+	out := new(QueryOracleRequestDocResponse)
+	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/OracleRequestDoc", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOracleRequestDocsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OracleRequestDocs(ctx context.Context, in *QueryOracleRequestDocsRequest, opts ...grpc.CallOption) (*QueryOracleRequestDocsResponse, error) {
+	out := new(QueryOracleRequestDocsResponse)
+	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/OracleRequestDocs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOracleRequestDocsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) ModeratorAddress(ctx context.Context, in *QueryModeratorAddressRequest, opts ...grpc.CallOption) (*QueryModeratorAddressResponse, error) {
+	out := new(QueryModeratorAddressResponse)
+	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/ModeratorAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOracleRequestDocsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RequestsByAccount(ctx context.Context, in *QueryRequestsByAccountRequest, opts ...grpc.CallOption) (*QueryRequestsByAccountResponse, error) {
+	out := new(QueryRequestsByAccountResponse)
+	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/RequestsByAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOracleRequestDocsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingRound(ctx context.Context, in *QueryPendingRoundRequest, opts ...grpc.CallOption) (*QueryPendingRoundResponse, error) {
+	out := new(QueryPendingRoundResponse)
+	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/PendingRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOracleRequestDocsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OracleRequestDocs) > 0 {
-		for iNdEx := len(m.OracleRequestDocs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OracleRequestDocs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) LatestResults(ctx context.Context, in *QueryLatestResultsRequest, opts ...grpc.CallOption) (*QueryLatestResultsResponse, error) {
+	out := new(QueryLatestResultsResponse)
+	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/LatestResults", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryModeratorAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error) {
+	out := new(QueryModuleStateResponse)
+	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/ModuleState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryModeratorAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryModeratorAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryModeratorAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RoundTiming(ctx context.Context, in *QueryRoundTimingRequest, opts ...grpc.CallOption) (*QueryRoundTimingResponse, error) {
+	out := new(QueryRoundTimingResponse)
+	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Query/RoundTiming", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryModeratorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// OracleSubmitData queries oracle data by ID
+	OracleSubmitData(context.Context, *QueryOracleSubmitDataRequest) (*QueryOracleSubmitDataResponse, error)
+	// OracleData queries oracle data by ID
+	OracleData(context.Context, *QueryOracleDataRequest) (*QueryOracleDataResponse, error)
+	// OracleRequestDoc queries oracle request doc by ID
+	OracleRequestDoc(context.Context, *QueryOracleRequestDocRequest) (*QueryOracleRequestDocResponse, error)
+	// OracleRequestDocs queries an oracle request document list
+	OracleRequestDocs(context.Context, *QueryOracleRequestDocsRequest) (*QueryOracleRequestDocsResponse, error)
+	// ModeratorAddress queries the moderator address
+	ModeratorAddress(context.Context, *QueryModeratorAddressRequest) (*QueryModeratorAddressResponse, error)
+	// RequestsByAccount queries the oracle request documents that list an account as provider
+	RequestsByAccount(context.Context, *QueryRequestsByAccountRequest) (*QueryRequestsByAccountResponse, error)
+	// PendingRound queries the reports received so far for a round that has not finalized
+	PendingRound(context.Context, *QueryPendingRoundRequest) (*QueryPendingRoundResponse, error)
+	// LatestResults queries the latest finalized result of several requests in one call
+	LatestResults(context.Context, *QueryLatestResultsRequest) (*QueryLatestResultsResponse, error)
+	// ModuleState queries the params, the moderator address and the request counts in one call
+	ModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
+	// RoundTiming queries the moving averages of how long the rounds of a request take to finalize
+	RoundTiming(context.Context, *QueryRoundTimingRequest) (*QueryRoundTimingResponse, error)
 }
 
-func (m *QueryModeratorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ModeratorAddress) > 0 {
-		i -= len(m.ModeratorAddress)
-		copy(dAtA[i:], m.ModeratorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModeratorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedQueryServer) OracleSubmitData(ctx context.Context, req *QueryOracleSubmitDataRequest) (*QueryOracleSubmitDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleSubmitData not implemented")
+}
+func (*UnimplementedQueryServer) OracleData(ctx context.Context, req *QueryOracleDataRequest) (*QueryOracleDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleData not implemented")
+}
+func (*UnimplementedQueryServer) OracleRequestDoc(ctx context.Context, req *QueryOracleRequestDocRequest) (*QueryOracleRequestDocResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleRequestDoc not implemented")
+}
+func (*UnimplementedQueryServer) OracleRequestDocs(ctx context.Context, req *QueryOracleRequestDocsRequest) (*QueryOracleRequestDocsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleRequestDocs not implemented")
+}
+func (*UnimplementedQueryServer) ModeratorAddress(ctx context.Context, req *QueryModeratorAddressRequest) (*QueryModeratorAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModeratorAddress not implemented")
+}
+func (*UnimplementedQueryServer) RequestsByAccount(ctx context.Context, req *QueryRequestsByAccountRequest) (*QueryRequestsByAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestsByAccount not implemented")
+}
+func (*UnimplementedQueryServer) PendingRound(ctx context.Context, req *QueryPendingRoundRequest) (*QueryPendingRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRound not implemented")
+}
+func (*UnimplementedQueryServer) LatestResults(ctx context.Context, req *QueryLatestResultsRequest) (*QueryLatestResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestResults not implemented")
+}
+func (*UnimplementedQueryServer) ModuleState(ctx context.Context, req *QueryModuleStateRequest) (*QueryModuleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleState not implemented")
+}
+func (*UnimplementedQueryServer) RoundTiming(ctx context.Context, req *QueryRoundTimingRequest) (*QueryRoundTimingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoundTiming not implemented")
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryOracleSubmitDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		n += 1 + sovQuery(uint64(m.RequestId))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guru.oracle.v1.Query/Params",
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOracleSubmitDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OracleSubmitData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleSubmitDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.SubmitDatas) > 0 {
-		for _, e := range m.SubmitDatas {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleSubmitData(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryOracleDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guru.oracle.v1.Query/OracleSubmitData",
 	}
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		n += 1 + sovQuery(uint64(m.RequestId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleSubmitData(ctx, req.(*QueryOracleSubmitDataRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOracleDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OracleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.DataSet != nil {
-		l = m.DataSet.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).OracleData(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryOracleRequestDocRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guru.oracle.v1.Query/OracleData",
 	}
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		n += 1 + sovQuery(uint64(m.RequestId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleData(ctx, req.(*QueryOracleDataRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOracleRequestDocResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OracleRequestDoc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleRequestDocRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.RequestDoc.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryOracleRequestDocsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).OracleRequestDoc(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guru.oracle.v1.Query/OracleRequestDoc",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleRequestDoc(ctx, req.(*QueryOracleRequestDocRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOracleRequestDocsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OracleRequestDocs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleRequestDocsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.OracleRequestDocs) > 0 {
+	if interceptor == nil {
+		return srv.(QueryServer).OracleRequestDocs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guru.oracle.v1.Query/OracleRequestDocs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleRequestDocs(ctx, req.(*QueryOracleRequestDocsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModeratorAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModeratorAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModeratorAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guru.oracle.v1.Query/ModeratorAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModeratorAddress(ctx, req.(*QueryModeratorAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RequestsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestsByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RequestsByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guru.oracle.v1.Query/RequestsByAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequestsByAccount(ctx, req.(*QueryRequestsByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guru.oracle.v1.Query/PendingRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRound(ctx, req.(*QueryPendingRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guru.oracle.v1.Query/LatestResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestResults(ctx, req.(*QueryLatestResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guru.oracle.v1.Query/ModuleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleState(ctx, req.(*QueryModuleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RoundTiming_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoundTimingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoundTiming(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guru.oracle.v1.Query/RoundTiming",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoundTiming(ctx, req.(*QueryRoundTimingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "guru.oracle.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "OracleSubmitData",
+			Handler:    _Query_OracleSubmitData_Handler,
+		},
+		{
+			MethodName: "OracleData",
+			Handler:    _Query_OracleData_Handler,
+		},
+		{
+			MethodName: "OracleRequestDoc",
+			Handler:    _Query_OracleRequestDoc_Handler,
+		},
+		{
+			MethodName: "OracleRequestDocs",
+			Handler:    _Query_OracleRequestDocs_Handler,
+		},
+		{
+			MethodName: "ModeratorAddress",
+			Handler:    _Query_ModeratorAddress_Handler,
+		},
+		{
+			MethodName: "RequestsByAccount",
+			Handler:    _Query_RequestsByAccount_Handler,
+		},
+		{
+			MethodName: "PendingRound",
+			Handler:    _Query_PendingRound_Handler,
+		},
+		{
+			MethodName: "LatestResults",
+			Handler:    _Query_LatestResults_Handler,
+		},
+		{
+			MethodName: "ModuleState",
+			Handler:    _Query_ModuleState_Handler,
+		},
+		{
+			MethodName: "RoundTiming",
+			Handler:    _Query_RoundTiming_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "guru/oracle/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleSubmitDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleSubmitDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleSubmitDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleSubmitDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleSubmitDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleSubmitDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubmitDatas) > 0 {
+		for iNdEx := len(m.SubmitDatas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubmitDatas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DataSet != nil {
+		{
+			size, err := m.DataSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleRequestDocRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleRequestDocRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleRequestDocRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleRequestDocResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleRequestDocResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleRequestDocResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RequestDoc.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleRequestDocsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleRequestDocsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleRequestDocsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleRequestDocsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleRequestDocsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleRequestDocsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleRequestDocs) > 0 {
+		for iNdEx := len(m.OracleRequestDocs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleRequestDocs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModeratorAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModeratorAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModeratorAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModeratorAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModeratorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModeratorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModeratorAddress) > 0 {
+		i -= len(m.ModeratorAddress)
+		copy(dAtA[i:], m.ModeratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModeratorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequestsByAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestsByAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestsByAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequestsByAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestsByAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestsByAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequestDocs) > 0 {
+		for iNdEx := len(m.RequestDocs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequestDocs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuorumMet {
+		i--
+		if m.QuorumMet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ProvisionalValue) > 0 {
+		i -= len(m.ProvisionalValue)
+		copy(dAtA[i:], m.ProvisionalValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProvisionalValue)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Quorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quorum))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Accepted != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Accepted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reports != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reports))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestIds) > 0 {
+		dAtA9 := make([]byte, len(m.RequestIds)*10)
+		var j8 int
+		for _, num := range m.RequestIds {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintQuery(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for k := range m.Results {
+			v := m.Results[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQuery(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnabledRequestCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EnabledRequestCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RequestCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ModeratorAddress) > 0 {
+		i -= len(m.ModeratorAddress)
+		copy(dAtA[i:], m.ModeratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModeratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoundTimingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoundTimingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoundTimingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoundTimingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoundTimingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoundTimingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RoundTiming.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOracleSubmitDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleSubmitDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SubmitDatas) > 0 {
+		for _, e := range m.SubmitDatas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	return n
+}
+
+func (m *QueryOracleDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSet != nil {
+		l = m.DataSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleRequestDocRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	return n
+}
+
+func (m *QueryOracleRequestDocResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RequestDoc.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOracleRequestDocsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryOracleRequestDocsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OracleRequestDocs) > 0 {
 		for _, e := range m.OracleRequestDocs {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
+	return n
+}
+
+func (m *QueryModeratorAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModeratorAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequestsByAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequestsByAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequestDocs) > 0 {
+		for _, e := range m.RequestDocs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryPendingRoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.Reports != 0 {
+		n += 1 + sovQuery(uint64(m.Reports))
+	}
+	if m.Accepted != 0 {
+		n += 1 + sovQuery(uint64(m.Accepted))
+	}
+	if m.Quorum != 0 {
+		n += 1 + sovQuery(uint64(m.Quorum))
+	}
+	l = len(m.ProvisionalValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.QuorumMet {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryLatestResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequestIds) > 0 {
+		l = 0
+		for _, e := range m.RequestIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryLatestResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for k, v := range m.Results {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuery(uint64(l))
+			}
+			mapEntrySize := 1 + sovQuery(uint64(k)) + l
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryModuleStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RequestCount != 0 {
+		n += 1 + sovQuery(uint64(m.RequestCount))
+	}
+	if m.EnabledRequestCount != 0 {
+		n += 1 + sovQuery(uint64(m.EnabledRequestCount))
+	}
+	return n
+}
+
+func (m *QueryRoundTimingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	return n
+}
+
+func (m *QueryRoundTimingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RoundTiming.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleSubmitDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleSubmitDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleSubmitDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleSubmitDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleSubmitDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleSubmitDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitDatas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmitDatas = append(m.SubmitDatas, &SubmitDataSet{})
+			if err := m.SubmitDatas[len(m.SubmitDatas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataSet == nil {
+				m.DataSet = &DataSet{}
+			}
+			if err := m.DataSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleRequestDocRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleRequestDocRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleRequestDocRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleRequestDocResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleRequestDocResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleRequestDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestDoc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestDoc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *QueryOracleRequestDocsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleRequestDocsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleRequestDocsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryModeratorAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleRequestDocsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleRequestDocsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleRequestDocsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRequestDocs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleRequestDocs = append(m.OracleRequestDocs, &OracleRequestDoc{})
+			if err := m.OracleRequestDocs[len(m.OracleRequestDocs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModeratorAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModeratorAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModeratorAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryModeratorAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ModeratorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModeratorAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1482,12 +3835,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModeratorAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModeratorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModeratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1509,7 +3894,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestsByAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1532,15 +3917,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequestsByAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequestsByAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1567,7 +3984,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1592,7 +4012,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleSubmitDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestsByAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1615,17 +4035,17 @@ func (m *QueryOracleSubmitDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleSubmitDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequestsByAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleSubmitDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequestsByAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestDocs", wireType)
 			}
-			m.RequestId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1635,35 +4055,31 @@ func (m *QueryOracleSubmitDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestDocs = append(m.RequestDocs, OracleRequestDoc{})
+			if err := m.RequestDocs[len(m.RequestDocs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1673,23 +4089,27 @@ func (m *QueryOracleSubmitDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1712,7 +4132,7 @@ func (m *QueryOracleSubmitDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleSubmitDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRoundRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1735,17 +4155,17 @@ func (m *QueryOracleSubmitDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleSubmitDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRoundRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleSubmitDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitDatas", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var msglen int
+			m.RequestId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1755,26 +4175,30 @@ func (m *QueryOracleSubmitDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RequestId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.SubmitDatas = append(m.SubmitDatas, &SubmitDataSet{})
-			if err := m.SubmitDatas[len(m.SubmitDatas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1796,7 +4220,7 @@ func (m *QueryOracleSubmitDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1819,17 +4243,74 @@ func (m *QueryOracleDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			m.Reports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reports |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
 			}
-			m.RequestId = 0
+			m.Accepted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1839,66 +4320,35 @@ func (m *QueryOracleDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				m.Accepted |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOracleDataResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Quorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleDataResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionalValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1908,28 +4358,44 @@ func (m *QueryOracleDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DataSet == nil {
-				m.DataSet = &DataSet{}
+			m.ProvisionalValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumMet", wireType)
 			}
-			if err := m.DataSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.QuorumMet = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1951,7 +4417,7 @@ func (m *QueryOracleDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleRequestDocRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestResultsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1974,30 +4440,87 @@ func (m *QueryOracleRequestDocRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleRequestDocRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestResultsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleRequestDocRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-			}
-			m.RequestId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.RequestIds = append(m.RequestIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RequestIds) == 0 {
+					m.RequestIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RequestIds = append(m.RequestIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -2020,7 +4543,7 @@ func (m *QueryOracleRequestDocRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleRequestDocResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestResultsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2043,15 +4566,15 @@ func (m *QueryOracleRequestDocResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleRequestDocResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestResultsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleRequestDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestDoc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2078,9 +4601,91 @@ func (m *QueryOracleRequestDocResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RequestDoc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Results == nil {
+				m.Results = make(map[uint64]*DataSet)
+			}
+			var mapkey uint64
+			var mapvalue *DataSet
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DataSet{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Results[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2103,7 +4708,7 @@ func (m *QueryOracleRequestDocResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleRequestDocsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2126,31 +4731,12 @@ func (m *QueryOracleRequestDocsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleRequestDocsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleRequestDocsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= RequestStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2172,7 +4758,7 @@ func (m *QueryOracleRequestDocsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleRequestDocsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2195,15 +4781,15 @@ func (m *QueryOracleRequestDocsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleRequestDocsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleRequestDocsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleRequestDocs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2230,11 +4816,80 @@ func (m *QueryOracleRequestDocsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleRequestDocs = append(m.OracleRequestDocs, &OracleRequestDoc{})
-			if err := m.OracleRequestDocs[len(m.OracleRequestDocs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModeratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestCount", wireType)
+			}
+			m.RequestCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledRequestCount", wireType)
+			}
+			m.EnabledRequestCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnabledRequestCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2256,7 +4911,7 @@ func (m *QueryOracleRequestDocsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModeratorAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRoundTimingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2279,12 +4934,31 @@ func (m *QueryModeratorAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModeratorAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoundTimingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModeratorAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoundTimingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2306,7 +4980,7 @@ func (m *QueryModeratorAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModeratorAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRoundTimingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2329,17 +5003,17 @@ func (m *QueryModeratorAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModeratorAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoundTimingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModeratorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoundTimingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModeratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundTiming", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2349,23 +5023,24 @@ func (m *QueryModeratorAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.RoundTiming.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -359,6 +359,95 @@ func (m *MsgUpdateModeratorAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateModeratorAddressResponse proto.InternalMessageInfo
 
+// MsgCancelRound represents a message to abort the open round of a request.
+// The round's reports are deleted and the nonce advances without a result.
+type MsgCancelRound struct {
+	ModeratorAddress string `protobuf:"bytes,1,opt,name=moderator_address,json=moderatorAddress,proto3" json:"moderator_address,omitempty"`
+	// ID of the request whose round is cancelled
+	RequestId uint64 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Nonce of the open round, one above the request's current nonce
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *MsgCancelRound) Reset()         { *m = MsgCancelRound{} }
+func (m *MsgCancelRound) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRound) ProtoMessage()    {}
+func (*MsgCancelRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_febdd1f478235f42, []int{8}
+}
+func (m *MsgCancelRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRound.Merge(m, src)
+}
+func (m *MsgCancelRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRound proto.InternalMessageInfo
+
+// MsgCancelRoundResponse defines the Msg/CancelRound response type
+type MsgCancelRoundResponse struct {
+	// Number of reports deleted with the round
+	ClearedReports uint64 `protobuf:"varint,1,opt,name=cleared_reports,json=clearedReports,proto3" json:"cleared_reports,omitempty"`
+}
+
+func (m *MsgCancelRoundResponse) Reset()         { *m = MsgCancelRoundResponse{} }
+func (m *MsgCancelRoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRoundResponse) ProtoMessage()    {}
+func (*MsgCancelRoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_febdd1f478235f42, []int{9}
+}
+func (m *MsgCancelRoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRoundResponse.Merge(m, src)
+}
+func (m *MsgCancelRoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRoundResponse proto.InternalMessageInfo
+
+func (m *MsgCancelRoundResponse) GetClearedReports() uint64 {
+	if m != nil {
+		return m.ClearedReports
+	}
+	return 0
+}
+
 // MsgUpdateParams defines a Msg for updating the oracle module parameters
 type MsgUpdateParams struct {
 	// authority is the address of the governance account
@@ -372,7 +461,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_febdd1f478235f42, []int{8}
+	return fileDescriptor_febdd1f478235f42, []int{10}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -423,7 +512,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_febdd1f478235f42, []int{9}
+	return fileDescriptor_febdd1f478235f42, []int{11}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,6 +550,8 @@ func init() {
 	proto.RegisterType((*MsgSubmitOracleDataResponse)(nil), "guru.oracle.v1.MsgSubmitOracleDataResponse")
 	proto.RegisterType((*MsgUpdateModeratorAddress)(nil), "guru.oracle.v1.MsgUpdateModeratorAddress")
 	proto.RegisterType((*MsgUpdateModeratorAddressResponse)(nil), "guru.oracle.v1.MsgUpdateModeratorAddressResponse")
+	proto.RegisterType((*MsgCancelRound)(nil), "guru.oracle.v1.MsgCancelRound")
+	proto.RegisterType((*MsgCancelRoundResponse)(nil), "guru.oracle.v1.MsgCancelRoundResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "guru.oracle.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "guru.oracle.v1.MsgUpdateParamsResponse")
 }
@@ -468,56 +559,63 @@ func init() {
 func init() { proto.RegisterFile("guru/oracle/v1/tx.proto", fileDescriptor_febdd1f478235f42) }
 
 var fileDescriptor_febdd1f478235f42 = []byte{
-	// 776 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x33, 0x6d, 0x7f, 0xf9, 0xd9, 0xa9, 0xd4, 0xba, 0xad, 0x6d, 0x9a, 0x9a, 0x4d, 0xdc,
-	0xaa, 0xfd, 0x47, 0xb3, 0xa4, 0x15, 0x91, 0xde, 0x0c, 0x15, 0x29, 0x18, 0x94, 0x2d, 0x5e, 0xbc,
-	0x2c, 0x93, 0xec, 0x38, 0x5d, 0x48, 0x76, 0xe2, 0xce, 0x6c, 0xff, 0xdc, 0xa4, 0x27, 0x0f, 0x82,
-	0x82, 0x77, 0x29, 0x88, 0x47, 0xa1, 0x07, 0xf1, 0x35, 0xf4, 0x22, 0x14, 0xbd, 0x78, 0x12, 0x69,
-	0x84, 0xfa, 0x32, 0x64, 0x77, 0x76, 0xb7, 0xcd, 0xa4, 0xdb, 0x44, 0xf0, 0xe0, 0x2d, 0x3b, 0xcf,
-	0xf7, 0x79, 0xe6, 0xf3, 0x7c, 0x67, 0x9e, 0x21, 0x70, 0x82, 0x78, 0xae, 0xa7, 0x53, 0x17, 0xd5,
-	0xea, 0x58, 0xdf, 0x2c, 0xe9, 0x7c, 0xbb, 0xd8, 0x74, 0x29, 0xa7, 0xca, 0xb0, 0x1f, 0x28, 0x8a,
-	0x40, 0x71, 0xb3, 0x94, 0x9d, 0xa8, 0x51, 0xd6, 0xa0, 0x4c, 0x6f, 0x30, 0xe2, 0xeb, 0x1a, 0x8c,
-	0x08, 0x61, 0x76, 0x52, 0x04, 0xcc, 0xe0, 0x4b, 0x17, 0x1f, 0x61, 0x68, 0x8c, 0x50, 0x42, 0xc5,
-	0xba, 0xff, 0x2b, 0x5c, 0xbd, 0x4a, 0x28, 0x25, 0x75, 0xac, 0xa3, 0xa6, 0xad, 0x23, 0xc7, 0xa1,
-	0x1c, 0x71, 0x9b, 0x3a, 0x51, 0xce, 0x94, 0x04, 0x14, 0x12, 0x44, 0xa9, 0xed, 0x41, 0x82, 0x1d,
-	0xcc, 0xec, 0x28, 0x55, 0x0d, 0x11, 0xab, 0x88, 0xf9, 0xd1, 0x2a, 0xe6, 0xa8, 0xa4, 0xd7, 0xa8,
-	0xed, 0x88, 0xb8, 0xf6, 0x19, 0xc0, 0xa9, 0x0a, 0x23, 0x06, 0x26, 0x36, 0xe3, 0xd8, 0x7d, 0x18,
-	0x94, 0x31, 0xf0, 0x33, 0x0f, 0x33, 0xbe, 0x4a, 0x6b, 0xca, 0x3d, 0x78, 0xb9, 0x41, 0x2d, 0xec,
-	0x22, 0x4e, 0x5d, 0x13, 0x59, 0x96, 0x8b, 0x19, 0xcb, 0x80, 0x02, 0x98, 0x1d, 0x2c, 0x67, 0xbe,
-	0x7c, 0x5c, 0x1c, 0x0b, 0x7b, 0xbb, 0x2b, 0x22, 0xeb, 0xdc, 0xb5, 0x1d, 0x62, 0x8c, 0xc4, 0x29,
-	0xe1, 0xba, 0x72, 0x1f, 0x0e, 0xb9, 0xa2, 0xa8, 0x69, 0xd1, 0x5a, 0xa6, 0xaf, 0x00, 0x66, 0x87,
-	0x96, 0x0a, 0xc5, 0x76, 0x3f, 0x8b, 0xf2, 0xee, 0xe5, 0x81, 0x83, 0xef, 0xf9, 0x94, 0x01, 0xdd,
-	0x78, 0x65, 0x45, 0x7d, 0xb1, 0x97, 0x4f, 0xfd, 0xda, 0xcb, 0xa7, 0x76, 0x8f, 0xf7, 0xe7, 0x3b,
-	0xd1, 0xb4, 0x55, 0x38, 0x7d, 0x4e, 0x3b, 0x06, 0x66, 0x4d, 0xea, 0x30, 0xac, 0xe4, 0x60, 0x54,
-	0xd4, 0xb4, 0xad, 0xa0, 0x9f, 0x01, 0x63, 0x30, 0x5c, 0x59, 0xb3, 0xb4, 0x16, 0x80, 0x93, 0x15,
-	0x46, 0x1e, 0x37, 0x2d, 0xc4, 0xf1, 0xbf, 0xee, 0x89, 0x32, 0x0e, 0xd3, 0x2e, 0x46, 0x8c, 0x3a,
-	0x99, 0x7e, 0x1f, 0xc2, 0x08, 0xbf, 0xba, 0x7a, 0x55, 0x86, 0xd7, 0x12, 0x9b, 0xec, 0xd5, 0xa9,
-	0x4f, 0x00, 0x8e, 0x56, 0x18, 0x59, 0xf7, 0xaa, 0x0d, 0x9b, 0x8b, 0x22, 0xab, 0x88, 0x23, 0xdf,
-	0x23, 0xe4, 0xf1, 0x0d, 0xea, 0xda, 0x7c, 0xa7, 0x77, 0x8f, 0xe2, 0x94, 0xc8, 0xa3, 0x3b, 0xf0,
-	0x82, 0x85, 0x38, 0x32, 0x19, 0xe6, 0xa1, 0x41, 0x39, 0xd9, 0x20, 0xb1, 0xb5, 0xbf, 0xe9, 0x3a,
-	0xe6, 0xc6, 0xff, 0x96, 0xf8, 0x21, 0x35, 0xdf, 0xc1, 0xa2, 0xe5, 0x82, 0x7b, 0x2f, 0x73, 0x47,
-	0x6d, 0x6b, 0x87, 0xa7, 0x6f, 0x40, 0x45, 0x3e, 0xba, 0xbf, 0x74, 0x03, 0x1e, 0xc0, 0x2b, 0x0e,
-	0xde, 0x32, 0x3b, 0x4b, 0xf5, 0x75, 0x29, 0x35, 0xea, 0xe0, 0x2d, 0x19, 0xaa, 0xeb, 0x71, 0x4f,
-	0x9f, 0x3a, 0x6e, 0x39, 0x39, 0xee, 0xfb, 0x15, 0x80, 0x97, 0x62, 0xd5, 0x23, 0xe4, 0xa2, 0x06,
-	0x53, 0x6e, 0xc3, 0xc1, 0xd8, 0xbf, 0xae, 0x5d, 0x9e, 0x48, 0x95, 0x5b, 0x30, 0xdd, 0x0c, 0x2a,
-	0x84, 0x47, 0x37, 0x2e, 0x1f, 0x9d, 0xa8, 0x1f, 0xde, 0xe8, 0x50, 0xbb, 0x32, 0xec, 0xe3, 0x9f,
-	0x54, 0xd1, 0x26, 0xe1, 0x84, 0x04, 0x14, 0xc1, 0x2e, 0xbd, 0x4d, 0xc3, 0xfe, 0x0a, 0x23, 0xca,
-	0x07, 0x00, 0x33, 0x89, 0x2f, 0xd8, 0x82, 0xbc, 0xeb, 0x39, 0xef, 0x43, 0x76, 0xf9, 0x0f, 0xc4,
-	0xb1, 0x67, 0xfa, 0xee, 0xd7, 0x9f, 0x6f, 0xfa, 0xe6, 0x56, 0xc0, 0xbc, 0x76, 0x5d, 0x97, 0x5e,
-	0x63, 0x37, 0x4c, 0x36, 0x4f, 0x8d, 0xba, 0xf2, 0x1e, 0xc0, 0xf1, 0x84, 0xb7, 0x65, 0xee, 0x0c,
-	0x80, 0xb3, 0xa5, 0xd9, 0x52, 0xcf, 0xd2, 0x98, 0x74, 0x31, 0x20, 0x9d, 0xf1, 0x49, 0x35, 0x99,
-	0xd4, 0x0b, 0x52, 0xdb, 0x38, 0x5f, 0x02, 0x38, 0xd2, 0x31, 0xd9, 0xd3, 0x67, 0x6c, 0x2b, 0x8b,
-	0xb2, 0x0b, 0x3d, 0x88, 0x62, 0xaa, 0x9b, 0x01, 0x55, 0xc1, 0xa7, 0x9a, 0x92, 0xa9, 0x58, 0x90,
-	0x64, 0xfa, 0x53, 0xad, 0xbc, 0x8b, 0x6d, 0xeb, 0x18, 0xc8, 0x64, 0xdb, 0x64, 0xe9, 0x39, 0xb6,
-	0x25, 0x0e, 0xc5, 0x42, 0x00, 0x78, 0xc3, 0x07, 0x2c, 0x24, 0xd8, 0x16, 0x8f, 0x9b, 0xb2, 0x0b,
-	0xe0, 0xc5, 0xb6, 0xf1, 0xc9, 0x27, 0x6e, 0x28, 0x04, 0xd9, 0x99, 0x2e, 0x82, 0x98, 0x63, 0x36,
-	0xe0, 0xd0, 0x7c, 0x8e, 0x5c, 0x02, 0x87, 0x18, 0xa2, 0xec, 0x7f, 0xcf, 0x8f, 0xf7, 0xe7, 0x41,
-	0x79, 0xed, 0xe0, 0x48, 0x05, 0x87, 0x47, 0x2a, 0xf8, 0x71, 0xa4, 0x82, 0xd7, 0x2d, 0x35, 0x75,
-	0xd8, 0x52, 0x53, 0xdf, 0x5a, 0x6a, 0xea, 0x89, 0x4e, 0x6c, 0xbe, 0xe1, 0x55, 0x8b, 0x35, 0xda,
-	0x08, 0x2a, 0x3d, 0xb5, 0x1d, 0x52, 0xa7, 0x55, 0x54, 0x17, 0x75, 0x37, 0x97, 0xf4, 0xed, 0xa8,
-	0x38, 0xdf, 0x69, 0x62, 0x56, 0x4d, 0x07, 0xff, 0x17, 0x96, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0xed, 0x33, 0x21, 0xae, 0x1d, 0x09, 0x00, 0x00,
+	// 881 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0x69, 0x9a, 0x92, 0x09, 0x72, 0xcb, 0x36, 0x38, 0xce, 0xa6, 0x5e, 0xbb, 0x1b,
+	0xa8, 0xd3, 0x44, 0xf5, 0xca, 0x29, 0x42, 0x28, 0xb7, 0x9a, 0x20, 0x54, 0x09, 0x0b, 0xb4, 0x11,
+	0x17, 0x2e, 0xab, 0xf1, 0xee, 0x30, 0x5d, 0xc9, 0xde, 0x31, 0x33, 0xb3, 0x69, 0x7b, 0x41, 0x28,
+	0x27, 0x0e, 0x48, 0x20, 0x71, 0x47, 0x95, 0x10, 0x37, 0x90, 0x7a, 0x40, 0xfc, 0x0d, 0xbd, 0x20,
+	0x45, 0x70, 0xe1, 0x84, 0x50, 0x8c, 0x54, 0xfe, 0x0c, 0xb4, 0x33, 0xbb, 0x5b, 0x7b, 0xec, 0xb5,
+	0x8d, 0xd4, 0x03, 0x37, 0xcf, 0xbc, 0x5f, 0x9f, 0xf7, 0xdd, 0x37, 0x2f, 0x81, 0x5b, 0x24, 0x66,
+	0xb1, 0x43, 0x19, 0xf2, 0xfb, 0xd8, 0x39, 0x6d, 0x3b, 0xe2, 0x51, 0x6b, 0xc8, 0xa8, 0xa0, 0x46,
+	0x39, 0x31, 0xb4, 0x94, 0xa1, 0x75, 0xda, 0x36, 0xb7, 0x7c, 0xca, 0x07, 0x94, 0x3b, 0x03, 0x4e,
+	0x12, 0xbf, 0x01, 0x27, 0xca, 0xd1, 0xdc, 0x56, 0x06, 0x4f, 0x9e, 0x1c, 0x75, 0x48, 0x4d, 0x9b,
+	0x84, 0x12, 0xaa, 0xee, 0x93, 0x5f, 0xe9, 0xed, 0x0d, 0x42, 0x29, 0xe9, 0x63, 0x07, 0x0d, 0x43,
+	0x07, 0x45, 0x11, 0x15, 0x48, 0x84, 0x34, 0xca, 0x62, 0x76, 0x34, 0xa0, 0x94, 0x20, 0x0b, 0x9d,
+	0x34, 0x12, 0x1c, 0x61, 0x1e, 0x66, 0xa1, 0x56, 0x8a, 0xd8, 0x43, 0x3c, 0xb1, 0xf6, 0xb0, 0x40,
+	0x6d, 0xc7, 0xa7, 0x61, 0xa4, 0xec, 0xf6, 0xaf, 0x00, 0xee, 0x74, 0x39, 0x71, 0x31, 0x09, 0xb9,
+	0xc0, 0xec, 0x43, 0x99, 0xc6, 0xc5, 0x9f, 0xc5, 0x98, 0x8b, 0x63, 0xea, 0x1b, 0xef, 0xc1, 0xd7,
+	0x06, 0x34, 0xc0, 0x0c, 0x09, 0xca, 0x3c, 0x14, 0x04, 0x0c, 0x73, 0x5e, 0x05, 0x0d, 0xb0, 0xb7,
+	0xde, 0xa9, 0xfe, 0xf6, 0xf3, 0x9d, 0xcd, 0xb4, 0xb7, 0x7b, 0xca, 0x72, 0x22, 0x58, 0x18, 0x11,
+	0xf7, 0x5a, 0x1e, 0x92, 0xde, 0x1b, 0xef, 0xc3, 0x0d, 0xa6, 0x92, 0x7a, 0x01, 0xf5, 0xab, 0x2b,
+	0x0d, 0xb0, 0xb7, 0x71, 0xd8, 0x68, 0x4d, 0xea, 0xd9, 0xd2, 0xab, 0x77, 0x56, 0x9f, 0xfd, 0x59,
+	0x2f, 0xb9, 0x90, 0xe5, 0x37, 0x47, 0xd6, 0x97, 0x4f, 0xea, 0xa5, 0x7f, 0x9e, 0xd4, 0x4b, 0x67,
+	0xcf, 0x9f, 0xee, 0x4f, 0xa3, 0xd9, 0xc7, 0x70, 0x77, 0x4e, 0x3b, 0x2e, 0xe6, 0x43, 0x1a, 0x71,
+	0x6c, 0xd4, 0x60, 0x96, 0xd4, 0x0b, 0x03, 0xd9, 0xcf, 0xaa, 0xbb, 0x9e, 0xde, 0xdc, 0x0f, 0xec,
+	0x11, 0x80, 0xdb, 0x5d, 0x4e, 0x3e, 0x1e, 0x06, 0x48, 0xe0, 0xff, 0xbb, 0x26, 0x46, 0x05, 0xae,
+	0x31, 0x8c, 0x38, 0x8d, 0xaa, 0x97, 0x12, 0x08, 0x37, 0x3d, 0x2d, 0xd4, 0xaa, 0x03, 0x6f, 0x16,
+	0x36, 0xb9, 0xac, 0x52, 0xbf, 0x00, 0x78, 0xbd, 0xcb, 0xc9, 0x49, 0xdc, 0x1b, 0x84, 0x42, 0x25,
+	0x39, 0x46, 0x02, 0x25, 0x1a, 0xa1, 0x58, 0x3c, 0xa0, 0x2c, 0x14, 0x8f, 0x97, 0xd7, 0x28, 0x0f,
+	0xc9, 0x34, 0x7a, 0x07, 0xbe, 0x12, 0x20, 0x81, 0x3c, 0x8e, 0x45, 0x2a, 0x50, 0x4d, 0x17, 0x48,
+	0x95, 0x4e, 0x8a, 0x9e, 0x60, 0xe1, 0x5e, 0x09, 0xd4, 0x0f, 0xad, 0xf9, 0x29, 0x16, 0xbb, 0x26,
+	0xe7, 0x5e, 0xe7, 0xce, 0xda, 0xb6, 0xcf, 0xc7, 0x27, 0xa0, 0xab, 0x7f, 0xba, 0x97, 0x34, 0x01,
+	0x1f, 0xc0, 0xd7, 0x23, 0xfc, 0xd0, 0x9b, 0x4e, 0xb5, 0xb2, 0x20, 0xd5, 0xf5, 0x08, 0x3f, 0xd4,
+	0xa1, 0x16, 0x7e, 0xee, 0xdd, 0xb1, 0xcf, 0xad, 0x07, 0xe7, 0x7d, 0xff, 0x08, 0x60, 0xb9, 0xcb,
+	0xc9, 0xbb, 0x28, 0xf2, 0x71, 0xdf, 0xa5, 0x71, 0x14, 0xbc, 0xac, 0x66, 0x27, 0x07, 0x69, 0x45,
+	0x1b, 0x24, 0x63, 0x13, 0x5e, 0x8e, 0x68, 0xe4, 0x63, 0x39, 0xc3, 0xab, 0xae, 0x3a, 0x2c, 0xec,
+	0xe9, 0x1e, 0xac, 0x4c, 0xd2, 0xe6, 0x73, 0xdb, 0x84, 0x57, 0xfd, 0x3e, 0x46, 0x0c, 0x07, 0x1e,
+	0xc3, 0x43, 0xca, 0x04, 0x4f, 0x87, 0xb7, 0x9c, 0x5e, 0xbb, 0xea, 0xd6, 0xfe, 0x1a, 0xc0, 0xab,
+	0xb9, 0x2e, 0x1f, 0x21, 0x86, 0x06, 0xdc, 0x78, 0x1b, 0xae, 0xe7, 0x13, 0xb3, 0xb0, 0xd5, 0x17,
+	0xae, 0xc6, 0x5b, 0x70, 0x6d, 0x28, 0x33, 0xa4, 0xc3, 0x5a, 0xd1, 0x87, 0x55, 0xe5, 0x4f, 0xdf,
+	0x70, 0xea, 0x7b, 0x54, 0x4e, 0x9a, 0x7b, 0x91, 0xc5, 0xde, 0x86, 0x5b, 0x1a, 0x50, 0xd6, 0xd5,
+	0xe1, 0x77, 0x57, 0xe0, 0xa5, 0x2e, 0x27, 0xc6, 0x4f, 0x00, 0x56, 0x0b, 0x77, 0xf6, 0x81, 0x5e,
+	0x75, 0xce, 0x46, 0x34, 0xef, 0xfe, 0x07, 0xe7, 0x7c, 0x4a, 0x9c, 0xb3, 0xdf, 0xff, 0xfe, 0x76,
+	0xe5, 0xf6, 0x11, 0xd8, 0xb7, 0xdf, 0x70, 0xb4, 0xbf, 0x3f, 0x2c, 0x0d, 0xf6, 0xc6, 0x96, 0x9b,
+	0xf1, 0x03, 0x80, 0x95, 0x82, 0x6d, 0x7a, 0x7b, 0x06, 0xc0, 0x6c, 0x57, 0xb3, 0xbd, 0xb4, 0x6b,
+	0x4e, 0x7a, 0x47, 0x92, 0x36, 0x13, 0x52, 0x5b, 0x27, 0x8d, 0x65, 0xe8, 0x04, 0xe7, 0x57, 0x00,
+	0x5e, 0x9b, 0xda, 0x65, 0xbb, 0x33, 0xca, 0xea, 0x4e, 0xe6, 0xc1, 0x12, 0x4e, 0x39, 0xd5, 0x2d,
+	0x49, 0xd5, 0x48, 0xa8, 0x76, 0x74, 0x2a, 0x2e, 0x83, 0xbc, 0x64, 0x8f, 0x19, 0xdf, 0xe7, 0xb2,
+	0x4d, 0xad, 0xa0, 0x62, 0xd9, 0x74, 0xd7, 0x39, 0xb2, 0x15, 0xae, 0x81, 0x03, 0x09, 0xf8, 0x66,
+	0x02, 0xd8, 0x28, 0x90, 0x2d, 0x7f, 0x8c, 0xc6, 0xe7, 0x70, 0x63, 0x7c, 0x5f, 0x58, 0x33, 0xca,
+	0x8d, 0xd9, 0xcd, 0x5b, 0xf3, 0xed, 0x39, 0x43, 0x53, 0x32, 0xdc, 0x4c, 0x18, 0x6e, 0xe8, 0x0c,
+	0xbe, 0xf4, 0xf7, 0x98, 0x2c, 0x78, 0x06, 0xe0, 0xab, 0x13, 0xcf, 0xb7, 0x5e, 0xd8, 0xb0, 0x72,
+	0x30, 0x9b, 0x0b, 0x1c, 0x72, 0x86, 0x3d, 0xc9, 0x60, 0x27, 0x0c, 0xb5, 0x02, 0x1d, 0xd4, 0x23,
+	0x36, 0x2f, 0x7f, 0xf1, 0xfc, 0xe9, 0x3e, 0xe8, 0xdc, 0x7f, 0x76, 0x61, 0x81, 0xf3, 0x0b, 0x0b,
+	0xfc, 0x75, 0x61, 0x81, 0x6f, 0x46, 0x56, 0xe9, 0x7c, 0x64, 0x95, 0xfe, 0x18, 0x59, 0xa5, 0x4f,
+	0x1c, 0x12, 0x8a, 0x07, 0x71, 0xaf, 0xe5, 0xd3, 0x81, 0xcc, 0xf4, 0x69, 0x18, 0x91, 0x3e, 0xed,
+	0xa1, 0xbe, 0xca, 0x7b, 0x7a, 0xe8, 0x3c, 0xca, 0x92, 0x8b, 0xc7, 0x43, 0xcc, 0x7b, 0x6b, 0xf2,
+	0x3f, 0xb4, 0xbb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xc0, 0xe9, 0xee, 0x1f, 0x8f, 0x0a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -540,6 +638,8 @@ type MsgClient interface {
 	SubmitOracleData(ctx context.Context, in *MsgSubmitOracleData, opts ...grpc.CallOption) (*MsgSubmitOracleDataResponse, error)
 	// UpdateModeratorAddress defines a method for updating the moderator address
 	UpdateModeratorAddress(ctx context.Context, in *MsgUpdateModeratorAddress, opts ...grpc.CallOption) (*MsgUpdateModeratorAddressResponse, error)
+	// CancelRound defines a method for the moderator to abort the open round of a request
+	CancelRound(ctx context.Context, in *MsgCancelRound, opts ...grpc.CallOption) (*MsgCancelRoundResponse, error)
 	// UpdateParams defines a governance operation for updating the oracle module parameters
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -588,6 +688,15 @@ func (c *msgClient) UpdateModeratorAddress(ctx context.Context, in *MsgUpdateMod
 	return out, nil
 }
 
+func (c *msgClient) CancelRound(ctx context.Context, in *MsgCancelRound, opts ...grpc.CallOption) (*MsgCancelRoundResponse, error) {
+	out := new(MsgCancelRoundResponse)
+	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Msg/CancelRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/guru.oracle.v1.Msg/UpdateParams", in, out, opts...)
@@ -607,6 +716,8 @@ type MsgServer interface {
 	SubmitOracleData(context.Context, *MsgSubmitOracleData) (*MsgSubmitOracleDataResponse, error)
 	// UpdateModeratorAddress defines a method for updating the moderator address
 	UpdateModeratorAddress(context.Context, *MsgUpdateModeratorAddress) (*MsgUpdateModeratorAddressResponse, error)
+	// CancelRound defines a method for the moderator to abort the open round of a request
+	CancelRound(context.Context, *MsgCancelRound) (*MsgCancelRoundResponse, error)
 	// UpdateParams defines a governance operation for updating the oracle module parameters
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -627,6 +738,9 @@ func (*UnimplementedMsgServer) SubmitOracleData(ctx context.Context, req *MsgSub
 func (*UnimplementedMsgServer) UpdateModeratorAddress(ctx context.Context, req *MsgUpdateModeratorAddress) (*MsgUpdateModeratorAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateModeratorAddress not implemented")
 }
+func (*UnimplementedMsgServer) CancelRound(ctx context.Context, req *MsgCancelRound) (*MsgCancelRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRound not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -707,6 +821,24 @@ func _Msg_UpdateModeratorAddress_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guru.oracle.v1.Msg/CancelRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRound(ctx, req.(*MsgCancelRound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -745,6 +877,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateModeratorAddress",
 			Handler:    _Msg_UpdateModeratorAddress_Handler,
 		},
+		{
+			MethodName: "CancelRound",
+			Handler:    _Msg_CancelRound_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1022,6 +1158,74 @@ func (m *MsgUpdateModeratorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RequestId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ModeratorAddress) > 0 {
+		i -= len(m.ModeratorAddress)
+		copy(dAtA[i:], m.ModeratorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModeratorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClearedReports != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClearedReports))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1206,6 +1410,37 @@ func (m *MsgUpdateModeratorAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequestId != 0 {
+		n += 1 + sovTx(uint64(m.RequestId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgCancelRoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClearedReports != 0 {
+		n += 1 + sovTx(uint64(m.ClearedReports))
+	}
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1968,6 +2203,195 @@ func (m *MsgUpdateModeratorAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModeratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearedReports", wireType)
+			}
+			m.ClearedReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClearedReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
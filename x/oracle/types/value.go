@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+const (
+	// OracleValuePrecision is the number of decimal places a numeric oracle value keeps, the precision of LegacyDec
+	OracleValuePrecision = sdkmath.LegacyPrecision
+
+	// oracleValueBigFloatPrec is the big.Float mantissa size used for arithmetic on oracle values.
+	// It is large enough that rounding the result to OracleValuePrecision decimals is exact.
+	oracleValueBigFloatPrec = 256
+)
+
+// OracleValue is a numeric oracle value with OracleValuePrecision decimals.
+// The daemon produces raw data with String, the module validates and aggregates it through
+// ParseOracleValue, and consumers read it back with ParseOracleValue, so every step agrees on the format.
+type OracleValue struct {
+	dec sdkmath.LegacyDec
+}
+
+// NewOracleValue returns the oracle value of dec
+func NewOracleValue(dec sdkmath.LegacyDec) OracleValue {
+	return OracleValue{dec: dec}
+}
+
+// ParseOracleValue parses a plain decimal with at most OracleValuePrecision decimals.
+// Exponent notation, Inf and NaN are rejected.
+func ParseOracleValue(s string) (OracleValue, error) {
+	dec, err := sdkmath.LegacyNewDecFromStr(s)
+	if err != nil {
+		return OracleValue{}, err
+	}
+	return OracleValue{dec: dec}, nil
+}
+
+// OracleValueFromBigFloat rounds f to OracleValuePrecision decimals
+func OracleValueFromBigFloat(f *big.Float) (OracleValue, error) {
+	if f.IsInf() {
+		return OracleValue{}, fmt.Errorf("oracle value cannot be infinite")
+	}
+	return ParseOracleValue(f.Text('f', OracleValuePrecision))
+}
+
+// Dec returns the value as a LegacyDec
+func (v OracleValue) Dec() sdkmath.LegacyDec {
+	if v.dec.IsNil() {
+		return sdkmath.LegacyZeroDec()
+	}
+	return v.dec
+}
+
+// BigFloat returns the value as a big.Float precise enough for aggregation
+func (v OracleValue) BigFloat() *big.Float {
+	f, _ := new(big.Float).SetPrec(oracleValueBigFloatPrec).SetString(v.Dec().String())
+	return f
+}
+
+// IsPositive reports whether the value is greater than zero
+func (v OracleValue) IsPositive() bool {
+	return v.Dec().IsPositive()
+}
+
+// String returns the canonical raw data form: a plain decimal without trailing zeros, e.g. "65000.12" or "3"
+func (v OracleValue) String() string {
+	text := v.Dec().String()
+	if strings.Contains(text, ".") {
+		text = strings.TrimRight(strings.TrimRight(text, "0"), ".")
+	}
+	return text
+}
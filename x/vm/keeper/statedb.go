@@ -7,9 +7,11 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/holiman/uint256"
 
+	"github.com/gurufinglobal/guru/v2/utils"
 	"github.com/gurufinglobal/guru/v2/x/vm/statedb"
 	"github.com/gurufinglobal/guru/v2/x/vm/types"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
@@ -89,6 +91,19 @@ func (k *Keeper) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
 	return store.Get(codeHash.Bytes())
 }
 
+// GetCodeSize returns the length of the contract code stored under the given code hash
+// without loading the code, implements `statedb.Keeper` interface.
+// Code stored before sizes were recorded falls back to loading the code.
+func (k *Keeper) GetCodeSize(ctx sdk.Context, codeHash common.Hash) int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeSize)
+	bz := store.Get(codeHash.Bytes())
+	if len(bz) == 0 {
+		return len(k.GetCode(ctx, codeHash))
+	}
+
+	return int(sdk.BigEndianToUint64(bz))
+}
+
 // ForEachStorage iterate contract storage, callback return false to break early
 func (k *Keeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -117,6 +132,9 @@ func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *uint25
 	coin := k.bankWrapper.SpendableCoin(ctx, cosmosAddr, types.GetEVMCoinDenom())
 
 	balance := coin.Amount.BigInt()
+	if _, err := utils.Uint256FromBigInt(balance); err != nil {
+		return errorsmod.Wrapf(types.ErrBalanceOverflow, "current balance of %s: %s", addr, err)
+	}
 	delta := new(big.Int).Sub(amount.ToBig(), balance)
 	switch delta.Sign() {
 	case 1:
@@ -217,23 +235,29 @@ func (k *Keeper) DeleteCodeHash(ctx sdk.Context, addr common.Address) {
 }
 
 // SetCode sets the given contract code bytes for the corresponding code hash bytes key
-// in the code store.
+// in the code store and records its length in the code size store.
 func (k *Keeper) SetCode(ctx sdk.Context, codeHash, code []byte) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCode)
 	store.Set(codeHash, code)
 
+	sizeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeSize)
+	sizeStore.Set(codeHash, sdk.Uint64ToBigEndian(uint64(len(code))))
+
 	k.Logger(ctx).Debug(
 		"code updated",
 		"code-hash", common.BytesToHash(codeHash).Hex(),
 	)
 }
 
-// DeleteCode deletes the contract code for the given code hash bytes in
-// the corresponding store.
+// DeleteCode deletes the contract code and its recorded length for the given
+// code hash bytes in the corresponding stores.
 func (k *Keeper) DeleteCode(ctx sdk.Context, codeHash []byte) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCode)
 	store.Delete(codeHash)
 
+	sizeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeSize)
+	sizeStore.Delete(codeHash)
+
 	k.Logger(ctx).Debug(
 		"code deleted",
 		"code-hash", common.BytesToHash(codeHash).Hex(),
@@ -10,7 +10,13 @@ import (
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
+	"github.com/gurufinglobal/guru/v2/testutil/integration/os/network"
 	utiltx "github.com/gurufinglobal/guru/v2/testutil/tx"
+	"github.com/gurufinglobal/guru/v2/x/vm/keeper"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func BenchmarkCreateAccountNew(b *testing.B) {
@@ -72,6 +78,43 @@ func BenchmarkSetCode(b *testing.B) {
 	}
 }
 
+// benchmarkCode measures reading the size of a contract at the 24KB EIP-170 limit
+func benchmarkCode(b *testing.B, read func(k *keeper.Keeper, ctx sdk.Context, codeHash common.Hash) int) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.EVMKeeper
+
+	code := make([]byte, 24576)
+	codeHash := crypto.Keccak256Hash(code)
+	k.SetCode(ctx, codeHash.Bytes(), code)
+
+	// store reads are charged per byte, so gas shows the cost of loading the code
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if read(k, ctx, codeHash) != len(code) {
+			b.Fatal("unexpected code size")
+		}
+	}
+
+	b.ReportMetric(float64(ctx.GasMeter().GasConsumed())/float64(b.N), "gas/op")
+}
+
+func BenchmarkGetCodeLen(b *testing.B) {
+	benchmarkCode(b, func(k *keeper.Keeper, ctx sdk.Context, codeHash common.Hash) int {
+		return len(k.GetCode(ctx, codeHash))
+	})
+}
+
+func BenchmarkGetCodeSize(b *testing.B) {
+	benchmarkCode(b, func(k *keeper.Keeper, ctx sdk.Context, codeHash common.Hash) int {
+		return k.GetCodeSize(ctx, codeHash)
+	})
+}
+
 func BenchmarkSetState(b *testing.B) {
 	suite := KeeperTestSuite{}
 	suite.SetupTest()
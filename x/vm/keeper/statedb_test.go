@@ -360,10 +360,30 @@ func (suite *KeeperTestSuite) TestKeeperSetOrDeleteCode() {
 			code := store.Get(tc.codeHash)
 
 			suite.Require().Equal(tc.code, code)
+
+			size := prefix.NewStore(ctx.KVStore(key), types.KeyPrefixCodeSize).Get(tc.codeHash)
+			if len(tc.code) == 0 {
+				suite.Require().Nil(size)
+			} else {
+				suite.Require().Equal(uint64(len(tc.code)), sdk.BigEndianToUint64(size))
+			}
 		})
 	}
 }
 
+func (suite *KeeperTestSuite) TestKeeperGetCodeSizeWithoutRecordedSize() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	codeHash := crypto.Keccak256Hash([]byte("legacy code"))
+
+	// code written before sizes were recorded has no entry in the size store
+	key := suite.network.App.GetKey(types.StoreKey)
+	prefix.NewStore(ctx.KVStore(key), types.KeyPrefixCode).Set(codeHash.Bytes(), []byte("legacy code"))
+
+	suite.Require().Equal(len("legacy code"), suite.network.App.EVMKeeper.GetCodeSize(ctx, codeHash))
+	suite.Require().Equal(0, suite.network.App.EVMKeeper.GetCodeSize(ctx, common.Hash{}))
+}
+
 func TestIterateContracts(t *testing.T) {
 	keyring := testkeyring.New(1)
 	network := network.NewUnitTestNetwork(
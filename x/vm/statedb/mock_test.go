@@ -53,6 +53,10 @@ func (k MockKeeper) GetCode(_ sdk.Context, codeHash common.Hash) []byte {
 	return k.codes[codeHash]
 }
 
+func (k MockKeeper) GetCodeSize(_ sdk.Context, codeHash common.Hash) int {
+	return len(k.codes[codeHash])
+}
+
 func (k MockKeeper) ForEachStorage(_ sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
 	if acct, ok := k.accounts[addr]; ok {
 		for k, v := range acct.states {
@@ -9,6 +9,8 @@ import (
 
 	"github.com/gurufinglobal/guru/v2/x/vm/types"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -107,11 +109,18 @@ func (s *stateObject) markSelfDestructed() {
 
 // AddBalance adds amount to s's balance.
 // It is used to add funds to the destination account of a transfer.
+// If the sum does not fit in a uint256 the balance is left unchanged and
+// the error is recorded on the StateDB so the commit fails.
 func (s *stateObject) AddBalance(amount *uint256.Int) uint256.Int {
 	if amount.IsZero() {
 		return *(s.Balance())
 	}
-	return s.SetBalance(new(uint256.Int).Add(s.Balance(), amount))
+	sum, overflow := new(uint256.Int).AddOverflow(s.Balance(), amount)
+	if overflow {
+		s.db.setError(errorsmod.Wrapf(types.ErrBalanceOverflow, "add %s to balance %s of %s", amount, s.Balance(), s.address))
+		return *(s.Balance())
+	}
+	return s.SetBalance(sum)
 }
 
 // SubBalance removes amount from s's balance.
@@ -176,9 +185,17 @@ func (s *stateObject) Code() []byte {
 }
 
 // CodeSize returns the size of the contract code associated with this object,
-// or zero if none.
+// or zero if none. Committed code is measured without being loaded.
 func (s *stateObject) CodeSize() int {
-	return len(s.Code())
+	if s.code != nil {
+		return len(s.code)
+	}
+
+	if types.IsEmptyCodeHash(s.CodeHash()) {
+		return 0
+	}
+
+	return s.db.keeper.GetCodeSize(s.db.ctx, common.BytesToHash(s.CodeHash()))
 }
 
 // SetCode set contract code to account
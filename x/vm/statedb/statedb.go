@@ -79,6 +79,23 @@ type StateDB struct {
 
 	// The count of calls to precompiles
 	precompileCallsCounter uint8
+
+	// dbErr records the first failure that could not be returned through the
+	// vm.StateDB interface, e.g. a balance overflow. Commit refuses to write
+	// the dirty states while it is set.
+	dbErr error
+}
+
+// setError remembers the first non-nil error it is called with.
+func (s *StateDB) setError(err error) {
+	if s.dbErr == nil {
+		s.dbErr = err
+	}
+}
+
+// Error returns the first error recorded while modifying the state.
+func (s *StateDB) Error() error {
+	return s.dbErr
 }
 
 func (s *StateDB) CreateContract(address common.Address) {
@@ -675,6 +692,9 @@ func (s *StateDB) CommitWithCacheCtx() error {
 // commitWithCtx writes the dirty states to keeper
 // using the provided context
 func (s *StateDB) commitWithCtx(ctx sdk.Context) error {
+	if s.dbErr != nil {
+		return s.dbErr
+	}
 	for _, addr := range s.journal.sortedDirties() {
 		obj := s.stateObjects[addr]
 		if obj.selfDestructed {
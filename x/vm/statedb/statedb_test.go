@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/gurufinglobal/guru/v2/x/vm/statedb"
+	"github.com/gurufinglobal/guru/v2/x/vm/types"
 	"github.com/gurufinglobal/guru/v2/x/vm/types/mocks"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -241,7 +242,7 @@ func (suite *StateDBTestSuite) TestDBError() {
 }
 
 func (suite *StateDBTestSuite) TestBalance() {
-	// NOTE: no need to test overflow/underflow, that is guaranteed by evm implementation.
+	// NOTE: underflow is guaranteed by evm implementation, overflow is covered by TestBalanceOverflow.
 	testCases := []struct {
 		name       string
 		malleate   func(*statedb.StateDB)
@@ -280,6 +281,26 @@ func (suite *StateDBTestSuite) TestBalance() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestBalanceOverflow() {
+	ctx := sdk.Context{}
+	keeper := mocks.NewEVMKeeper()
+	db := statedb.New(ctx, keeper, emptyTxConfig)
+
+	maxBalance := new(uint256.Int).SetAllOne()
+	db.AddBalance(address, maxBalance, tracing.BalanceChangeUnspecified)
+	suite.Require().NoError(db.Error())
+
+	prev := db.AddBalance(address, uint256.NewInt(1), tracing.BalanceChangeUnspecified)
+	suite.Require().Equal(*maxBalance, prev)
+	// balance must not wrap around to zero
+	suite.Require().Equal(maxBalance, db.GetBalance(address))
+	suite.Require().ErrorIs(db.Error(), types.ErrBalanceOverflow)
+
+	// the overflow is sticky and prevents the dirty state from being written
+	suite.Require().ErrorIs(db.Commit(), types.ErrBalanceOverflow)
+	suite.Require().Nil(keeper.GetAccount(ctx, address))
+}
+
 func (suite *StateDBTestSuite) TestState() {
 	key1 := common.BigToHash(big.NewInt(1))
 	value1 := common.BigToHash(big.NewInt(1))
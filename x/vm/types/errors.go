@@ -30,6 +30,7 @@ const (
 	codeErrInactivePrecompile
 	codeErrABIPack
 	codeErrABIUnpack
+	codeErrBalanceOverflow
 )
 
 var (
@@ -87,6 +88,9 @@ var (
 	// ErrABIUnpack returns an error if the contract ABI unpacking fails
 	ErrABIUnpack = errorsmod.Register(ModuleName, codeErrABIUnpack, "contract ABI unpack failed")
 
+	// ErrBalanceOverflow returns an error if a balance operation exceeds the uint256 range
+	ErrBalanceOverflow = errorsmod.Register(ModuleName, codeErrBalanceOverflow, "balance overflows uint256")
+
 	// RevertSelector is selector of ErrExecutionReverted
 	RevertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
 )
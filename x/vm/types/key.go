@@ -27,6 +27,7 @@ const (
 	prefixStorage
 	prefixParams
 	prefixCodeHash
+	prefixCodeSize
 )
 
 // prefix bytes for the EVM transient store
@@ -43,6 +44,7 @@ var (
 	KeyPrefixStorage  = []byte{prefixStorage}
 	KeyPrefixParams   = []byte{prefixParams}
 	KeyPrefixCodeHash = []byte{prefixCodeHash}
+	KeyPrefixCodeSize = []byte{prefixCodeSize}
 )
 
 // Transient Store key prefixes
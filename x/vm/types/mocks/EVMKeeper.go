@@ -57,6 +57,10 @@ func (k EVMKeeper) GetCode(_ sdk.Context, codeHash common.Hash) []byte {
 	return k.codes[codeHash]
 }
 
+func (k EVMKeeper) GetCodeSize(_ sdk.Context, codeHash common.Hash) int {
+	return len(k.codes[codeHash])
+}
+
 func (k EVMKeeper) ForEachStorage(_ sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
 	if acct, ok := k.accounts[addr]; ok {
 		for k, v := range acct.states {